@@ -1,12 +1,29 @@
 package fpgo
 
 import (
+	"context"
 	"fmt"
+	"sync"
 	"time"
 )
 
 var ErrActorAskTimeout = fmt.Errorf("ErrActorAskTimeout")
 
+// ErrActorIsClosed The Actor is closed
+var ErrActorIsClosed = fmt.Errorf("ErrActorIsClosed")
+
+// ErrActorMailboxIsFull The bounded mailbox of the Actor is full(MailboxOverflowError)
+var ErrActorMailboxIsFull = fmt.Errorf("ErrActorMailboxIsFull")
+
+// ErrActorReplyTypeMismatch The type of the reply doesn't match the asked one
+var ErrActorReplyTypeMismatch = fmt.Errorf("ErrActorReplyTypeMismatch")
+
+// ErrActorSendCancelled The context of SendWithContext() is cancelled before the message is enqueued
+var ErrActorSendCancelled = fmt.Errorf("ErrActorSendCancelled")
+
+// ErrActorSendTimeout The mailbox of the Actor stays full past the deadline of the context of SendWithContext()
+var ErrActorSendTimeout = fmt.Errorf("ErrActorSendTimeout")
+
 // ActorHandle A target could send messages
 type ActorHandle[T any] interface {
 	Send(message T)
@@ -15,14 +32,37 @@ type ActorHandle[T any] interface {
 // ActorDef[T] Actor model inspired by Erlang/Akka
 type ActorDef[T any] struct {
 	id       time.Time
-	isClosed bool
+	isClosed AtomBool
 	ch       chan T
 	effect   func(*ActorDef[T], T)
 
+	behaviors []func(*ActorDef[T], T)
+
+	mailboxOverflowPolicy MailboxOverflowPolicy
+
+	askCh     chan *actorEnvelope[T]
+	closedCh  chan struct{}
+	closeOnce sync.Once
+	current   *actorEnvelope[T]
+
+	// Held by senders(read) & by Close() closing the mailbox(write)
+	sendLock sync.RWMutex
+
+	timeScheduler TimeScheduler
+
+	stash     []*actorEnvelope[T]
+	unstashed []*actorEnvelope[T]
+
+	supervisor   *SupervisorStrategy
+	failureCh    chan interface{}
+	restartTimes []time.Time
+
 	context map[string]interface{}
 
-	children map[time.Time]*ActorDef[T]
-	parent   *ActorDef[T]
+	children      map[time.Time]*ActorDef[T]
+	namedChildren map[string]*ActorDef[T]
+	childrenLock  sync.RWMutex
+	parent        *ActorDef[T]
 }
 
 var defaultActor *ActorDef[interface{}]
@@ -55,6 +95,12 @@ func ActorNewByOptionsGenerics[T any](effect func(*ActorDef[T], T), ioCh chan T,
 		effect:   effect,
 		context:  context,
 		children: map[time.Time]*ActorDef[T]{},
+
+		namedChildren: map[string]*ActorDef[T]{},
+
+		askCh:     make(chan *actorEnvelope[T]),
+		closedCh:  make(chan struct{}),
+		failureCh: make(chan interface{}),
 	}
 
 	go newOne.run()
@@ -62,33 +108,188 @@ func ActorNewByOptionsGenerics[T any](effect func(*ActorDef[T], T), ioCh chan T,
 	return &newOne
 }
 
+// MailboxOverflowPolicy What to do when the bounded mailbox of an Actor is full
+type MailboxOverflowPolicy int
+
+const (
+	// MailboxOverflowBlock Block the sender until the mailbox has space
+	MailboxOverflowBlock MailboxOverflowPolicy = iota
+	// MailboxOverflowDropNewest Drop the message being sent
+	MailboxOverflowDropNewest
+	// MailboxOverflowDropOldest Drop the oldest message in the mailbox(blocking like MailboxOverflowBlock if the capacity is 0)
+	MailboxOverflowDropOldest
+	// MailboxOverflowError Return ErrActorMailboxIsFull by TrySend()
+	MailboxOverflowError
+)
+
+// ActorNewWithMailbox New Actor instance with a bounded mailbox of the capacity and the overflow policy
+func ActorNewWithMailbox[T any](effect func(*ActorDef[T], T), capacity int, policy MailboxOverflowPolicy) *ActorDef[T] {
+	newOne := ActorNewByOptionsGenerics(effect, make(chan T, capacity), map[string]interface{}{})
+	newOne.mailboxOverflowPolicy = policy
+
+	return newOne
+}
+
 // Send Send a message to the Actor
 func (actorSelf *ActorDef[T]) Send(message T) {
-	if actorSelf.isClosed {
-		return
-	}
+	actorSelf.TrySend(message)
+}
+
+// TrySend Send a message to the Actor by the MailboxOverflowPolicy, and get the error(ErrActorIsClosed/ErrActorMailboxIsFull)
+func (actorSelf *ActorDef[T]) TrySend(message T) error {
+	return actorSelf.doSendSafe(message, func() error {
+		policy := actorSelf.mailboxOverflowPolicy
+		// There's no oldest one to drop in an unbuffered mailbox
+		if policy == MailboxOverflowDropOldest && cap(actorSelf.ch) == 0 {
+			policy = MailboxOverflowBlock
+		}
+
+		switch policy {
+		case MailboxOverflowDropNewest:
+			select {
+			case actorSelf.ch <- message:
+			default:
+				actorSelf.publishDeadLetter(message, ErrActorMailboxIsFull)
+			}
+		case MailboxOverflowDropOldest:
+			for {
+				select {
+				case actorSelf.ch <- message:
+					return nil
+				case <-actorSelf.closedCh:
+					actorSelf.publishDeadLetter(message, ErrActorIsClosed)
+					return ErrActorIsClosed
+				default:
+					// Drop the oldest one and try again
+					select {
+					case oldest := <-actorSelf.ch:
+						actorSelf.publishDeadLetter(oldest, ErrActorMailboxIsFull)
+					default:
+					}
+				}
+			}
+		case MailboxOverflowError:
+			select {
+			case actorSelf.ch <- message:
+			default:
+				actorSelf.publishDeadLetter(message, ErrActorMailboxIsFull)
+				return ErrActorMailboxIsFull
+			}
+		default:
+			select {
+			case actorSelf.ch <- message:
+			case <-actorSelf.closedCh:
+				actorSelf.publishDeadLetter(message, ErrActorIsClosed)
+				return ErrActorIsClosed
+			}
+		}
+
+		return nil
+	})
+}
+
+// SendWithContext Send the message, waiting for mailbox space until the context is done(regardless of the MailboxOverflowPolicy)
+//
+// NOTE: returns ErrActorSendTimeout if the deadline is exceeded, ErrActorSendCancelled if it's cancelled.
+func (actorSelf *ActorDef[T]) SendWithContext(ctx context.Context, message T) error {
+	return actorSelf.doSendSafe(message, func() error {
+		select {
+		case actorSelf.ch <- message:
+			return nil
+		case <-actorSelf.closedCh:
+			actorSelf.publishDeadLetter(message, ErrActorIsClosed)
+			return ErrActorIsClosed
+		case <-ctx.Done():
+			err := ErrActorSendCancelled
+			if ctx.Err() == context.DeadlineExceeded {
+				err = ErrActorSendTimeout
+			}
+			actorSelf.publishDeadLetter(message, err)
+			return err
+		}
+	})
+}
 
-	actorSelf.ch <- message
+// doSendSafe Call send while the mailbox can't be closed(ErrActorIsClosed if it's closed already)
+//
+// NOTE: send must return after closedCh is closed, or Close() would be blocked.
+func (actorSelf *ActorDef[T]) doSendSafe(message T, send func() error) error {
+	actorSelf.sendLock.RLock()
+	defer actorSelf.sendLock.RUnlock()
+
+	if actorSelf.isClosed.Get() {
+		actorSelf.publishDeadLetter(message, ErrActorIsClosed)
+		return ErrActorIsClosed
+	}
+	return send()
 }
 
-// Spawn Spawn a new Actor with parent(this actor)
+// Spawn Spawn a new Actor with parent(this actor), it's closed when the parent is closed
 func (actorSelf *ActorDef[T]) Spawn(effect func(*ActorDef[T], T)) *ActorDef[T] {
 	newOne := actorSelf.New(effect)
-	if actorSelf.isClosed {
+	if actorSelf.isClosed.Get() {
 		return newOne
 	}
 
 	newOne.parent = actorSelf
+	actorSelf.childrenLock.Lock()
 	actorSelf.children[newOne.id] = newOne
+	actorSelf.childrenLock.Unlock()
+
+	return newOne
+}
+
+// SpawnChild Spawn a new child Actor whose lifecycle is tied to this actor(the same as Spawn())
+func (actorSelf *ActorDef[T]) SpawnChild(effect func(*ActorDef[T], T)) *ActorDef[T] {
+	return actorSelf.Spawn(effect)
+}
+
+// SpawnNamedChild Spawn a new child Actor registered by the name(replacing the previous one of the name)
+func (actorSelf *ActorDef[T]) SpawnNamedChild(name string, effect func(*ActorDef[T], T)) *ActorDef[T] {
+	newOne := actorSelf.Spawn(effect)
+	if newOne.parent != actorSelf {
+		return newOne
+	}
+
+	actorSelf.childrenLock.Lock()
+	actorSelf.namedChildren[name] = newOne
+	actorSelf.childrenLock.Unlock()
 
 	return newOne
 }
 
 // GetChild Get a child Actor by ID
 func (actorSelf *ActorDef[T]) GetChild(id time.Time) *ActorDef[T] {
+	actorSelf.childrenLock.RLock()
+	defer actorSelf.childrenLock.RUnlock()
+
 	return actorSelf.children[id]
 }
 
+// GetChildByName Get a running child Actor by the name(nil if there's none or it's closed)
+func (actorSelf *ActorDef[T]) GetChildByName(name string) *ActorDef[T] {
+	actorSelf.childrenLock.RLock()
+	child := actorSelf.namedChildren[name]
+	actorSelf.childrenLock.RUnlock()
+
+	if child == nil || child.IsClosed() {
+		return nil
+	}
+	return child
+}
+
+// GetChildren Get all child Actors
+func (actorSelf *ActorDef[T]) GetChildren() []*ActorDef[T] {
+	actorSelf.childrenLock.RLock()
+	defer actorSelf.childrenLock.RUnlock()
+
+	children := make([]*ActorDef[T], 0, len(actorSelf.children))
+	for _, child := range actorSelf.children {
+		children = append(children, child)
+	}
+	return children
+}
+
 // GetParent Get its parent Actor
 func (actorSelf *ActorDef[T]) GetParent() *ActorDef[T] {
 	return actorSelf.parent
@@ -101,22 +302,147 @@ func (actorSelf *ActorDef[T]) GetID() time.Time {
 
 // Close Close the Actor
 func (actorSelf *ActorDef[T]) Close() {
-	actorSelf.isClosed = true
-
-	close(actorSelf.ch)
+	actorSelf.isClosed.Set(true)
+
+	actorSelf.closeOnce.Do(func() {
+		// Wake up the blocked senders first, then close the mailbox after they've left
+		close(actorSelf.closedCh)
+		actorSelf.sendLock.Lock()
+		close(actorSelf.ch)
+		actorSelf.sendLock.Unlock()
+
+		// Close children with their parent
+		for _, child := range actorSelf.GetChildren() {
+			child.Close()
+		}
+	})
 }
 
 // IsClosed Check is Closed
 func (actorSelf *ActorDef[T]) IsClosed() bool {
-	return actorSelf.isClosed
+	return actorSelf.isClosed.Get()
+}
+
+// Responder Get the responder of the message being handled(nil if it's not sent by ActorAsk())
+//
+// NOTE: call it in the effect function, the responder could be kept for replying asynchronously.
+func (actorSelf *ActorDef[T]) Responder() *ActorResponder {
+	if actorSelf.current == nil {
+		return nil
+	}
+	return actorSelf.current.responder
 }
 
 func (actorSelf *ActorDef[T]) run() {
-	for message := range actorSelf.ch {
-		actorSelf.effect(actorSelf, message)
+	for {
+		isStopped := false
+		// Unstashed messages go first
+		if len(actorSelf.unstashed) > 0 {
+			envelope := actorSelf.unstashed[0]
+			actorSelf.unstashed = actorSelf.unstashed[1:]
+			if actorSelf.receive(envelope.message, envelope.responder) {
+				actorSelf.Close()
+				return
+			}
+			continue
+		}
+
+		select {
+		case message, ok := <-actorSelf.ch:
+			if !ok {
+				return
+			}
+			isStopped = actorSelf.receive(message, nil)
+		case ask := <-actorSelf.askCh:
+			// Handle the messages buffered before the ask first
+			isMailboxClosed := false
+			for n := len(actorSelf.ch); n > 0 && !isStopped && !isMailboxClosed; n-- {
+				message, ok := <-actorSelf.ch
+				isMailboxClosed = !ok
+				if ok {
+					isStopped = actorSelf.receive(message, nil)
+				}
+			}
+			if isStopped || isMailboxClosed {
+				ask.responder.ReplyError(ErrActorIsClosed)
+				isStopped = true
+				break
+			}
+			isStopped = actorSelf.receive(ask.message, ask.responder)
+		case recovered := <-actorSelf.failureCh:
+			// Escalated by a child
+			isStopped = actorSelf.handleFailure(recovered)
+		}
+
+		if isStopped {
+			actorSelf.Close()
+			return
+		}
 	}
 }
 
+type actorEnvelope[T any] struct {
+	message   T
+	responder *ActorResponder
+}
+
+// ActorResponder Reply a message sent by ActorAsk()(only the first reply takes effect)
+type ActorResponder struct {
+	once  sync.Once
+	reply func(response interface{}, err error)
+}
+
+// Reply Reply the response(no-op for a nil responder)
+func (responderSelf *ActorResponder) Reply(response interface{}) {
+	responderSelf.doReply(response, nil)
+}
+
+// ReplyError Reply the error(no-op for a nil responder)
+func (responderSelf *ActorResponder) ReplyError(err error) {
+	responderSelf.doReply(nil, err)
+}
+
+func (responderSelf *ActorResponder) doReply(response interface{}, err error) {
+	if responderSelf == nil {
+		return
+	}
+
+	responderSelf.once.Do(func() {
+		responderSelf.reply(response, err)
+	})
+}
+
+// ActorAsk Send the message to the Actor, and get the Future of the reply by Responder() of the Actor
+func ActorAsk[T any, R any](actor *ActorDef[T], message T) *Future[R] {
+	future := NewFuture[R]()
+	// Not started(e.g. the Actor utils instance)
+	if actor.closedCh == nil {
+		future.Fail(ErrActorIsClosed)
+		return future
+	}
+
+	responder := &ActorResponder{reply: func(response interface{}, err error) {
+		if err != nil {
+			future.Fail(err)
+			return
+		}
+		result, ok := response.(R)
+		if !ok && response != nil {
+			future.Fail(ErrActorReplyTypeMismatch)
+			return
+		}
+		future.Complete(result)
+	}}
+	select {
+	case actor.askCh <- &actorEnvelope[T]{message: message, responder: responder}:
+	case <-actor.closedCh:
+		actor.publishDeadLetter(message, ErrActorIsClosed)
+		future.Fail(ErrActorIsClosed)
+	}
+
+	return future
+}
+
 // Actor Actor utils instance
 var Actor ActorDef[interface{}]
 
@@ -197,6 +523,6 @@ func init() {
 	// Ask = *Ask.New(0, nil)
 	// Actor = *Actor.New(func(_ *ActorDef[interface{}], _ interface{}) {})
 	// Actor.Close()
-	Actor.isClosed = true
+	Actor.isClosed.Set(true)
 	defaultActor = &Actor
 }
@@ -0,0 +1,31 @@
+package fpgo
+
+// Actor Behaviors
+
+// Become Switch the effect function for the following messages(call it in the effect function), the current one is pushed onto the behavior stack
+func (actorSelf *ActorDef[T]) Become(effect func(*ActorDef[T], T)) {
+	actorSelf.behaviors = append(actorSelf.behaviors, actorSelf.effect)
+	actorSelf.effect = effect
+}
+
+// Unbecome Switch back to the previous effect function(call it in the effect function), false if there's no previous one
+func (actorSelf *ActorDef[T]) Unbecome() bool {
+	if len(actorSelf.behaviors) == 0 {
+		return false
+	}
+
+	last := len(actorSelf.behaviors) - 1
+	actorSelf.effect = actorSelf.behaviors[last]
+	actorSelf.behaviors = actorSelf.behaviors[:last]
+	return true
+}
+
+// resetBehavior Switch back to the initial effect function(e.g. when restarted by the SupervisorStrategy)
+func (actorSelf *ActorDef[T]) resetBehavior() {
+	if len(actorSelf.behaviors) == 0 {
+		return
+	}
+
+	actorSelf.effect = actorSelf.behaviors[0]
+	actorSelf.behaviors = nil
+}
@@ -0,0 +1,24 @@
+package fpgo
+
+import "time"
+
+// Dead Letters
+
+// DeadLetter A message which couldn't be delivered to an Actor
+type DeadLetter struct {
+	ActorID time.Time
+	Message interface{}
+	// Reason Why it's undeliverable(e.g. ErrActorIsClosed/ErrActorMailboxIsFull)
+	Reason error
+}
+
+// DeadLetters The Publisher of all undeliverable Actor messages, subscribe it to observe message loss
+var DeadLetters = PublisherNewGenerics[DeadLetter]()
+
+func (actorSelf *ActorDef[T]) publishDeadLetter(message T, reason error) {
+	DeadLetters.Publish(DeadLetter{
+		ActorID: actorSelf.id,
+		Message: message,
+		Reason:  reason,
+	})
+}
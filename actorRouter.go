@@ -0,0 +1,182 @@
+package fpgo
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+)
+
+// Router
+
+// RouterStrategy How an ActorRouter routes messages to its routees
+type RouterStrategy int
+
+const (
+	// RouterRoundRobin Route messages to routees one after another
+	RouterRoundRobin RouterStrategy = iota
+	// RouterBroadcast Route every message to all routees
+	RouterBroadcast
+	// RouterConsistentHash Route messages of the same key to the same routee(only a few keys move when routees change)
+	RouterConsistentHash
+)
+
+// consistentHashReplicas The number of virtual nodes of each routee on the hash ring
+const consistentHashReplicas = 64
+
+// ActorRouter Fan messages out to routee Actors inspired by Akka routers(ActorHandle)
+type ActorRouter[T any] struct {
+	lock     sync.RWMutex
+	strategy RouterStrategy
+	routees  []*ActorDef[T]
+	counter  uint64
+
+	hashKey  func(T) string
+	ring     []uint32
+	ringNode map[uint32]*ActorDef[T]
+}
+
+// NewRoundRobinRouter New ActorRouter routing messages to the routees one after another
+func NewRoundRobinRouter[T any](routees ...*ActorDef[T]) *ActorRouter[T] {
+	return newActorRouter(RouterRoundRobin, nil, routees)
+}
+
+// NewBroadcastRouter New ActorRouter routing every message to all routees
+func NewBroadcastRouter[T any](routees ...*ActorDef[T]) *ActorRouter[T] {
+	return newActorRouter(RouterBroadcast, nil, routees)
+}
+
+// NewConsistentHashRouter New ActorRouter routing messages of the same key(by hashKey) to the same routee
+func NewConsistentHashRouter[T any](hashKey func(T) string, routees ...*ActorDef[T]) *ActorRouter[T] {
+	return newActorRouter(RouterConsistentHash, hashKey, routees)
+}
+
+func newActorRouter[T any](strategy RouterStrategy, hashKey func(T) string, routees []*ActorDef[T]) *ActorRouter[T] {
+	router := &ActorRouter[T]{strategy: strategy, hashKey: hashKey}
+	router.AddRoutees(routees...)
+	return router
+}
+
+// Send Route the message to the routee(s) by the RouterStrategy(dropped if there's no routee)
+func (routerSelf *ActorRouter[T]) Send(message T) {
+	routerSelf.lock.RLock()
+	routees := routerSelf.routees
+	var target *ActorDef[T]
+	if len(routees) > 0 {
+		switch routerSelf.strategy {
+		case RouterRoundRobin:
+			index := atomic.AddUint64(&routerSelf.counter, 1) - 1
+			target = routees[index%uint64(len(routees))]
+		case RouterConsistentHash:
+			target = routerSelf.lookup(routerSelf.hashKey(message))
+		}
+	}
+	routerSelf.lock.RUnlock()
+
+	if routerSelf.strategy == RouterBroadcast {
+		for _, routee := range routees {
+			routee.Send(message)
+		}
+		return
+	}
+	if target != nil {
+		target.Send(message)
+	}
+}
+
+// AddRoutees Add routee Actors
+func (routerSelf *ActorRouter[T]) AddRoutees(routees ...*ActorDef[T]) {
+	routerSelf.lock.Lock()
+	defer routerSelf.lock.Unlock()
+
+	// Make a new slice, Send() may be iterating the old one
+	routerSelf.routees = Concat(routerSelf.routees[:len(routerSelf.routees):len(routerSelf.routees)], routees)
+	routerSelf.rebuildRing()
+}
+
+// RemoveRoutees Remove routee Actors(they're not closed)
+func (routerSelf *ActorRouter[T]) RemoveRoutees(routees ...*ActorDef[T]) {
+	routerSelf.lock.Lock()
+	defer routerSelf.lock.Unlock()
+
+	removed := SliceToMap(true, routees...)
+	result := make([]*ActorDef[T], 0, len(routerSelf.routees))
+	for _, routee := range routerSelf.routees {
+		if !removed[routee] {
+			result = append(result, routee)
+		}
+	}
+	routerSelf.routees = result
+	routerSelf.rebuildRing()
+}
+
+// Resize Resize the routees to the size, new routees are made by the factory & removed ones are closed
+func (routerSelf *ActorRouter[T]) Resize(size int, factory func() *ActorDef[T]) {
+	routees := routerSelf.Routees()
+	if size > len(routees) {
+		added := make([]*ActorDef[T], 0, size-len(routees))
+		for i := len(routees); i < size; i++ {
+			added = append(added, factory())
+		}
+		routerSelf.AddRoutees(added...)
+		return
+	}
+
+	if size < 0 {
+		size = 0
+	}
+	removed := routees[size:]
+	routerSelf.RemoveRoutees(removed...)
+	for _, routee := range removed {
+		routee.Close()
+	}
+}
+
+// Routees Get the current routees
+func (routerSelf *ActorRouter[T]) Routees() []*ActorDef[T] {
+	routerSelf.lock.RLock()
+	defer routerSelf.lock.RUnlock()
+
+	return Concat(routerSelf.routees[:0:0], routerSelf.routees)
+}
+
+// rebuildRing should be called with the lock
+func (routerSelf *ActorRouter[T]) rebuildRing() {
+	if routerSelf.strategy != RouterConsistentHash {
+		return
+	}
+
+	routerSelf.ring = make([]uint32, 0, len(routerSelf.routees)*consistentHashReplicas)
+	routerSelf.ringNode = make(map[uint32]*ActorDef[T], len(routerSelf.routees)*consistentHashReplicas)
+	for _, routee := range routerSelf.routees {
+		id := fmt.Sprintf("%p", routee)
+		for i := 0; i < consistentHashReplicas; i++ {
+			point := hashString(id + "#" + strconv.Itoa(i))
+			routerSelf.ring = append(routerSelf.ring, point)
+			routerSelf.ringNode[point] = routee
+		}
+	}
+	sort.Slice(routerSelf.ring, func(i, j int) bool {
+		return routerSelf.ring[i] < routerSelf.ring[j]
+	})
+}
+
+// lookup should be called with the lock
+func (routerSelf *ActorRouter[T]) lookup(key string) *ActorDef[T] {
+	point := hashString(key)
+	index := sort.Search(len(routerSelf.ring), func(i int) bool {
+		return routerSelf.ring[i] >= point
+	})
+	if index == len(routerSelf.ring) {
+		index = 0
+	}
+	return routerSelf.ringNode[routerSelf.ring[index]]
+}
+
+func hashString(key string) uint32 {
+	hash := fnv.New32a()
+	hash.Write([]byte(key))
+	return hash.Sum32()
+}
@@ -0,0 +1,86 @@
+package fpgo
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActorRouter(t *testing.T) {
+	var lock sync.Mutex
+	var wg sync.WaitGroup
+	received := map[int][]string{}
+	newRoutee := func(index int) *ActorDef[string] {
+		return ActorNewGenerics(func(_ *ActorDef[string], input string) {
+			lock.Lock()
+			received[index] = append(received[index], input)
+			lock.Unlock()
+			wg.Done()
+		})
+	}
+	reset := func() {
+		received = map[int][]string{}
+	}
+	routees := []*ActorDef[string]{newRoutee(0), newRoutee(1), newRoutee(2)}
+
+	var router *ActorRouter[string]
+	router = NewRoundRobinRouter(routees...)
+	wg.Add(6)
+	for i := 0; i < 6; i++ {
+		router.Send(strconv.Itoa(i))
+	}
+	wg.Wait()
+	assert.Equal(t, map[int][]string{0: {"0", "3"}, 1: {"1", "4"}, 2: {"2", "5"}}, received)
+
+	reset()
+	router = NewBroadcastRouter(routees...)
+	wg.Add(3)
+	router.Send("all")
+	wg.Wait()
+	assert.Equal(t, map[int][]string{0: {"all"}, 1: {"all"}, 2: {"all"}}, received)
+
+	// The same key goes to the same routee
+	reset()
+	router = NewConsistentHashRouter(func(message string) string {
+		return message[:1]
+	}, routees...)
+	wg.Add(6)
+	for _, message := range []string{"a1", "b1", "c1", "a2", "b2", "c2"} {
+		router.Send(message)
+	}
+	wg.Wait()
+	routeeOfKey := map[string]int{}
+	for routeeIndex, messages := range received {
+		for _, message := range messages {
+			key := message[:1]
+			if previous, ok := routeeOfKey[key]; ok {
+				assert.Equal(t, previous, routeeIndex)
+			}
+			routeeOfKey[key] = routeeIndex
+		}
+	}
+	assert.Equal(t, 3, len(routeeOfKey))
+
+	// Resize
+	reset()
+	index := 3
+	router = NewRoundRobinRouter(routees...)
+	router.Resize(4, func() *ActorDef[string] {
+		index++
+		return newRoutee(index - 1)
+	})
+	assert.Equal(t, 4, len(router.Routees()))
+	router.Resize(1, nil)
+	assert.Equal(t, []*ActorDef[string]{routees[0]}, router.Routees())
+	assert.Equal(t, true, routees[1].IsClosed())
+	wg.Add(2)
+	router.Send("x")
+	router.Send("y")
+	wg.Wait()
+	assert.Equal(t, map[int][]string{0: {"x", "y"}}, received)
+	router.RemoveRoutees(routees[0])
+	router.Send("dropped")
+	routees[0].Close()
+}
@@ -0,0 +1,34 @@
+package fpgo
+
+// Actor Stash
+
+// Stash Defer the message being handled(call it in the effect function), it'll be handled again after Unstash()/UnstashAll()
+func (actorSelf *ActorDef[T]) Stash() {
+	if actorSelf.current == nil {
+		return
+	}
+
+	actorSelf.stash = append(actorSelf.stash, actorSelf.current)
+}
+
+// Unstash Handle the oldest stashed message before new messages(call it in the effect function), false if the stash is empty
+func (actorSelf *ActorDef[T]) Unstash() bool {
+	if len(actorSelf.stash) == 0 {
+		return false
+	}
+
+	actorSelf.unstashed = append(actorSelf.unstashed, actorSelf.stash[0])
+	actorSelf.stash = actorSelf.stash[1:]
+	return true
+}
+
+// UnstashAll Handle all stashed messages in order before new messages(call it in the effect function)
+func (actorSelf *ActorDef[T]) UnstashAll() {
+	actorSelf.unstashed = append(actorSelf.unstashed, actorSelf.stash...)
+	actorSelf.stash = nil
+}
+
+// StashSize Get the number of stashed messages(call it in the effect function)
+func (actorSelf *ActorDef[T]) StashSize() int {
+	return len(actorSelf.stash)
+}
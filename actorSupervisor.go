@@ -0,0 +1,118 @@
+package fpgo
+
+import (
+	"fmt"
+	"time"
+)
+
+// Supervision
+
+// ErrActorPanicked The effect function of the Actor panicked(replied to the pending ActorAsk())
+var ErrActorPanicked = fmt.Errorf("ErrActorPanicked")
+
+// SupervisorDirective What to do with an Actor whose effect function panicked
+type SupervisorDirective int
+
+const (
+	// SupervisorRestart Restart the Actor(after the backoff delay) and keep handling the next messages
+	SupervisorRestart SupervisorDirective = iota
+	// SupervisorResume Ignore the failure and keep handling the next messages
+	SupervisorResume
+	// SupervisorStop Stop(close) the Actor
+	SupervisorStop
+	// SupervisorEscalate Stop the Actor and let its parent handle the failure by the parent's SupervisorStrategy
+	SupervisorEscalate
+)
+
+// SupervisorStrategy Supervision of Actors inspired by Erlang/Akka
+type SupervisorStrategy struct {
+	// Decider Decide the directive by the recovered panic value(SupervisorRestart if nil)
+	Decider func(recovered interface{}) SupervisorDirective
+	// MaxRestarts The maximum number of restarts within the Window, the Actor stops when it's exceeded(unlimited if <= 0)
+	MaxRestarts int
+	// Window The time window counting restarts(counting all restarts if <= 0)
+	Window time.Duration
+	// Backoff The delay before the n-th restart within the Window(RetryPolicy.Delay())
+	Backoff RetryPolicy
+	// OnRestart Called before the Actor restarts(e.g. resetting states)
+	OnRestart func()
+}
+
+// SetSupervisor Set the SupervisorStrategy, panics of the effect function are recovered only if it's set
+//
+// NOTE: call it before sending messages.
+func (actorSelf *ActorDef[T]) SetSupervisor(supervisor *SupervisorStrategy) *ActorDef[T] {
+	actorSelf.supervisor = supervisor
+	return actorSelf
+}
+
+// receive Handle the message(recover panics if supervised), returns true if the Actor should stop
+func (actorSelf *ActorDef[T]) receive(message T, responder *ActorResponder) (isStopped bool) {
+	if actorSelf.supervisor != nil {
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				actorSelf.current = nil
+				responder.ReplyError(fmt.Errorf("%w: %v", ErrActorPanicked, recovered))
+				isStopped = actorSelf.handleFailure(recovered)
+			}
+		}()
+	}
+
+	actorSelf.current = &actorEnvelope[T]{message: message, responder: responder}
+	actorSelf.effect(actorSelf, message)
+	actorSelf.current = nil
+	return false
+}
+
+// handleFailure Apply the SupervisorStrategy(stop if there's none), returns true if the Actor should stop
+func (actorSelf *ActorDef[T]) handleFailure(recovered interface{}) bool {
+	supervisor := actorSelf.supervisor
+	if supervisor == nil {
+		return true
+	}
+
+	directive := SupervisorRestart
+	if supervisor.Decider != nil {
+		directive = supervisor.Decider(recovered)
+	}
+	switch directive {
+	case SupervisorResume:
+		return false
+	case SupervisorStop:
+		return true
+	case SupervisorEscalate:
+		parent := actorSelf.parent
+		if parent != nil && parent.closedCh != nil {
+			select {
+			case parent.failureCh <- recovered:
+			case <-parent.closedCh:
+			}
+		}
+		return true
+	}
+
+	// Restart
+	now := time.Now()
+	if supervisor.Window > 0 {
+		restartTimes := actorSelf.restartTimes[:0]
+		for _, restartTime := range actorSelf.restartTimes {
+			if now.Sub(restartTime) < supervisor.Window {
+				restartTimes = append(restartTimes, restartTime)
+			}
+		}
+		actorSelf.restartTimes = restartTimes
+	}
+	if supervisor.MaxRestarts > 0 && len(actorSelf.restartTimes) >= supervisor.MaxRestarts {
+		return true
+	}
+	actorSelf.restartTimes = append(actorSelf.restartTimes, now)
+
+	if delay := supervisor.Backoff.Delay(len(actorSelf.restartTimes)); delay > 0 {
+		time.Sleep(delay)
+	}
+	actorSelf.resetBehavior()
+	if supervisor.OnRestart != nil {
+		supervisor.OnRestart()
+	}
+	return false
+}
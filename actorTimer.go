@@ -0,0 +1,73 @@
+package fpgo
+
+import (
+	"sync"
+	"time"
+)
+
+// Actor Timers
+
+// SetTimeScheduler Set the TimeScheduler of ScheduleOnce()/SchedulePeriodic()(DefaultTimeScheduler if nil)
+func (actorSelf *ActorDef[T]) SetTimeScheduler(timeScheduler TimeScheduler) *ActorDef[T] {
+	actorSelf.timeScheduler = timeScheduler
+	return actorSelf
+}
+
+func (actorSelf *ActorDef[T]) getTimeScheduler() TimeScheduler {
+	if actorSelf.timeScheduler == nil {
+		return DefaultTimeScheduler
+	}
+	return actorSelf.timeScheduler
+}
+
+// ScheduleOnce Send the message to the Actor itself after the duration
+func (actorSelf *ActorDef[T]) ScheduleOnce(duration time.Duration, message T) TimerHandle {
+	return actorSelf.getTimeScheduler().AfterFunc(duration, func() {
+		actorSelf.Send(message)
+	})
+}
+
+// SchedulePeriodic Send the message to the Actor itself every duration until the handle is stopped or the Actor is closed
+func (actorSelf *ActorDef[T]) SchedulePeriodic(duration time.Duration, message T) TimerHandle {
+	handle := &actorPeriodicTimer{}
+	timeScheduler := actorSelf.getTimeScheduler()
+
+	var tick func()
+	tick = func() {
+		if actorSelf.IsClosed() {
+			handle.Stop()
+			return
+		}
+		actorSelf.Send(message)
+
+		handle.lock.Lock()
+		defer handle.lock.Unlock()
+		if !handle.isStopped {
+			handle.timer = timeScheduler.AfterFunc(duration, tick)
+		}
+	}
+	handle.lock.Lock()
+	handle.timer = timeScheduler.AfterFunc(duration, tick)
+	handle.lock.Unlock()
+
+	return handle
+}
+
+type actorPeriodicTimer struct {
+	lock      sync.Mutex
+	timer     TimerHandle
+	isStopped bool
+}
+
+// Stop Stop the periodic timer, false if it's been stopped
+func (timerSelf *actorPeriodicTimer) Stop() bool {
+	timerSelf.lock.Lock()
+	defer timerSelf.lock.Unlock()
+
+	if timerSelf.isStopped {
+		return false
+	}
+	timerSelf.isStopped = true
+	timerSelf.timer.Stop()
+	return true
+}
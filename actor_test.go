@@ -1,6 +1,9 @@
 package fpgo
 
 import (
+	"context"
+	"errors"
+	"sync"
 	"testing"
 	"time"
 
@@ -131,3 +134,404 @@ func TestActorAsk(t *testing.T) {
 	assert.Equal(t, expectedInt, actual)
 	assert.Equal(t, ErrActorAskTimeout, err)
 }
+
+func TestActorAskFuture(t *testing.T) {
+	errNegative := errors.New("negative")
+	actor := ActorNewGenerics(func(self *ActorDef[int], input int) {
+		responder := self.Responder()
+		if input < 0 {
+			responder.ReplyError(errNegative)
+			return
+		}
+		if input == 0 {
+			responder.Reply("zero")
+			return
+		}
+		// Reply asynchronously
+		go responder.Reply(input * 10)
+	})
+
+	var val int
+	var err error
+	val, err = ActorAsk[int, int](actor, 2).Get()
+	assert.Equal(t, 20, val)
+	assert.NoError(t, err)
+	_, err = ActorAsk[int, int](actor, -1).Get()
+	assert.Equal(t, errNegative, err)
+	_, err = ActorAsk[int, int](actor, 0).Get()
+	assert.Equal(t, ErrActorReplyTypeMismatch, err)
+
+	// No responder for Send()
+	actor.Send(1)
+
+	actor.Close()
+	_, err = ActorAsk[int, int](actor, 1).Get()
+	assert.Equal(t, ErrActorIsClosed, err)
+}
+
+func TestActorSupervisor(t *testing.T) {
+	var err error
+	var val int
+	effect := func(self *ActorDef[int], input int) {
+		if input < 0 {
+			panic("negative")
+		}
+		self.Responder().Reply(input * 10)
+	}
+
+	// Resume
+	actor := ActorNewGenerics(effect).SetSupervisor(&SupervisorStrategy{
+		Decider: func(interface{}) SupervisorDirective {
+			return SupervisorResume
+		},
+	})
+	_, err = ActorAsk[int, int](actor, -1).Get()
+	assert.ErrorIs(t, err, ErrActorPanicked)
+	val, err = ActorAsk[int, int](actor, 1).Get()
+	assert.Equal(t, 10, val)
+	assert.NoError(t, err)
+	actor.Close()
+
+	// Restart until exceeding MaxRestarts
+	restarted := 0
+	actor = ActorNewGenerics(effect).SetSupervisor(&SupervisorStrategy{
+		MaxRestarts: 2,
+		Window:      time.Minute,
+		Backoff:     FixedBackoff(-1, time.Millisecond),
+		OnRestart: func() {
+			restarted++
+		},
+	})
+	for i := 0; i < 3; i++ {
+		_, err = ActorAsk[int, int](actor, -1).Get()
+		assert.ErrorIs(t, err, ErrActorPanicked)
+	}
+	<-actor.closedCh
+	assert.Equal(t, 2, restarted)
+	_, err = ActorAsk[int, int](actor, 1).Get()
+	assert.Equal(t, ErrActorIsClosed, err)
+
+	// Escalate
+	parentFailure := make(chan interface{}, 1)
+	parent := ActorNewGenerics(effect).SetSupervisor(&SupervisorStrategy{
+		Decider: func(recovered interface{}) SupervisorDirective {
+			parentFailure <- recovered
+			return SupervisorResume
+		},
+	})
+	child := parent.Spawn(effect).SetSupervisor(&SupervisorStrategy{
+		Decider: func(interface{}) SupervisorDirective {
+			return SupervisorEscalate
+		},
+	})
+	child.Send(-1)
+	assert.Equal(t, "negative", <-parentFailure)
+	<-child.closedCh
+	val, err = ActorAsk[int, int](parent, 2).Get()
+	assert.Equal(t, 20, val)
+	assert.NoError(t, err)
+	parent.Close()
+}
+
+func TestActorBoundedMailbox(t *testing.T) {
+	runBlockedActor := func(policy MailboxOverflowPolicy) ([]int, []error) {
+		started := make(chan bool)
+		release := make(chan bool)
+		var actual []int
+		actor := ActorNewWithMailbox(func(self *ActorDef[int], input int) {
+			if input == 0 {
+				started <- true
+				<-release
+				return
+			}
+			if input < 0 {
+				self.Responder().Reply(actual)
+				return
+			}
+			actual = append(actual, input)
+		}, 2, policy)
+		defer actor.Close()
+
+		actor.Send(0)
+		<-started
+		var errs []error
+		for i := 1; i <= 3; i++ {
+			errs = append(errs, actor.TrySend(i))
+		}
+		close(release)
+
+		result, _ := ActorAsk[int, []int](actor, -1).Get()
+		return result, errs
+	}
+
+	var actual []int
+	var errs []error
+	actual, errs = runBlockedActor(MailboxOverflowDropNewest)
+	assert.Equal(t, []int{1, 2}, actual)
+	assert.Equal(t, []error{nil, nil, nil}, errs)
+	actual, _ = runBlockedActor(MailboxOverflowDropOldest)
+	assert.Equal(t, []int{2, 3}, actual)
+	actual, errs = runBlockedActor(MailboxOverflowError)
+	assert.Equal(t, []int{1, 2}, actual)
+	assert.Equal(t, []error{nil, nil, ErrActorMailboxIsFull}, errs)
+
+	actor := ActorNewWithMailbox(func(*ActorDef[int], int) {}, 1, MailboxOverflowBlock)
+	actor.Close()
+	assert.Equal(t, ErrActorIsClosed, actor.TrySend(1))
+}
+
+func TestActorChildren(t *testing.T) {
+	effect := func(self *ActorDef[int], input int) {
+		self.Responder().Reply(input)
+	}
+	root := ActorNewGenerics(effect)
+	child := root.SpawnChild(effect)
+	named := root.SpawnNamedChild("worker", effect)
+	grandchild := named.SpawnNamedChild("worker", effect)
+
+	assert.Equal(t, child, root.GetChild(child.GetID()))
+	assert.Equal(t, named, root.GetChildByName("worker"))
+	assert.Equal(t, grandchild, named.GetChildByName("worker"))
+	assert.Equal(t, root, named.GetParent())
+	assert.Nil(t, root.GetChildByName("none"))
+	assert.Equal(t, 2, len(root.GetChildren()))
+
+	// Closing the parent closes children recursively
+	root.Close()
+	assert.Equal(t, true, child.IsClosed())
+	assert.Equal(t, true, named.IsClosed())
+	assert.Equal(t, true, grandchild.IsClosed())
+	assert.Nil(t, root.GetChildByName("worker"))
+	_, err := ActorAsk[int, int](grandchild, 1).Get()
+	assert.Equal(t, ErrActorIsClosed, err)
+}
+
+func TestActorTimers(t *testing.T) {
+	timeScheduler := NewVirtualTimeScheduler(time.Now())
+	received := make(chan string, 10)
+	actor := ActorNewGenerics(func(_ *ActorDef[string], input string) {
+		received <- input
+	}).SetTimeScheduler(timeScheduler)
+
+	actor.ScheduleOnce(10*time.Millisecond, "once")
+	cancelled := actor.ScheduleOnce(10*time.Millisecond, "cancelled")
+	assert.Equal(t, true, cancelled.Stop())
+	heartbeat := actor.SchedulePeriodic(5*time.Millisecond, "heartbeat")
+
+	timeScheduler.Advance(10 * time.Millisecond)
+	assert.Equal(t, "heartbeat", <-received)
+	assert.ElementsMatch(t, []string{"heartbeat", "once"}, []string{<-received, <-received})
+	assert.Equal(t, true, heartbeat.Stop())
+	assert.Equal(t, false, heartbeat.Stop())
+	timeScheduler.Advance(10 * time.Millisecond)
+	assert.Equal(t, 0, len(received))
+
+	// Stopped by closing the Actor
+	actor.SchedulePeriodic(5*time.Millisecond, "heartbeat")
+	actor.Close()
+	timeScheduler.Advance(10 * time.Millisecond)
+	assert.Equal(t, 0, len(timeScheduler.timers))
+}
+
+func TestActorStash(t *testing.T) {
+	isReady := false
+	var handled []string
+	actor := ActorNewGenerics(func(self *ActorDef[string], input string) {
+		switch {
+		case input == "reset":
+			isReady = false
+			handled = nil
+		case input == "ready":
+			isReady = true
+			self.UnstashAll()
+		case !isReady:
+			self.Stash()
+		case input == "done":
+			self.Responder().Reply(handled)
+		default:
+			handled = append(handled, input)
+		}
+	})
+	defer actor.Close()
+
+	actor.Send("a")
+	actor.Send("b")
+	actor.Send("ready")
+	actor.Send("c")
+	result, err := ActorAsk[string, []string](actor, "done").Get()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, result)
+
+	// Stashed asks are replied after unstashed
+	actor.Send("reset")
+	actor.Send("x")
+	future := ActorAsk[string, []string](actor, "done")
+	actor.Send("y")
+	assert.Equal(t, false, future.IsDone())
+	actor.Send("ready")
+	result, _ = future.Get()
+	assert.Equal(t, []string{"x"}, result)
+}
+
+func TestActorDeadLetters(t *testing.T) {
+	release := make(chan bool)
+	actor := ActorNewWithMailbox(func(_ *ActorDef[int], input int) {
+		<-release
+	}, 0, MailboxOverflowError)
+
+	// Only the dead letters of this Actor(DeadLetters is shared by all the Actors)
+	var lock sync.Mutex
+	var deadLetters []DeadLetter
+	s := DeadLetters.Subscribe(Subscription[DeadLetter]{
+		OnNext: func(deadLetter DeadLetter) {
+			if !deadLetter.ActorID.Equal(actor.GetID()) {
+				return
+			}
+			lock.Lock()
+			deadLetters = append(deadLetters, deadLetter)
+			lock.Unlock()
+		},
+	})
+	defer DeadLetters.Unsubscribe(s)
+
+	assert.Equal(t, ErrActorMailboxIsFull, actor.TrySend(1))
+	close(release)
+	actor.Close()
+	actor.Send(2)
+	ActorAsk[int, int](actor, 3)
+
+	lock.Lock()
+	defer lock.Unlock()
+	assert.Equal(t, []DeadLetter{
+		{ActorID: actor.GetID(), Message: 1, Reason: ErrActorMailboxIsFull},
+		{ActorID: actor.GetID(), Message: 2, Reason: ErrActorIsClosed},
+		{ActorID: actor.GetID(), Message: 3, Reason: ErrActorIsClosed},
+	}, deadLetters)
+}
+
+func TestActorBecome(t *testing.T) {
+	var locked, unlocked func(*ActorDef[string], string)
+	locked = func(self *ActorDef[string], input string) {
+		switch input {
+		case "coin":
+			self.Become(unlocked)
+		case "state":
+			self.Responder().Reply("locked")
+		}
+	}
+	unlocked = func(self *ActorDef[string], input string) {
+		switch input {
+		case "push":
+			self.Responder().Reply(self.Unbecome())
+		case "state":
+			self.Responder().Reply("unlocked")
+		case "panic":
+			panic(input)
+		}
+	}
+	actor := ActorNewGenerics(locked).SetSupervisor(&SupervisorStrategy{})
+	defer actor.Close()
+
+	state := func() string {
+		result, _ := ActorAsk[string, string](actor, "state").Get()
+		return result
+	}
+	assert.Equal(t, "locked", state())
+	actor.Send("coin")
+	assert.Equal(t, "unlocked", state())
+	popped, _ := ActorAsk[string, bool](actor, "push").Get()
+	assert.Equal(t, true, popped)
+	assert.Equal(t, "locked", state())
+
+	// Restarted with the initial behavior
+	actor.Send("coin")
+	actor.Send("panic")
+	assert.Equal(t, "locked", state())
+}
+
+func TestActorSendWithContext(t *testing.T) {
+	release := make(chan bool)
+	actor := ActorNewWithMailbox(func(_ *ActorDef[int], input int) {
+		<-release
+	}, 0, MailboxOverflowDropNewest)
+
+	// Occupy the Actor
+	assert.NoError(t, actor.SendWithContext(context.Background(), 1))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, ErrActorSendTimeout, actor.SendWithContext(ctx, 2))
+
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	assert.Equal(t, ErrActorSendCancelled, actor.SendWithContext(ctx, 3))
+
+	close(release)
+	assert.NoError(t, actor.SendWithContext(context.Background(), 4))
+
+	actor.Close()
+	assert.Equal(t, ErrActorIsClosed, actor.SendWithContext(context.Background(), 5))
+}
+
+func TestActorSendCloseConcurrently(t *testing.T) {
+	for i := 0; i < 50; i++ {
+		release := make(chan bool)
+		// Senders are blocked by the full mailbox when it's closed
+		actor := ActorNewWithMailbox(func(_ *ActorDef[int], input int) {
+			<-release
+		}, 1, MailboxOverflowBlock)
+		var wg sync.WaitGroup
+		for j := 0; j < 4; j++ {
+			wg.Add(1)
+			go func(j int) {
+				defer wg.Done()
+				for k := 0; k < 20; k++ {
+					var err error
+					if j%2 == 0 {
+						err = actor.SendWithContext(context.Background(), k)
+					} else {
+						err = actor.TrySend(k)
+					}
+					if err != nil {
+						assert.Equal(t, ErrActorIsClosed, err)
+					}
+				}
+			}(j)
+		}
+		time.Sleep(time.Millisecond / 10)
+		actor.Close()
+		close(release)
+		wg.Wait()
+		assert.Equal(t, ErrActorIsClosed, actor.TrySend(-1))
+	}
+}
+
+func TestActorDropOldestUnbuffered(t *testing.T) {
+	started := make(chan bool)
+	release := make(chan bool)
+	defer close(release)
+	// The handler is stuck, nothing could be dropped from the unbuffered mailbox
+	actor := ActorNewWithMailbox(func(_ *ActorDef[int], input int) {
+		started <- true
+		<-release
+	}, 0, MailboxOverflowDropOldest)
+	actor.Send(0)
+	<-started
+
+	sent := make(chan error)
+	go func() {
+		sent <- actor.TrySend(1)
+	}()
+	time.Sleep(time.Millisecond)
+	closed := make(chan bool)
+	go func() {
+		actor.Close()
+		close(closed)
+	}()
+	select {
+	case <-closed:
+	case <-time.After(time.Second):
+		assert.Fail(t, "Close() is blocked by the sender")
+	}
+	assert.Equal(t, ErrActorIsClosed, <-sent)
+}
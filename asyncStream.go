@@ -0,0 +1,219 @@
+package fpgo
+
+import (
+	"context"
+	"sync"
+)
+
+// AsyncStream
+
+// AsyncStreamOption Options of AsyncStream
+type AsyncStreamOption struct {
+	// BufferSize The buffer size of the channels between stages(unbuffered if <= 0)
+	BufferSize int
+	// Scheduler Run the stages(GoroutineScheduler if nil, e.g. a worker.WorkerPool)
+	//
+	// NOTE: every stage occupies a running job until the stream is done, so the Scheduler should run all the stages concurrently.
+	Scheduler Scheduler
+}
+
+// AsyncStream Stream whose stages run concurrently(each on its own job of the Scheduler) connected by bounded channels,
+// it's lazy until Collect() is called and the item order is kept
+type AsyncStream[T any] struct {
+	option AsyncStreamOption
+	start  func(run *asyncStreamRun) <-chan T
+}
+
+// asyncStreamRun The states shared by the stages of a Collect() call
+type asyncStreamRun struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	lock sync.Mutex
+	err  error
+}
+
+// fail Stop the run with the first error
+func (runSelf *asyncStreamRun) fail(err error) {
+	runSelf.lock.Lock()
+	defer runSelf.lock.Unlock()
+	if runSelf.err == nil {
+		runSelf.err = err
+		runSelf.cancel()
+	}
+}
+
+// AsyncStreamFrom New AsyncStream instance from items
+func AsyncStreamFrom[T any](list ...T) *AsyncStream[T] {
+	return AsyncStreamFromArray(list)
+}
+
+// AsyncStreamFromArray New AsyncStream instance from a T array
+func AsyncStreamFromArray[T any](list []T, opts ...AsyncStreamOption) *AsyncStream[T] {
+	return asyncStreamSource(func(ctx context.Context, emit func(T) bool) {
+		for _, item := range list {
+			if !emit(item) {
+				return
+			}
+		}
+	}, opts...)
+}
+
+// AsyncStreamFromChannel New AsyncStream instance from items received from the channel(until it's closed)
+func AsyncStreamFromChannel[T any](ch <-chan T, opts ...AsyncStreamOption) *AsyncStream[T] {
+	return asyncStreamSource(func(ctx context.Context, emit func(T) bool) {
+		for {
+			select {
+			case item, ok := <-ch:
+				if !ok || !emit(item) {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}, opts...)
+}
+
+// AsyncStreamMap Map the items by fn on its own stage(the result type could be different)
+func AsyncStreamMap[T any, R any](asyncStream *AsyncStream[T], fn func(T) R) *AsyncStream[R] {
+	return asyncStreamChain(asyncStream, func(in T, emit func(R) bool) bool {
+		return emit(fn(in))
+	})
+}
+
+// AsyncStreamMapErr Map the items by fn on its own stage, the first error stops the stream(returned by Collect())
+func AsyncStreamMapErr[T any, R any](asyncStream *AsyncStream[T], fn func(context.Context, T) (R, error)) *AsyncStream[R] {
+	return asyncStreamChainWithRun(asyncStream, func(run *asyncStreamRun, in T, emit func(R) bool) bool {
+		result, err := fn(run.ctx, in)
+		if err != nil {
+			run.fail(err)
+			return false
+		}
+		return emit(result)
+	})
+}
+
+// Map Map the items by fn on its own stage
+func (asyncStreamSelf *AsyncStream[T]) Map(fn func(T) T) *AsyncStream[T] {
+	return AsyncStreamMap(asyncStreamSelf, fn)
+}
+
+// Filter Filter the items by the predicate on its own stage
+func (asyncStreamSelf *AsyncStream[T]) Filter(fn Predicate[T]) *AsyncStream[T] {
+	return asyncStreamChain(asyncStreamSelf, func(in T, emit func(T) bool) bool {
+		if fn(in) {
+			return emit(in)
+		}
+		return true
+	})
+}
+
+// Collect Run all the stages & gather the items, or get the first error(of the stages, the Scheduler or the ctx)
+func (asyncStreamSelf *AsyncStream[T]) Collect(ctx context.Context) ([]T, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	run := &asyncStreamRun{ctx: ctx, cancel: cancel}
+	defer cancel()
+
+	result := make([]T, 0)
+	for item := range asyncStreamSelf.start(run) {
+		result = append(result, item)
+	}
+
+	run.lock.Lock()
+	err := run.err
+	run.lock.Unlock()
+	if err == nil {
+		err = ctx.Err()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// asyncStreamSource New AsyncStream by the producer, emit() is false once the run is stopped
+func asyncStreamSource[T any](produce func(ctx context.Context, emit func(T) bool), opts ...AsyncStreamOption) *AsyncStream[T] {
+	var option AsyncStreamOption
+	if len(opts) > 0 {
+		option = opts[0]
+	}
+	if option.Scheduler == nil {
+		option.Scheduler = GoroutineScheduler
+	}
+
+	return &AsyncStream[T]{
+		option: option,
+		start: func(run *asyncStreamRun) <-chan T {
+			out := make(chan T, asyncStreamBufferSize(option))
+			asyncStreamSpawn(run, option, out, func() {
+				produce(run.ctx, asyncStreamEmitter(run, out))
+			})
+			return out
+		},
+	}
+}
+
+// asyncStreamChain New a stage from the upstream, onNext returns false to stop the stage
+func asyncStreamChain[T any, R any](upstream *AsyncStream[T], onNext func(in T, emit func(R) bool) bool) *AsyncStream[R] {
+	return asyncStreamChainWithRun(upstream, func(run *asyncStreamRun, in T, emit func(R) bool) bool {
+		return onNext(in, emit)
+	})
+}
+
+// asyncStreamChainWithRun asyncStreamChain with the states of the run
+func asyncStreamChainWithRun[T any, R any](upstream *AsyncStream[T], onNext func(run *asyncStreamRun, in T, emit func(R) bool) bool) *AsyncStream[R] {
+	option := upstream.option
+	return &AsyncStream[R]{
+		option: option,
+		start: func(run *asyncStreamRun) <-chan R {
+			in := upstream.start(run)
+			out := make(chan R, asyncStreamBufferSize(option))
+			asyncStreamSpawn(run, option, out, func() {
+				emit := asyncStreamEmitter(run, out)
+				for {
+					select {
+					case item, ok := <-in:
+						if !ok || !onNext(run, item, emit) {
+							return
+						}
+					case <-run.ctx.Done():
+						return
+					}
+				}
+			})
+			return out
+		},
+	}
+}
+
+// asyncStreamSpawn Run the stage by the Scheduler, out is closed once it's done(or rejected by the Scheduler)
+func asyncStreamSpawn[T any](run *asyncStreamRun, option AsyncStreamOption, out chan T, stage func()) {
+	err := option.Scheduler.Schedule(func() {
+		defer close(out)
+		stage()
+	})
+	if err != nil {
+		run.fail(err)
+		close(out)
+	}
+}
+
+// asyncStreamEmitter Send the item to out, false if the run has been stopped
+func asyncStreamEmitter[T any](run *asyncStreamRun, out chan<- T) func(T) bool {
+	return func(item T) bool {
+		select {
+		case out <- item:
+			return true
+		case <-run.ctx.Done():
+			return false
+		}
+	}
+}
+
+func asyncStreamBufferSize(option AsyncStreamOption) int {
+	if option.BufferSize < 0 {
+		return 0
+	}
+	return option.BufferSize
+}
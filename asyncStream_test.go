@@ -0,0 +1,110 @@
+package fpgo
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAsyncStream(t *testing.T) {
+	actual, err := AsyncStreamMap(AsyncStreamFrom(1, 2, 3, 4, 5).Filter(func(v int) bool {
+		return v%2 == 1
+	}).Map(func(v int) int {
+		return v * 10
+	}), strconv.Itoa).Collect(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"10", "30", "50"}, actual)
+
+	actual, err = AsyncStreamMap(AsyncStreamFrom[int](), strconv.Itoa).Collect(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, actual)
+
+	ch := make(chan int)
+	go func() {
+		defer close(ch)
+		for i := 1; i <= 3; i++ {
+			ch <- i
+		}
+	}()
+	numbers, err := AsyncStreamFromChannel(ch, AsyncStreamOption{BufferSize: 2}).Map(func(v int) int {
+		return v + 1
+	}).Collect(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []int{2, 3, 4}, numbers)
+}
+
+func TestAsyncStreamConcurrentStages(t *testing.T) {
+	var lock sync.Mutex
+	running := map[string]bool{}
+	overlapped := false
+	stage := func(name string, other string) func(int) int {
+		return func(v int) int {
+			lock.Lock()
+			running[name] = true
+			if running[other] {
+				overlapped = true
+			}
+			lock.Unlock()
+			time.Sleep(2 * time.Millisecond)
+			lock.Lock()
+			running[name] = false
+			lock.Unlock()
+			return v
+		}
+	}
+
+	actual, err := AsyncStreamFromArray([]int{1, 2, 3, 4, 5, 6}, AsyncStreamOption{BufferSize: 1}).
+		Map(stage("a", "b")).
+		Map(stage("b", "a")).
+		Collect(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6}, actual)
+	// Pipeline parallelism
+	assert.True(t, overlapped)
+}
+
+func TestAsyncStreamErrors(t *testing.T) {
+	errOdd := errors.New("odd")
+	actual, err := AsyncStreamMapErr(AsyncStreamFrom(2, 4, 5, 6), func(ctx context.Context, v int) (int, error) {
+		if v%2 == 1 {
+			return 0, errOdd
+		}
+		return v, nil
+	}).Collect(context.Background())
+	assert.Equal(t, errOdd, err)
+	assert.Nil(t, actual)
+
+	// Canceled
+	ctx, cancel := context.WithCancel(context.Background())
+	never := make(chan int)
+	go func() {
+		time.Sleep(time.Millisecond)
+		cancel()
+	}()
+	actual, err = AsyncStreamFromChannel(never).Map(func(v int) int {
+		return v
+	}).Collect(ctx)
+	assert.Equal(t, context.Canceled, err)
+	assert.Nil(t, actual)
+
+	// Rejected by the Scheduler
+	h := Handler.New()
+	h.Close()
+	actual, err = AsyncStreamFromArray([]int{1}, AsyncStreamOption{Scheduler: h}).Map(func(v int) int {
+		return v
+	}).Collect(context.Background())
+	assert.Equal(t, ErrHandlerIsClosed, err)
+	assert.Nil(t, actual)
+
+	// Run on the Handler
+	h = Handler.New()
+	defer h.Close()
+	numbers, err := AsyncStreamFromArray([]int{1, 2}, AsyncStreamOption{Scheduler: h}).Collect(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, numbers)
+}
@@ -0,0 +1,228 @@
+package fpgo
+
+import (
+	"math"
+	"sync/atomic"
+)
+
+// Atom
+
+// AtomBool Atomic Bool
+type AtomBool struct{ flag int32 }
+
+// Set Set the bool atomically
+func (atomBoolSelf *AtomBool) Set(value bool) {
+	var i int32
+	i = 0
+	if value {
+		i = 1
+	}
+	atomic.StoreInt32(&(atomBoolSelf.flag), int32(i))
+}
+
+// Get Get the bool atomically
+func (atomBoolSelf *AtomBool) Get() bool {
+	if atomic.LoadInt32(&(atomBoolSelf.flag)) != 0 {
+		return true
+	}
+	return false
+}
+
+// atomBox Box for atomic.Value(values of different concrete types/nil interfaces could be stored)
+type atomBox[T any] struct {
+	val T
+}
+
+// Atom Atomic value of T backed by atomic.Value(the zero value holds the zero T)
+type Atom[T any] struct {
+	value atomic.Value
+}
+
+// NewAtom New Atom with the initial value
+func NewAtom[T any](val T) *Atom[T] {
+	atom := &Atom[T]{}
+	atom.Store(val)
+	return atom
+}
+
+// Load Get the value atomically
+func (atomSelf *Atom[T]) Load() T {
+	box, ok := atomSelf.value.Load().(*atomBox[T])
+	if !ok {
+		return *new(T)
+	}
+	return box.val
+}
+
+// Store Set the value atomically
+func (atomSelf *Atom[T]) Store(val T) {
+	atomSelf.value.Store(&atomBox[T]{val: val})
+}
+
+// Swap Set the value atomically and return the old one
+func (atomSelf *Atom[T]) Swap(val T) T {
+	box, ok := atomSelf.value.Swap(&atomBox[T]{val: val}).(*atomBox[T])
+	if !ok {
+		return *new(T)
+	}
+	return box.val
+}
+
+// AtomRef
+
+// AtomRef Atomic reference(*T) supporting CompareAndSwap by identity & Update retry loops(the zero value holds nil)
+type AtomRef[T any] struct {
+	value atomic.Value
+}
+
+// NewAtomRef New AtomRef with the initial reference
+func NewAtomRef[T any](ref *T) *AtomRef[T] {
+	atomRef := &AtomRef[T]{}
+	atomRef.Store(ref)
+	return atomRef
+}
+
+// Load Get the reference atomically
+func (atomRefSelf *AtomRef[T]) Load() *T {
+	ref, _ := atomRefSelf.value.Load().(*T)
+	return ref
+}
+
+// Store Set the reference atomically
+func (atomRefSelf *AtomRef[T]) Store(ref *T) {
+	atomRefSelf.value.Store(ref)
+}
+
+// Swap Set the reference atomically and return the old one
+func (atomRefSelf *AtomRef[T]) Swap(ref *T) *T {
+	old, _ := atomRefSelf.value.Swap(ref).(*T)
+	return old
+}
+
+// CompareAndSwap Set the reference to new only if it's still old(by identity)
+func (atomRefSelf *AtomRef[T]) CompareAndSwap(old *T, new *T) bool {
+	if old == nil {
+		// The zero value holds no reference yet
+		if atomRefSelf.value.CompareAndSwap(nil, new) {
+			return true
+		}
+	}
+	return atomRefSelf.value.CompareAndSwap(old, new)
+}
+
+// Update Replace the value by fn(the current value) with a CompareAndSwap retry loop and return the new value
+//
+// NOTE: fn may be called more than once under contention, it should be pure(the zero T is given for nil)
+func (atomRefSelf *AtomRef[T]) Update(fn func(T) T) T {
+	for {
+		old := atomRefSelf.Load()
+		var val T
+		if old != nil {
+			val = *old
+		}
+		val = fn(val)
+		if atomRefSelf.CompareAndSwap(old, &val) {
+			return val
+		}
+	}
+}
+
+// AtomInt64
+
+// AtomInt64 Atomic int64
+type AtomInt64 struct {
+	// NOTE: the first word is 64-bit aligned even on 32-bit platforms
+	val int64
+}
+
+// NewAtomInt64 New AtomInt64 with the initial value
+func NewAtomInt64(val int64) *AtomInt64 {
+	return &AtomInt64{val: val}
+}
+
+// Load Get the value atomically
+func (atomSelf *AtomInt64) Load() int64 {
+	return atomic.LoadInt64(&atomSelf.val)
+}
+
+// Store Set the value atomically
+func (atomSelf *AtomInt64) Store(val int64) {
+	atomic.StoreInt64(&atomSelf.val, val)
+}
+
+// Swap Set the value atomically and return the old one
+func (atomSelf *AtomInt64) Swap(val int64) int64 {
+	return atomic.SwapInt64(&atomSelf.val, val)
+}
+
+// Add Add delta atomically and return the new value
+func (atomSelf *AtomInt64) Add(delta int64) int64 {
+	return atomic.AddInt64(&atomSelf.val, delta)
+}
+
+// CompareAndSwap Set the value to new only if it's still old
+func (atomSelf *AtomInt64) CompareAndSwap(old int64, new int64) bool {
+	return atomic.CompareAndSwapInt64(&atomSelf.val, old, new)
+}
+
+// Update Replace the value by fn(the current value) with a CompareAndSwap retry loop and return the new value
+func (atomSelf *AtomInt64) Update(fn func(int64) int64) int64 {
+	for {
+		old := atomSelf.Load()
+		val := fn(old)
+		if atomSelf.CompareAndSwap(old, val) {
+			return val
+		}
+	}
+}
+
+// AtomFloat64
+
+// AtomFloat64 Atomic float64(stored as IEEE 754 bits)
+type AtomFloat64 struct {
+	// NOTE: the first word is 64-bit aligned even on 32-bit platforms
+	bits uint64
+}
+
+// NewAtomFloat64 New AtomFloat64 with the initial value
+func NewAtomFloat64(val float64) *AtomFloat64 {
+	return &AtomFloat64{bits: math.Float64bits(val)}
+}
+
+// Load Get the value atomically
+func (atomSelf *AtomFloat64) Load() float64 {
+	return math.Float64frombits(atomic.LoadUint64(&atomSelf.bits))
+}
+
+// Store Set the value atomically
+func (atomSelf *AtomFloat64) Store(val float64) {
+	atomic.StoreUint64(&atomSelf.bits, math.Float64bits(val))
+}
+
+// Swap Set the value atomically and return the old one
+func (atomSelf *AtomFloat64) Swap(val float64) float64 {
+	return math.Float64frombits(atomic.SwapUint64(&atomSelf.bits, math.Float64bits(val)))
+}
+
+// Add Add delta atomically(by a CompareAndSwap retry loop) and return the new value
+func (atomSelf *AtomFloat64) Add(delta float64) float64 {
+	return atomSelf.Update(func(val float64) float64 {
+		return val + delta
+	})
+}
+
+// CompareAndSwap Set the value to new only if it's still old(compared by bits, so NaN could be swapped)
+func (atomSelf *AtomFloat64) CompareAndSwap(old float64, new float64) bool {
+	return atomic.CompareAndSwapUint64(&atomSelf.bits, math.Float64bits(old), math.Float64bits(new))
+}
+
+// Update Replace the value by fn(the current value) with a CompareAndSwap retry loop and return the new value
+func (atomSelf *AtomFloat64) Update(fn func(float64) float64) float64 {
+	for {
+		oldBits := atomic.LoadUint64(&atomSelf.bits)
+		val := fn(math.Float64frombits(oldBits))
+		if atomic.CompareAndSwapUint64(&atomSelf.bits, oldBits, math.Float64bits(val)) {
+			return val
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package fpgo
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAtom(t *testing.T) {
+	var atom Atom[error]
+	assert.Equal(t, nil, atom.Load())
+	atom.Store(ErrQueueIsEmpty)
+	assert.Equal(t, ErrQueueIsEmpty, atom.Load())
+	// Different concrete types & nil are allowed
+	assert.Equal(t, ErrQueueIsEmpty, atom.Swap(ErrMatchNotFound))
+	assert.Equal(t, ErrMatchNotFound, atom.Swap(nil))
+	assert.Equal(t, nil, atom.Load())
+	assert.Equal(t, "a", NewAtom("a").Load())
+}
+
+func TestAtomRef(t *testing.T) {
+	var atomRef AtomRef[[]int]
+	assert.Nil(t, atomRef.Load())
+	assert.Equal(t, []int{1}, atomRef.Update(func(list []int) []int {
+		return append(list, 1)
+	}))
+
+	first := atomRef.Load()
+	second := &[]int{2}
+	assert.Equal(t, false, atomRef.CompareAndSwap(second, second))
+	assert.Equal(t, true, atomRef.CompareAndSwap(first, second))
+	assert.Equal(t, second, atomRef.Swap(nil))
+	assert.Nil(t, atomRef.Load())
+	assert.Equal(t, true, atomRef.CompareAndSwap(nil, second))
+
+	counter := NewAtomRef(&[]int{})
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			counter.Update(func(list []int) []int {
+				return Concat(list, []int{i})
+			})
+		}(i)
+	}
+	wg.Wait()
+	assert.Equal(t, 50, len(*counter.Load()))
+}
+
+func TestAtomNumber(t *testing.T) {
+	atomInt := NewAtomInt64(1)
+	assert.Equal(t, int64(3), atomInt.Add(2))
+	assert.Equal(t, false, atomInt.CompareAndSwap(1, 10))
+	assert.Equal(t, true, atomInt.CompareAndSwap(3, 10))
+	assert.Equal(t, int64(10), atomInt.Swap(5))
+	assert.Equal(t, int64(25), atomInt.Update(func(v int64) int64 {
+		return v * v
+	}))
+	atomInt.Store(0)
+
+	atomFloat := NewAtomFloat64(0.5)
+	assert.Equal(t, 1.0, atomFloat.Add(0.5))
+	assert.Equal(t, false, atomFloat.CompareAndSwap(0.5, 2))
+	assert.Equal(t, true, atomFloat.CompareAndSwap(1, 2))
+	assert.Equal(t, 2.0, atomFloat.Swap(0))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			atomInt.Add(1)
+			atomFloat.Add(0.5)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int64(100), atomInt.Load())
+	assert.Equal(t, 50.0, atomFloat.Load())
+}
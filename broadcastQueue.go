@@ -0,0 +1,265 @@
+package fpgo
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// BroadcastQueue
+
+// BroadcastQueue Queue delivering every offered item to all consumers(each one has its own cursor), unlike the competing consumers of ChannelQueue
+//
+// NOTE: an item is kept until all consumers have taken it, Offer() fails by ErrQueueIsFull when the slowest one lags by the capacity.
+type BroadcastQueue[T any] struct {
+	lock     sync.Mutex
+	isClosed bool
+	capacity int
+
+	items     []T
+	base      int64
+	consumers map[*BroadcastConsumer[T]]bool
+
+	changedCh chan struct{}
+}
+
+// BroadcastConsumer A consumer of BroadcastQueue receiving items offered after it subscribed
+type BroadcastConsumer[T any] struct {
+	queue    *BroadcastQueue[T]
+	cursor   int64
+	isClosed bool
+}
+
+// NewBroadcastQueue New BroadcastQueue instance with capacity(unbounded if <= 0)
+func NewBroadcastQueue[T any](capacity int) *BroadcastQueue[T] {
+	return &BroadcastQueue[T]{
+		capacity:  capacity,
+		consumers: map[*BroadcastConsumer[T]]bool{},
+		changedCh: make(chan struct{}),
+	}
+}
+
+// Subscribe New a BroadcastConsumer receiving the items offered from now on
+func (q *BroadcastQueue[T]) Subscribe() *BroadcastConsumer[T] {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	consumer := &BroadcastConsumer[T]{queue: q, cursor: q.base + int64(len(q.items))}
+	if q.isClosed {
+		consumer.isClosed = true
+		return consumer
+	}
+	q.consumers[consumer] = true
+	return consumer
+}
+
+// Put Put the T val(blocking until the slowest consumer catches up)
+func (q *BroadcastQueue[T]) Put(val T) error {
+	return q.put(context.Background(), val, nil)
+}
+
+// PutWithTimeout Put the T val(blocking), with timeout
+func (q *BroadcastQueue[T]) PutWithTimeout(val T, timeout time.Duration) error {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	return q.put(context.Background(), val, timer.C)
+}
+
+func (q *BroadcastQueue[T]) put(ctx context.Context, val T, timeoutCh <-chan time.Time) error {
+	for {
+		q.lock.Lock()
+		changedCh := q.changedCh
+		err := q.offer(val)
+		q.lock.Unlock()
+		if err != ErrQueueIsFull {
+			return err
+		}
+
+		select {
+		case <-changedCh:
+		case <-timeoutCh:
+			return ErrQueuePutTimeout
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// OfferWithContext Put the T val, blocking until the slowest consumer catches up or the context is done(returning ctx.Err())
+func (q *BroadcastQueue[T]) OfferWithContext(ctx context.Context, val T) error {
+	return q.put(ctx, val, nil)
+}
+
+// Offer Offer the T val to all consumers(non-blocking)
+func (q *BroadcastQueue[T]) Offer(val T) error {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	return q.offer(val)
+}
+
+func (q *BroadcastQueue[T]) offer(val T) error {
+	if q.isClosed {
+		return ErrQueueIsClosed
+	}
+	if q.capacity > 0 && len(q.items) >= q.capacity {
+		return ErrQueueIsFull
+	}
+
+	// Nobody receives it
+	if len(q.consumers) == 0 {
+		q.base++
+		return nil
+	}
+
+	q.items = append(q.items, val)
+	q.notify()
+	return nil
+}
+
+// ConsumerCount Get the number of the subscribed consumers
+func (q *BroadcastQueue[T]) ConsumerCount() int {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	return len(q.consumers)
+}
+
+// IsClosed Is the BroadcastQueue closed
+func (q *BroadcastQueue[T]) IsClosed() bool {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	return q.isClosed
+}
+
+// Close Close the BroadcastQueue, consumers get ErrQueueIsClosed after taking the remaining items
+func (q *BroadcastQueue[T]) Close() {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if q.isClosed {
+		return
+	}
+	q.isClosed = true
+	q.notify()
+}
+
+// notify Wake up all waiting ones(locked by the caller)
+func (q *BroadcastQueue[T]) notify() {
+	close(q.changedCh)
+	q.changedCh = make(chan struct{})
+}
+
+// trim Drop the items taken by all consumers(locked by the caller)
+func (q *BroadcastQueue[T]) trim() {
+	minCursor := q.base + int64(len(q.items))
+	for consumer := range q.consumers {
+		if consumer.cursor < minCursor {
+			minCursor = consumer.cursor
+		}
+	}
+	if dropped := int(minCursor - q.base); dropped > 0 {
+		var zero T
+		for i := 0; i < dropped; i++ {
+			q.items[i] = zero
+		}
+		q.items = q.items[dropped:]
+		q.base = minCursor
+		q.notify()
+	}
+}
+
+// Poll Poll the next T val(non-blocking)
+func (consumer *BroadcastConsumer[T]) Poll() (T, error) {
+	q := consumer.queue
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	return consumer.poll()
+}
+
+func (consumer *BroadcastConsumer[T]) poll() (T, error) {
+	q := consumer.queue
+	if consumer.isClosed {
+		return *new(T), ErrQueueIsClosed
+	}
+	if consumer.cursor >= q.base+int64(len(q.items)) {
+		if q.isClosed {
+			return *new(T), ErrQueueIsClosed
+		}
+		return *new(T), ErrQueueIsEmpty
+	}
+
+	val := q.items[consumer.cursor-q.base]
+	consumer.cursor++
+	q.trim()
+	return val, nil
+}
+
+// Take Take the next T val(blocking)
+func (consumer *BroadcastConsumer[T]) Take() (T, error) {
+	return consumer.take(context.Background(), nil)
+}
+
+// TakeWithTimeout Take the next T val(blocking), with timeout
+func (consumer *BroadcastConsumer[T]) TakeWithTimeout(timeout time.Duration) (T, error) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	return consumer.take(context.Background(), timer.C)
+}
+
+func (consumer *BroadcastConsumer[T]) take(ctx context.Context, timeoutCh <-chan time.Time) (T, error) {
+	q := consumer.queue
+	for {
+		q.lock.Lock()
+		changedCh := q.changedCh
+		val, err := consumer.poll()
+		q.lock.Unlock()
+		if err != ErrQueueIsEmpty {
+			return val, err
+		}
+
+		select {
+		case <-changedCh:
+		case <-timeoutCh:
+			return *new(T), ErrQueueTakeTimeout
+		case <-ctx.Done():
+			return *new(T), ctx.Err()
+		}
+	}
+}
+
+// TakeWithContext Take the next T val, blocking until there's one or the context is done(returning ctx.Err())
+func (consumer *BroadcastConsumer[T]) TakeWithContext(ctx context.Context) (T, error) {
+	return consumer.take(ctx, nil)
+}
+
+// Count Count the items not taken by the consumer yet
+func (consumer *BroadcastConsumer[T]) Count() int {
+	q := consumer.queue
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if consumer.isClosed {
+		return 0
+	}
+	return int(q.base + int64(len(q.items)) - consumer.cursor)
+}
+
+// Close Unsubscribe the BroadcastQueue, the items aren't kept for it anymore
+func (consumer *BroadcastConsumer[T]) Close() {
+	q := consumer.queue
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if consumer.isClosed {
+		return
+	}
+	consumer.isClosed = true
+	delete(q.consumers, consumer)
+	q.trim()
+	q.notify()
+}
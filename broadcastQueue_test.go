@@ -0,0 +1,71 @@
+package fpgo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBroadcastQueue(t *testing.T) {
+	broadcastQueue := NewBroadcastQueue[int](2)
+	// Nobody receives it
+	assert.NoError(t, broadcastQueue.Offer(0))
+
+	a := broadcastQueue.Subscribe()
+	b := broadcastQueue.Subscribe()
+	assert.Equal(t, 2, broadcastQueue.ConsumerCount())
+	assert.NoError(t, broadcastQueue.Offer(1))
+	assert.NoError(t, broadcastQueue.Offer(2))
+	assert.Equal(t, ErrQueueIsFull, broadcastQueue.Offer(3))
+
+	// Each consumer receives every item
+	for _, consumer := range []*BroadcastConsumer[int]{a, b} {
+		val, _ := consumer.Poll()
+		assert.Equal(t, 1, val)
+	}
+	assert.NoError(t, broadcastQueue.Offer(3))
+	assert.Equal(t, ErrQueuePutTimeout, broadcastQueue.PutWithTimeout(4, time.Millisecond))
+	val, _ := a.Take()
+	assert.Equal(t, 2, val)
+	val, _ = a.Take()
+	assert.Equal(t, 3, val)
+	_, err := a.Poll()
+	assert.Equal(t, ErrQueueIsEmpty, err)
+	_, err = a.TakeWithTimeout(time.Millisecond)
+	assert.Equal(t, ErrQueueTakeTimeout, err)
+	assert.Equal(t, 2, b.Count())
+
+	// Blocked by the slowest one
+	putDone := make(chan error)
+	go func() {
+		putDone <- broadcastQueue.Put(4)
+	}()
+	val, _ = b.Take()
+	assert.Equal(t, 2, val)
+	assert.NoError(t, <-putDone)
+
+	// Closed consumers don't block
+	b.Close()
+	assert.Equal(t, 1, broadcastQueue.ConsumerCount())
+	assert.Equal(t, 0, b.Count())
+	_, err = b.Poll()
+	assert.Equal(t, ErrQueueIsClosed, err)
+	assert.NoError(t, broadcastQueue.Offer(5))
+
+	go func() {
+		time.Sleep(time.Millisecond)
+		broadcastQueue.Close()
+	}()
+	var actual []int
+	for {
+		val, err := a.Take()
+		if err != nil {
+			assert.Equal(t, ErrQueueIsClosed, err)
+			break
+		}
+		actual = append(actual, val)
+	}
+	assert.Equal(t, []int{4, 5}, actual)
+	assert.Equal(t, ErrQueueIsClosed, broadcastQueue.Offer(6))
+}
@@ -0,0 +1,365 @@
+package fpgo
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// Cache
+
+// CacheOption Options for LRUCache & LFUCache
+type CacheOption struct {
+	// TTL The default TTL of the entries(never expire if <= 0)
+	TTL time.Duration
+	// TimeScheduler The clock of the TTL(DefaultTimeScheduler if nil)
+	TimeScheduler TimeScheduler
+}
+
+type cacheEntry[K comparable, V any] struct {
+	key       K
+	val       V
+	expiresAt time.Time
+	freq      int
+}
+
+// isExpired Check the entry is expired or not at the time
+func (entrySelf *cacheEntry[K, V]) isExpired(now time.Time) bool {
+	return !entrySelf.expiresAt.IsZero() && !now.Before(entrySelf.expiresAt)
+}
+
+// cacheBase Shared parts of LRUCache & LFUCache
+type cacheBase[K comparable, V any] struct {
+	lock     sync.Mutex
+	capacity int
+	option   CacheOption
+	entries  map[K]*list.Element
+	onEvict  func(K, V)
+
+	loads SingleFlight[K, V]
+}
+
+func newCacheBase[K comparable, V any](capacity int, opts ...CacheOption) cacheBase[K, V] {
+	var option CacheOption
+	if len(opts) > 0 {
+		option = opts[0]
+	}
+	if option.TimeScheduler == nil {
+		option.TimeScheduler = DefaultTimeScheduler
+	}
+
+	return cacheBase[K, V]{
+		capacity: capacity,
+		option:   option,
+		entries:  map[K]*list.Element{},
+	}
+}
+
+// newEntry New entry expiring after the ttl(never if <= 0)
+func (cacheSelf *cacheBase[K, V]) newEntry(key K, val V, ttl time.Duration) *cacheEntry[K, V] {
+	entry := &cacheEntry[K, V]{key: key, val: val}
+	if ttl > 0 {
+		entry.expiresAt = cacheSelf.option.TimeScheduler.Now().Add(ttl)
+	}
+	return entry
+}
+
+// notifyEvicted Call onEvict with the evicted entries(outside of the lock)
+func (cacheSelf *cacheBase[K, V]) notifyEvicted(evicted []*cacheEntry[K, V]) {
+	cacheSelf.lock.Lock()
+	onEvict := cacheSelf.onEvict
+	cacheSelf.lock.Unlock()
+
+	if onEvict == nil {
+		return
+	}
+	for _, entry := range evicted {
+		onEvict(entry.key, entry.val)
+	}
+}
+
+// getOrCompute Get the cached value, or load it once for the concurrent callers of the same key
+func (cacheSelf *cacheBase[K, V]) getOrCompute(key K, get func(K) (V, bool), set func(K, V), loader func(K) (V, error)) (V, error) {
+	if val, ok := get(key); ok {
+		return val, nil
+	}
+
+	return cacheSelf.loads.Dedupe(key, func() (V, error) {
+		// Loaded by the previous load
+		if val, ok := get(key); ok {
+			return val, nil
+		}
+		val, err := loader(key)
+		if err == nil {
+			set(key, val)
+		}
+		return val, err
+	})
+}
+
+// LRUCache
+
+// LRUCache Cache evicting the least recently used entries over the capacity(concurrency-safe)
+type LRUCache[K comparable, V any] struct {
+	cacheBase[K, V]
+	order *list.List
+}
+
+// NewLRUCache New LRUCache instance with the capacity(unlimited if <= 0)
+func NewLRUCache[K comparable, V any](capacity int, opts ...CacheOption) *LRUCache[K, V] {
+	return &LRUCache[K, V]{
+		cacheBase: newCacheBase[K, V](capacity, opts...),
+		order:     list.New(),
+	}
+}
+
+// SetOnEvict Set the callback of the entries evicted by the capacity or the TTL(not by Delete())
+func (cacheSelf *LRUCache[K, V]) SetOnEvict(onEvict func(K, V)) *LRUCache[K, V] {
+	cacheSelf.lock.Lock()
+	defer cacheSelf.lock.Unlock()
+
+	cacheSelf.onEvict = onEvict
+	return cacheSelf
+}
+
+// Get Get the value of the key, false if it doesn't exist or it's expired
+func (cacheSelf *LRUCache[K, V]) Get(key K) (V, bool) {
+	var evicted []*cacheEntry[K, V]
+	defer func() {
+		cacheSelf.notifyEvicted(evicted)
+	}()
+
+	cacheSelf.lock.Lock()
+	defer cacheSelf.lock.Unlock()
+
+	var zero V
+	element, ok := cacheSelf.entries[key]
+	if !ok {
+		return zero, false
+	}
+	entry := element.Value.(*cacheEntry[K, V])
+	if entry.isExpired(cacheSelf.option.TimeScheduler.Now()) {
+		cacheSelf.removeElement(element)
+		evicted = append(evicted, entry)
+		return zero, false
+	}
+
+	cacheSelf.order.MoveToFront(element)
+	return entry.val, true
+}
+
+// Set Set the value of the key with the default TTL
+func (cacheSelf *LRUCache[K, V]) Set(key K, val V) {
+	cacheSelf.SetWithTTL(key, val, cacheSelf.option.TTL)
+}
+
+// SetWithTTL Set the value of the key expiring after the ttl(never if <= 0)
+func (cacheSelf *LRUCache[K, V]) SetWithTTL(key K, val V, ttl time.Duration) {
+	var evicted []*cacheEntry[K, V]
+	defer func() {
+		cacheSelf.notifyEvicted(evicted)
+	}()
+
+	cacheSelf.lock.Lock()
+	defer cacheSelf.lock.Unlock()
+
+	entry := cacheSelf.newEntry(key, val, ttl)
+	if element, ok := cacheSelf.entries[key]; ok {
+		element.Value = entry
+		cacheSelf.order.MoveToFront(element)
+		return
+	}
+
+	cacheSelf.entries[key] = cacheSelf.order.PushFront(entry)
+	if cacheSelf.capacity > 0 && cacheSelf.order.Len() > cacheSelf.capacity {
+		oldest := cacheSelf.order.Back()
+		cacheSelf.removeElement(oldest)
+		evicted = append(evicted, oldest.Value.(*cacheEntry[K, V]))
+	}
+}
+
+// Delete Delete the key, false if it doesn't exist
+func (cacheSelf *LRUCache[K, V]) Delete(key K) bool {
+	cacheSelf.lock.Lock()
+	defer cacheSelf.lock.Unlock()
+
+	element, ok := cacheSelf.entries[key]
+	if ok {
+		cacheSelf.removeElement(element)
+	}
+	return ok
+}
+
+// Len Get the number of entries(including the expired ones not accessed yet)
+func (cacheSelf *LRUCache[K, V]) Len() int {
+	cacheSelf.lock.Lock()
+	defer cacheSelf.lock.Unlock()
+
+	return len(cacheSelf.entries)
+}
+
+// GetOrCompute Get the value of the key, or load & cache it(concurrent loads of the same key are de-duplicated, errors aren't cached)
+func (cacheSelf *LRUCache[K, V]) GetOrCompute(key K, loader func(K) (V, error)) (V, error) {
+	return cacheSelf.getOrCompute(key, cacheSelf.Get, cacheSelf.Set, loader)
+}
+
+func (cacheSelf *LRUCache[K, V]) removeElement(element *list.Element) {
+	cacheSelf.order.Remove(element)
+	delete(cacheSelf.entries, element.Value.(*cacheEntry[K, V]).key)
+}
+
+// LFUCache
+
+// LFUCache Cache evicting the least frequently used entries over the capacity(the least recently used one for ties, concurrency-safe)
+type LFUCache[K comparable, V any] struct {
+	cacheBase[K, V]
+	freqs   map[int]*list.List
+	minFreq int
+}
+
+// NewLFUCache New LFUCache instance with the capacity(unlimited if <= 0)
+func NewLFUCache[K comparable, V any](capacity int, opts ...CacheOption) *LFUCache[K, V] {
+	return &LFUCache[K, V]{
+		cacheBase: newCacheBase[K, V](capacity, opts...),
+		freqs:     map[int]*list.List{},
+	}
+}
+
+// SetOnEvict Set the callback of the entries evicted by the capacity or the TTL(not by Delete())
+func (cacheSelf *LFUCache[K, V]) SetOnEvict(onEvict func(K, V)) *LFUCache[K, V] {
+	cacheSelf.lock.Lock()
+	defer cacheSelf.lock.Unlock()
+
+	cacheSelf.onEvict = onEvict
+	return cacheSelf
+}
+
+// Get Get the value of the key(counted as a use), false if it doesn't exist or it's expired
+func (cacheSelf *LFUCache[K, V]) Get(key K) (V, bool) {
+	var evicted []*cacheEntry[K, V]
+	defer func() {
+		cacheSelf.notifyEvicted(evicted)
+	}()
+
+	cacheSelf.lock.Lock()
+	defer cacheSelf.lock.Unlock()
+
+	var zero V
+	element, ok := cacheSelf.entries[key]
+	if !ok {
+		return zero, false
+	}
+	entry := element.Value.(*cacheEntry[K, V])
+	if entry.isExpired(cacheSelf.option.TimeScheduler.Now()) {
+		cacheSelf.removeElement(element)
+		evicted = append(evicted, entry)
+		return zero, false
+	}
+
+	cacheSelf.touch(element)
+	return entry.val, true
+}
+
+// Set Set the value of the key with the default TTL
+func (cacheSelf *LFUCache[K, V]) Set(key K, val V) {
+	cacheSelf.SetWithTTL(key, val, cacheSelf.option.TTL)
+}
+
+// SetWithTTL Set the value of the key(counted as a use) expiring after the ttl(never if <= 0)
+func (cacheSelf *LFUCache[K, V]) SetWithTTL(key K, val V, ttl time.Duration) {
+	var evicted []*cacheEntry[K, V]
+	defer func() {
+		cacheSelf.notifyEvicted(evicted)
+	}()
+
+	cacheSelf.lock.Lock()
+	defer cacheSelf.lock.Unlock()
+
+	entry := cacheSelf.newEntry(key, val, ttl)
+	if element, ok := cacheSelf.entries[key]; ok {
+		entry.freq = element.Value.(*cacheEntry[K, V]).freq
+		element.Value = entry
+		cacheSelf.touch(element)
+		return
+	}
+
+	if cacheSelf.capacity > 0 && len(cacheSelf.entries) >= cacheSelf.capacity {
+		evicted = append(evicted, cacheSelf.evict())
+	}
+	entry.freq = 1
+	cacheSelf.entries[key] = cacheSelf.freqList(1).PushFront(entry)
+	cacheSelf.minFreq = 1
+}
+
+// Delete Delete the key, false if it doesn't exist
+func (cacheSelf *LFUCache[K, V]) Delete(key K) bool {
+	cacheSelf.lock.Lock()
+	defer cacheSelf.lock.Unlock()
+
+	element, ok := cacheSelf.entries[key]
+	if ok {
+		cacheSelf.removeElement(element)
+	}
+	return ok
+}
+
+// Len Get the number of entries(including the expired ones not accessed yet)
+func (cacheSelf *LFUCache[K, V]) Len() int {
+	cacheSelf.lock.Lock()
+	defer cacheSelf.lock.Unlock()
+
+	return len(cacheSelf.entries)
+}
+
+// GetOrCompute Get the value of the key, or load & cache it(concurrent loads of the same key are de-duplicated, errors aren't cached)
+func (cacheSelf *LFUCache[K, V]) GetOrCompute(key K, loader func(K) (V, error)) (V, error) {
+	return cacheSelf.getOrCompute(key, cacheSelf.Get, cacheSelf.Set, loader)
+}
+
+// freqList Get the list of the entries used freq times(created if it doesn't exist)
+func (cacheSelf *LFUCache[K, V]) freqList(freq int) *list.List {
+	freqList, ok := cacheSelf.freqs[freq]
+	if !ok {
+		freqList = list.New()
+		cacheSelf.freqs[freq] = freqList
+	}
+	return freqList
+}
+
+// touch Move the entry to the list of the next frequency
+func (cacheSelf *LFUCache[K, V]) touch(element *list.Element) {
+	entry := element.Value.(*cacheEntry[K, V])
+	cacheSelf.removeElement(element)
+	if cacheSelf.minFreq == entry.freq && cacheSelf.freqs[entry.freq] == nil {
+		cacheSelf.minFreq++
+	}
+	entry.freq++
+	cacheSelf.entries[entry.key] = cacheSelf.freqList(entry.freq).PushFront(entry)
+}
+
+// evict Remove the least recently used entry of the minimum frequency
+func (cacheSelf *LFUCache[K, V]) evict() *cacheEntry[K, V] {
+	// minFreq could be stale after removals
+	if cacheSelf.freqs[cacheSelf.minFreq] == nil {
+		cacheSelf.minFreq = 0
+		for freq := range cacheSelf.freqs {
+			if cacheSelf.minFreq == 0 || freq < cacheSelf.minFreq {
+				cacheSelf.minFreq = freq
+			}
+		}
+	}
+
+	element := cacheSelf.freqs[cacheSelf.minFreq].Back()
+	cacheSelf.removeElement(element)
+	return element.Value.(*cacheEntry[K, V])
+}
+
+func (cacheSelf *LFUCache[K, V]) removeElement(element *list.Element) {
+	entry := element.Value.(*cacheEntry[K, V])
+	freqList := cacheSelf.freqs[entry.freq]
+	freqList.Remove(element)
+	if freqList.Len() == 0 {
+		delete(cacheSelf.freqs, entry.freq)
+	}
+	delete(cacheSelf.entries, entry.key)
+}
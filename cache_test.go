@@ -0,0 +1,172 @@
+package fpgo
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLRUCache(t *testing.T) {
+	var evicted []string
+	cache := NewLRUCache[string, int](2).SetOnEvict(func(key string, val int) {
+		evicted = append(evicted, key)
+	})
+	cache.Set("a", 1)
+	cache.Set("b", 2)
+	val, ok := cache.Get("a")
+	assert.Equal(t, true, ok)
+	assert.Equal(t, 1, val)
+	// b is the least recently used one
+	cache.Set("c", 3)
+	_, ok = cache.Get("b")
+	assert.Equal(t, false, ok)
+	assert.Equal(t, []string{"b"}, evicted)
+	assert.Equal(t, 2, cache.Len())
+
+	cache.Set("a", 10)
+	cache.Set("d", 4)
+	assert.Equal(t, []string{"b", "c"}, evicted)
+	val, _ = cache.Get("a")
+	assert.Equal(t, 10, val)
+
+	assert.Equal(t, true, cache.Delete("a"))
+	assert.Equal(t, false, cache.Delete("a"))
+	assert.Equal(t, []string{"b", "c"}, evicted)
+	assert.Equal(t, 1, cache.Len())
+}
+
+func TestLFUCache(t *testing.T) {
+	var evicted []string
+	cache := NewLFUCache[string, int](2).SetOnEvict(func(key string, val int) {
+		evicted = append(evicted, key)
+	})
+	cache.Set("a", 1)
+	cache.Set("b", 2)
+	cache.Get("a")
+	cache.Get("a")
+	cache.Get("b")
+	// b is used less than a
+	cache.Set("c", 3)
+	assert.Equal(t, []string{"b"}, evicted)
+	// c is the least frequently used one
+	cache.Set("d", 4)
+	assert.Equal(t, []string{"b", "c"}, evicted)
+	val, ok := cache.Get("a")
+	assert.Equal(t, true, ok)
+	assert.Equal(t, 1, val)
+
+	// The least recently used one for ties
+	cache = NewLFUCache[string, int](2)
+	cache.Set("a", 1)
+	cache.Set("b", 2)
+	cache.Set("c", 3)
+	_, ok = cache.Get("a")
+	assert.Equal(t, false, ok)
+	_, ok = cache.Get("b")
+	assert.Equal(t, true, ok)
+
+	// A stale minimum frequency after Delete()
+	cache.Get("b")
+	assert.Equal(t, true, cache.Delete("c"))
+	cache.Set("d", 4)
+	cache.Get("d")
+	cache.Set("e", 5)
+	assert.Equal(t, 2, cache.Len())
+	_, ok = cache.Get("e")
+	assert.Equal(t, true, ok)
+	_, ok = cache.Get("d")
+	assert.Equal(t, false, ok)
+}
+
+func TestCacheTTL(t *testing.T) {
+	timeScheduler := NewVirtualTimeScheduler(time.Now())
+	option := CacheOption{TTL: 10 * time.Millisecond, TimeScheduler: timeScheduler}
+	var evicted []string
+	onEvict := func(key string, val int) {
+		evicted = append(evicted, key)
+	}
+
+	for _, cache := range []interface {
+		Get(string) (int, bool)
+		Set(string, int)
+		SetWithTTL(string, int, time.Duration)
+	}{
+		NewLRUCache[string, int](0, option).SetOnEvict(onEvict),
+		NewLFUCache[string, int](0, option).SetOnEvict(onEvict),
+	} {
+		evicted = nil
+		cache.Set("a", 1)
+		cache.SetWithTTL("b", 2, 20*time.Millisecond)
+		cache.SetWithTTL("c", 3, 0)
+		timeScheduler.Advance(10 * time.Millisecond)
+		_, ok := cache.Get("a")
+		assert.Equal(t, false, ok)
+		_, ok = cache.Get("b")
+		assert.Equal(t, true, ok)
+		timeScheduler.Advance(10 * time.Millisecond)
+		_, ok = cache.Get("b")
+		assert.Equal(t, false, ok)
+		_, ok = cache.Get("c")
+		assert.Equal(t, true, ok)
+		assert.Equal(t, []string{"a", "b"}, evicted)
+	}
+}
+
+func TestCacheGetOrCompute(t *testing.T) {
+	errLoad := errors.New("load")
+	for _, cache := range []interface {
+		GetOrCompute(int, func(int) (int, error)) (int, error)
+	}{
+		NewLRUCache[int, int](10),
+		NewLFUCache[int, int](10),
+	} {
+		var loaded int32
+		started := make(chan bool)
+		release := make(chan bool)
+		loader := func(key int) (int, error) {
+			if atomic.AddInt32(&loaded, 1) == 1 {
+				close(started)
+			}
+			<-release
+			return key * 10, nil
+		}
+
+		var wg sync.WaitGroup
+		results := make([]int, 5)
+		for i := range results {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				results[i], _ = cache.GetOrCompute(1, loader)
+			}(i)
+		}
+		<-started
+		time.Sleep(5 * time.Millisecond)
+		close(release)
+		wg.Wait()
+		assert.Equal(t, []int{10, 10, 10, 10, 10}, results)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&loaded))
+
+		// Cached
+		val, err := cache.GetOrCompute(1, func(int) (int, error) {
+			return 0, errLoad
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 10, val)
+
+		// Errors aren't cached
+		_, err = cache.GetOrCompute(2, func(int) (int, error) {
+			return 0, errLoad
+		})
+		assert.Equal(t, errLoad, err)
+		val, err = cache.GetOrCompute(2, func(key int) (int, error) {
+			return key * 10, nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 20, val)
+	}
+}
@@ -0,0 +1,239 @@
+package fpgo
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// CircuitBreaker
+
+// ErrCircuitBreakerOpen The call is rejected because the CircuitBreaker is open
+var ErrCircuitBreakerOpen = errors.New("circuit breaker is open")
+
+var errCircuitBreakerPanicked = errors.New("circuit breaker call panicked")
+
+// CircuitBreakerState State of CircuitBreaker
+type CircuitBreakerState int
+
+const (
+	// CircuitBreakerClosed Calls pass through, failures are counted
+	CircuitBreakerClosed CircuitBreakerState = iota
+	// CircuitBreakerOpen Calls are rejected until the ResetTimeout passes
+	CircuitBreakerOpen
+	// CircuitBreakerHalfOpen Limited trial calls pass through to decide closing or opening again
+	CircuitBreakerHalfOpen
+)
+
+// String Get the name of the state
+func (state CircuitBreakerState) String() string {
+	switch state {
+	case CircuitBreakerClosed:
+		return "closed"
+	case CircuitBreakerOpen:
+		return "open"
+	case CircuitBreakerHalfOpen:
+		return "half-open"
+	}
+	return "unknown"
+}
+
+// CircuitBreakerOption Options of CircuitBreaker
+type CircuitBreakerOption struct {
+	// FailureThreshold Open after the number of consecutive failures(5 if <= 0)
+	FailureThreshold int
+	// ResetTimeout Become half-open after being open for the duration(30s if <= 0)
+	ResetTimeout time.Duration
+	// HalfOpenMaxCalls The number of trial calls allowed when half-open, all of them should succeed to close(1 if <= 0)
+	HalfOpenMaxCalls int
+	// IsFailure Decide whether the error counts as a failure(all non-nil errors if nil), otherwise it counts as a success
+	IsFailure func(error) bool
+	// OnStateChange Called(outside of the lock) when the state changes
+	OnStateChange func(from CircuitBreakerState, to CircuitBreakerState)
+	// TimeScheduler The clock of the ResetTimeout(DefaultTimeScheduler if nil)
+	TimeScheduler TimeScheduler
+}
+
+// CircuitBreaker CircuitBreaker(closed/open/half-open) rejecting calls to a failing downstream for a while
+type CircuitBreaker struct {
+	lock   sync.Mutex
+	option CircuitBreakerOption
+
+	state     CircuitBreakerState
+	failures  int
+	openedAt  time.Time
+	trials    int
+	successes int
+	// Increased by every state change, results of calls allowed in older generations are ignored
+	generation uint64
+}
+
+// NewCircuitBreaker New CircuitBreaker in the closed state
+func NewCircuitBreaker(opts ...CircuitBreakerOption) *CircuitBreaker {
+	var option CircuitBreakerOption
+	if len(opts) > 0 {
+		option = opts[0]
+	}
+	if option.FailureThreshold <= 0 {
+		option.FailureThreshold = 5
+	}
+	if option.ResetTimeout <= 0 {
+		option.ResetTimeout = 30 * time.Second
+	}
+	if option.HalfOpenMaxCalls <= 0 {
+		option.HalfOpenMaxCalls = 1
+	}
+	if option.TimeScheduler == nil {
+		option.TimeScheduler = DefaultTimeScheduler
+	}
+
+	return &CircuitBreaker{option: option}
+}
+
+// State Get the current state(open becomes half-open once the ResetTimeout passes)
+func (breakerSelf *CircuitBreaker) State() CircuitBreakerState {
+	breakerSelf.lock.Lock()
+	changes := breakerSelf.checkResetTimeout(nil)
+	state := breakerSelf.state
+	breakerSelf.lock.Unlock()
+
+	breakerSelf.notify(changes)
+	return state
+}
+
+// Execute Call fn if the CircuitBreaker allows it(ErrCircuitBreakerOpen otherwise) and record the result
+func (breakerSelf *CircuitBreaker) Execute(fn func() error) error {
+	done, err := breakerSelf.Allow()
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		// A panic counts as a failure
+		if r := recover(); r != nil {
+			done(errCircuitBreakerPanicked)
+			panic(r)
+		}
+	}()
+	err = fn()
+	done(err)
+	return err
+}
+
+// Allow Reserve a call, done should be called exactly once with the result of the call(ErrCircuitBreakerOpen if it's rejected)
+func (breakerSelf *CircuitBreaker) Allow() (done func(error), err error) {
+	breakerSelf.lock.Lock()
+	changes := breakerSelf.checkResetTimeout(nil)
+	generation := breakerSelf.generation
+	switch breakerSelf.state {
+	case CircuitBreakerOpen:
+		err = ErrCircuitBreakerOpen
+	case CircuitBreakerHalfOpen:
+		if breakerSelf.trials >= breakerSelf.option.HalfOpenMaxCalls {
+			err = ErrCircuitBreakerOpen
+		} else {
+			breakerSelf.trials++
+		}
+	}
+	breakerSelf.lock.Unlock()
+	breakerSelf.notify(changes)
+	if err != nil {
+		return nil, err
+	}
+
+	var once sync.Once
+	return func(result error) {
+		once.Do(func() {
+			breakerSelf.record(generation, result)
+		})
+	}, nil
+}
+
+// Reset Reset to the closed state
+func (breakerSelf *CircuitBreaker) Reset() {
+	breakerSelf.lock.Lock()
+	changes := breakerSelf.setState(CircuitBreakerClosed, nil)
+	breakerSelf.lock.Unlock()
+
+	breakerSelf.notify(changes)
+}
+
+// record Record the result of a call allowed in the generation(ignored if the state has changed since then)
+func (breakerSelf *CircuitBreaker) record(generation uint64, result error) {
+	isFailure := result != nil
+	if isFailure && breakerSelf.option.IsFailure != nil {
+		isFailure = breakerSelf.option.IsFailure(result)
+	}
+
+	breakerSelf.lock.Lock()
+	if generation != breakerSelf.generation {
+		// e.g. a slow call from the closed state finishing during the half-open trials
+		breakerSelf.lock.Unlock()
+		return
+	}
+	var changes [][2]CircuitBreakerState
+	switch breakerSelf.state {
+	case CircuitBreakerClosed:
+		if !isFailure {
+			breakerSelf.failures = 0
+		} else if breakerSelf.failures++; breakerSelf.failures >= breakerSelf.option.FailureThreshold {
+			changes = breakerSelf.setState(CircuitBreakerOpen, changes)
+		}
+	case CircuitBreakerHalfOpen:
+		if isFailure {
+			changes = breakerSelf.setState(CircuitBreakerOpen, changes)
+		} else if breakerSelf.successes++; breakerSelf.successes >= breakerSelf.option.HalfOpenMaxCalls {
+			changes = breakerSelf.setState(CircuitBreakerClosed, changes)
+		}
+	}
+	breakerSelf.lock.Unlock()
+
+	breakerSelf.notify(changes)
+}
+
+// checkResetTimeout Become half-open if the ResetTimeout passed(lock held)
+func (breakerSelf *CircuitBreaker) checkResetTimeout(changes [][2]CircuitBreakerState) [][2]CircuitBreakerState {
+	if breakerSelf.state == CircuitBreakerOpen &&
+		!breakerSelf.option.TimeScheduler.Now().Before(breakerSelf.openedAt.Add(breakerSelf.option.ResetTimeout)) {
+		changes = breakerSelf.setState(CircuitBreakerHalfOpen, changes)
+	}
+	return changes
+}
+
+// setState Change the state & reset the counters(lock held)
+func (breakerSelf *CircuitBreaker) setState(state CircuitBreakerState, changes [][2]CircuitBreakerState) [][2]CircuitBreakerState {
+	breakerSelf.failures = 0
+	breakerSelf.trials = 0
+	breakerSelf.successes = 0
+	breakerSelf.generation++
+	if state == CircuitBreakerOpen {
+		breakerSelf.openedAt = breakerSelf.option.TimeScheduler.Now()
+	}
+	if breakerSelf.state == state {
+		return changes
+	}
+
+	changes = append(changes, [2]CircuitBreakerState{breakerSelf.state, state})
+	breakerSelf.state = state
+	return changes
+}
+
+func (breakerSelf *CircuitBreaker) notify(changes [][2]CircuitBreakerState) {
+	if breakerSelf.option.OnStateChange == nil {
+		return
+	}
+	for _, change := range changes {
+		breakerSelf.option.OnStateChange(change[0], change[1])
+	}
+}
+
+// CircuitBreakerExecute Call fn if the CircuitBreaker allows it(ErrCircuitBreakerOpen otherwise) and record the error
+func CircuitBreakerExecute[T any](breaker *CircuitBreaker, fn func() (T, error)) (T, error) {
+	var result T
+	err := breaker.Execute(func() error {
+		var err error
+		result, err = fn()
+		return err
+	})
+	return result, err
+}
@@ -0,0 +1,155 @@
+package fpgo
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	errFailed := errors.New("failed")
+	errIgnored := errors.New("ignored")
+	timeScheduler := NewVirtualTimeScheduler(time.Now())
+	var changes []string
+	breaker := NewCircuitBreaker(CircuitBreakerOption{
+		FailureThreshold: 3,
+		ResetTimeout:     10 * time.Second,
+		HalfOpenMaxCalls: 2,
+		IsFailure: func(err error) bool {
+			return err != errIgnored
+		},
+		OnStateChange: func(from CircuitBreakerState, to CircuitBreakerState) {
+			changes = append(changes, from.String()+">"+to.String())
+		},
+		TimeScheduler: timeScheduler,
+	})
+	fail := func() error {
+		return errFailed
+	}
+	succeed := func() error {
+		return nil
+	}
+
+	// Consecutive failures only(an error not counted as a failure is a success)
+	assert.Equal(t, errFailed, breaker.Execute(fail))
+	assert.Equal(t, errFailed, breaker.Execute(fail))
+	assert.NoError(t, breaker.Execute(succeed))
+	assert.Equal(t, errFailed, breaker.Execute(fail))
+	assert.Equal(t, errFailed, breaker.Execute(fail))
+	assert.Equal(t, errIgnored, breaker.Execute(func() error {
+		return errIgnored
+	}))
+	assert.Equal(t, errFailed, breaker.Execute(fail))
+	assert.Equal(t, errFailed, breaker.Execute(fail))
+	assert.Equal(t, CircuitBreakerClosed, breaker.State())
+	assert.Equal(t, errFailed, breaker.Execute(fail))
+	assert.Equal(t, CircuitBreakerOpen, breaker.State())
+
+	called := false
+	assert.Equal(t, ErrCircuitBreakerOpen, breaker.Execute(func() error {
+		called = true
+		return nil
+	}))
+	assert.Equal(t, false, called)
+
+	// Half-open: a failed trial opens it again
+	timeScheduler.Advance(10 * time.Second)
+	assert.Equal(t, CircuitBreakerHalfOpen, breaker.State())
+	assert.Equal(t, errFailed, breaker.Execute(fail))
+	assert.Equal(t, CircuitBreakerOpen, breaker.State())
+	timeScheduler.Advance(9 * time.Second)
+	assert.Equal(t, CircuitBreakerOpen, breaker.State())
+
+	// Half-open: limited trials, all should succeed to close
+	timeScheduler.Advance(time.Second)
+	done1, err := breaker.Allow()
+	assert.NoError(t, err)
+	done2, err := breaker.Allow()
+	assert.NoError(t, err)
+	_, err = breaker.Allow()
+	assert.Equal(t, ErrCircuitBreakerOpen, err)
+	done1(nil)
+	done1(errFailed)
+	assert.Equal(t, CircuitBreakerHalfOpen, breaker.State())
+	done2(nil)
+	assert.Equal(t, CircuitBreakerClosed, breaker.State())
+
+	assert.Equal(t, []string{
+		"closed>open", "open>half-open", "half-open>open", "open>half-open", "half-open>closed",
+	}, changes)
+
+	// Panics count as failures
+	for i := 0; i < 3; i++ {
+		assert.Panics(t, func() {
+			breaker.Execute(func() error {
+				panic("boom")
+			})
+		})
+	}
+	assert.Equal(t, CircuitBreakerOpen, breaker.State())
+	breaker.Reset()
+	assert.Equal(t, CircuitBreakerClosed, breaker.State())
+
+	// Defaults
+	defaultBreaker := NewCircuitBreaker()
+	for i := 0; i < 4; i++ {
+		defaultBreaker.Execute(fail)
+	}
+	assert.Equal(t, CircuitBreakerClosed, defaultBreaker.State())
+	defaultBreaker.Execute(fail)
+	assert.Equal(t, CircuitBreakerOpen, defaultBreaker.State())
+}
+
+func TestCircuitBreakerLateResults(t *testing.T) {
+	errFailed := errors.New("failed")
+	timeScheduler := NewVirtualTimeScheduler(time.Now())
+	breaker := NewCircuitBreaker(CircuitBreakerOption{
+		FailureThreshold: 1,
+		ResetTimeout:     10 * time.Second,
+		TimeScheduler:    timeScheduler,
+	})
+
+	// Slow calls allowed when it's closed
+	slowSuccess, _ := breaker.Allow()
+	slowFailure, _ := breaker.Allow()
+	breaker.Execute(func() error {
+		return errFailed
+	})
+	assert.Equal(t, CircuitBreakerOpen, breaker.State())
+
+	// Late results don't affect the half-open trial
+	timeScheduler.Advance(10 * time.Second)
+	trial, err := breaker.Allow()
+	assert.NoError(t, err)
+	slowSuccess(nil)
+	assert.Equal(t, CircuitBreakerHalfOpen, breaker.State())
+	slowFailure(errFailed)
+	assert.Equal(t, CircuitBreakerHalfOpen, breaker.State())
+	trial(nil)
+	assert.Equal(t, CircuitBreakerClosed, breaker.State())
+}
+
+func TestMonadIOProtect(t *testing.T) {
+	errFailed := errors.New("failed")
+	breaker := NewCircuitBreaker(CircuitBreakerOption{FailureThreshold: 1})
+	count := 0
+	monadIO := MonadIONewWithError(func() (int, error) {
+		count++
+		return count, errFailed
+	}).Protect(breaker)
+
+	result, err := monadIO.EvalWithError()
+	assert.Equal(t, 1, result)
+	assert.Equal(t, errFailed, err)
+	_, err = monadIO.EvalWithError()
+	assert.Equal(t, ErrCircuitBreakerOpen, err)
+	assert.Equal(t, 1, count)
+
+	val, err := CircuitBreakerExecute(NewCircuitBreaker(), func() (string, error) {
+		return "ok", nil
+	})
+	assert.Equal(t, "ok", val)
+	assert.NoError(t, err)
+}
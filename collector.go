@@ -0,0 +1,124 @@
+package fpgo
+
+import (
+	"strings"
+)
+
+// Collector
+
+// Collector Collector inspired by Java8Stream Collectors(Supplier -> Accumulator -> Finisher)
+type Collector[T any, A any, R any] struct {
+	// Supplier Make a new accumulation container
+	Supplier func() A
+	// Accumulator Fold an item into the accumulation container
+	Accumulator func(A, T) A
+	// Finisher Transform the accumulation container into the final result
+	Finisher func(A) R
+}
+
+// NewCollector New a Collector by its Supplier, Accumulator & Finisher
+func NewCollector[T any, A any, R any](supplier func() A, accumulator func(A, T) A, finisher func(A) R) Collector[T, A, R] {
+	return Collector[T, A, R]{
+		Supplier:    supplier,
+		Accumulator: accumulator,
+		Finisher:    finisher,
+	}
+}
+
+// Collect Collect items by the Collector
+func Collect[T any, A any, R any](collector Collector[T, A, R], list ...T) R {
+	container := collector.Supplier()
+	for _, item := range list {
+		container = collector.Accumulator(container, item)
+	}
+
+	return collector.Finisher(container)
+}
+
+// StreamCollect Collect items of the Stream by the Collector
+func StreamCollect[T comparable, A any, R any](streamSelf *StreamDef[T], collector Collector[T, A, R]) R {
+	return Collect(collector, (*streamSelf)...)
+}
+
+// ToMap Collector collecting items into a map by keyFn & valueFn(the latter item wins if keys are duplicated)
+func ToMap[T any, K comparable, V any](keyFn TransformerFunctor[T, K], valueFn TransformerFunctor[T, V]) Collector[T, map[K]V, map[K]V] {
+	return NewCollector(func() map[K]V {
+		return make(map[K]V)
+	}, func(container map[K]V, item T) map[K]V {
+		container[keyFn(item)] = valueFn(item)
+		return container
+	}, func(container map[K]V) map[K]V {
+		return container
+	})
+}
+
+// ToSet Collector collecting items into a Set
+func ToSet[T comparable]() Collector[T, []T, *MapSetDef[T, bool]] {
+	return NewCollector(func() []T {
+		return make([]T, 0)
+	}, func(container []T, item T) []T {
+		return append(container, item)
+	}, func(container []T) *MapSetDef[T, bool] {
+		return SetFromArray[T, bool](container)
+	})
+}
+
+// GroupingBy Collector grouping items by keyFn(the order within each group is kept)
+func GroupingBy[T any, K comparable](keyFn TransformerFunctor[T, K]) Collector[T, map[K][]T, map[K][]T] {
+	return NewCollector(func() map[K][]T {
+		return make(map[K][]T)
+	}, func(container map[K][]T, item T) map[K][]T {
+		key := keyFn(item)
+		container[key] = append(container[key], item)
+		return container
+	}, func(container map[K][]T) map[K][]T {
+		return container
+	})
+}
+
+// Joining Collector joining strings with the separator
+func Joining(separator string) Collector[string, []string, string] {
+	return NewCollector(func() []string {
+		return make([]string, 0)
+	}, func(container []string, item string) []string {
+		return append(container, item)
+	}, func(container []string) string {
+		return strings.Join(container, separator)
+	})
+}
+
+// Counting Collector counting items
+func Counting[T any]() Collector[T, int, int] {
+	return NewCollector(func() int {
+		return 0
+	}, func(count int, _ T) int {
+		return count + 1
+	}, func(count int) int {
+		return count
+	})
+}
+
+// Summing Collector summing up items
+func Summing[T Numeric]() Collector[T, T, T] {
+	return NewCollector(func() T {
+		return 0
+	}, func(sum T, item T) T {
+		return sum + item
+	}, func(sum T) T {
+		return sum
+	})
+}
+
+// Averaging Collector averaging items(0 if there's no item)
+func Averaging[T Numeric]() Collector[T, Tuple2[float64, int], float64] {
+	return NewCollector(func() Tuple2[float64, int] {
+		return NewTuple2(float64(0), 0)
+	}, func(sumAndCount Tuple2[float64, int], item T) Tuple2[float64, int] {
+		return NewTuple2(sumAndCount.V1+float64(item), sumAndCount.V2+1)
+	}, func(sumAndCount Tuple2[float64, int]) float64 {
+		if sumAndCount.V2 == 0 {
+			return 0
+		}
+		return sumAndCount.V1 / float64(sumAndCount.V2)
+	})
+}
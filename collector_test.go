@@ -0,0 +1,40 @@
+package fpgo
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollector(t *testing.T) {
+	s := StreamFrom(1, 2, 3, 4)
+
+	assert.Equal(t, map[string]int{"1": 1, "2": 4, "3": 9, "4": 16}, StreamCollect(s, ToMap(strconv.Itoa, func(v int) int {
+		return v * v
+	})))
+	set := StreamCollect(StreamFrom(1, 1, 2), ToSet[int]())
+	assert.Equal(t, 2, set.Size())
+	assert.Equal(t, true, set.ContainsKey(1))
+	assert.Equal(t, true, set.ContainsKey(2))
+	assert.Equal(t, map[bool][]int{true: {2, 4}, false: {1, 3}}, StreamCollect(s, GroupingBy(func(v int) bool {
+		return v%2 == 0
+	})))
+	assert.Equal(t, "a, b, c", StreamCollect(StreamFrom("a", "b", "c"), Joining(", ")))
+	assert.Equal(t, "", Collect(Joining(", ")))
+	assert.Equal(t, 4, StreamCollect(s, Counting[int]()))
+	assert.Equal(t, 10, StreamCollect(s, Summing[int]()))
+	assert.Equal(t, 2.5, StreamCollect(s, Averaging[int]()))
+	assert.Equal(t, float64(0), Collect(Averaging[int]()))
+
+	// Custom Collector
+	maxLen := NewCollector(func() int {
+		return 0
+	}, func(max int, item string) int {
+		if len(item) > max {
+			return len(item)
+		}
+		return max
+	}, strconv.Itoa)
+	assert.Equal(t, "3", Collect(maxLen, "a", "abc", "ab"))
+}
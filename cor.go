@@ -2,30 +2,8 @@ package fpgo
 
 import (
 	"sync"
-	"sync/atomic"
 )
 
-// AtomBool Atomic Bool
-type AtomBool struct{ flag int32 }
-
-// Set Set the bool atomically
-func (atomBoolSelf *AtomBool) Set(value bool) {
-	var i int32
-	i = 0
-	if value {
-		i = 1
-	}
-	atomic.StoreInt32(&(atomBoolSelf.flag), int32(i))
-}
-
-// Get Get the bool atomically
-func (atomBoolSelf *AtomBool) Get() bool {
-	if atomic.LoadInt32(&(atomBoolSelf.flag)) != 0 {
-		return true
-	}
-	return false
-}
-
 // CorOp Cor Yield Operation/Delegation/Callback
 type CorOp[T any] struct {
 	cor *CorDef[T]
@@ -0,0 +1,175 @@
+package fpgo
+
+import (
+	"container/heap"
+	"context"
+	"sync"
+	"time"
+)
+
+// DelayQueue
+
+// DelayQueue BlockingQueue whose items become available only after their delays(the earliest one first)
+type DelayQueue[T any] struct {
+	lock          sync.Mutex
+	isClosed      bool
+	heap          *comparatorHeap[delayedItem[T]]
+	timeScheduler TimeScheduler
+
+	signalCh chan struct{}
+	closedCh chan struct{}
+}
+
+type delayedItem[T any] struct {
+	val     T
+	readyAt time.Time
+}
+
+// NewDelayQueue New DelayQueue instance
+func NewDelayQueue[T any]() *DelayQueue[T] {
+	return NewDelayQueueWithScheduler[T](DefaultTimeScheduler)
+}
+
+// NewDelayQueueWithScheduler New DelayQueue instance timed by the TimeScheduler
+func NewDelayQueueWithScheduler[T any](timeScheduler TimeScheduler) *DelayQueue[T] {
+	return &DelayQueue[T]{
+		heap: &comparatorHeap[delayedItem[T]]{less: func(a, b delayedItem[T]) bool {
+			return a.readyAt.Before(b.readyAt)
+		}},
+		timeScheduler: timeScheduler,
+
+		signalCh: make(chan struct{}, 1),
+		closedCh: make(chan struct{}),
+	}
+}
+
+// Offer Offer the T val which becomes available after the delay(non-blocking)
+func (q *DelayQueue[T]) Offer(val T, delay time.Duration) error {
+	return q.OfferAt(val, q.timeScheduler.Now().Add(delay))
+}
+
+// OfferAt Offer the T val which becomes available at the readyAt time(non-blocking)
+func (q *DelayQueue[T]) OfferAt(val T, readyAt time.Time) error {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if q.isClosed {
+		return ErrQueueIsClosed
+	}
+
+	heap.Push(q.heap, delayedItem[T]{val: val, readyAt: readyAt})
+	// The earliest one may be changed
+	queueSignal(q.signalCh)
+	return nil
+}
+
+// Poll Poll the earliest available T val(non-blocking), ErrQueueIsEmpty if none is available yet
+func (q *DelayQueue[T]) Poll() (T, error) {
+	val, _, err := q.poll()
+	return val, err
+}
+
+// Take Take the earliest T val, blocking until it's available
+func (q *DelayQueue[T]) Take() (T, error) {
+	return q.take(context.Background(), nil)
+}
+
+// TakeWithTimeout Take the earliest T val, blocking until it's available, with timeout
+func (q *DelayQueue[T]) TakeWithTimeout(timeout time.Duration) (T, error) {
+	timeoutCh := make(chan struct{})
+	timer := q.timeScheduler.AfterFunc(timeout, func() {
+		close(timeoutCh)
+	})
+	defer timer.Stop()
+
+	return q.take(context.Background(), timeoutCh)
+}
+
+// TakeWithContext Take the earliest T val, blocking until it's available or the context is done(returning ctx.Err())
+func (q *DelayQueue[T]) TakeWithContext(ctx context.Context) (T, error) {
+	return q.take(ctx, nil)
+}
+
+// Count Count items(including the unavailable ones)
+func (q *DelayQueue[T]) Count() int {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	return q.heap.Len()
+}
+
+// IsClosed Is the DelayQueue closed
+func (q *DelayQueue[T]) IsClosed() bool {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	return q.isClosed
+}
+
+// Close Close the DelayQueue, the blocking Take calls return ErrQueueIsClosed(the available items could still be taken)
+func (q *DelayQueue[T]) Close() {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if q.isClosed {
+		return
+	}
+	q.isClosed = true
+	close(q.closedCh)
+}
+
+// poll Poll the earliest available T val, or get the duration to wait for the earliest one(0 if there's none)
+func (q *DelayQueue[T]) poll() (T, time.Duration, error) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if q.heap.Len() > 0 {
+		wait := q.heap.list[0].readyAt.Sub(q.timeScheduler.Now())
+		if wait <= 0 {
+			item := heap.Pop(q.heap).(delayedItem[T])
+			// Wake up the next waiting ones
+			if q.heap.Len() > 0 {
+				queueSignal(q.signalCh)
+			}
+			return item.val, 0, nil
+		}
+		if !q.isClosed {
+			return *new(T), wait, ErrQueueIsEmpty
+		}
+	}
+
+	if q.isClosed {
+		return *new(T), 0, ErrQueueIsClosed
+	}
+	return *new(T), 0, ErrQueueIsEmpty
+}
+
+func (q *DelayQueue[T]) take(ctx context.Context, timeoutCh <-chan struct{}) (T, error) {
+	for {
+		val, wait, err := q.poll()
+		if err != ErrQueueIsEmpty {
+			return val, err
+		}
+
+		var timer TimerHandle
+		if wait > 0 {
+			timer = q.timeScheduler.AfterFunc(wait, func() {
+				queueSignal(q.signalCh)
+			})
+		}
+		select {
+		case <-q.signalCh:
+		case <-q.closedCh:
+		case <-timeoutCh:
+			err = ErrQueueTakeTimeout
+		case <-ctx.Done():
+			err = ctx.Err()
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+		if err != ErrQueueIsEmpty {
+			return *new(T), err
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package fpgo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDelayQueue(t *testing.T) {
+	timeScheduler := NewVirtualTimeScheduler(time.Now())
+	delayQueue := NewDelayQueueWithScheduler[string](timeScheduler)
+
+	delayQueue.Offer("b", 20*time.Millisecond)
+	delayQueue.Offer("a", 10*time.Millisecond)
+	delayQueue.OfferAt("now", timeScheduler.Now())
+	assert.Equal(t, 3, delayQueue.Count())
+
+	val, err := delayQueue.Poll()
+	assert.NoError(t, err)
+	assert.Equal(t, "now", val)
+	_, err = delayQueue.Poll()
+	assert.Equal(t, ErrQueueIsEmpty, err)
+	timeScheduler.Advance(10 * time.Millisecond)
+	val, _ = delayQueue.Poll()
+	assert.Equal(t, "a", val)
+	_, err = delayQueue.Poll()
+	assert.Equal(t, ErrQueueIsEmpty, err)
+	timeScheduler.Advance(10 * time.Millisecond)
+	val, _ = delayQueue.Take()
+	assert.Equal(t, "b", val)
+
+	// Blocking
+	delayQueue = NewDelayQueue[string]()
+	start := time.Now()
+	delayQueue.Offer("c", 5*time.Millisecond)
+	val, err = delayQueue.Take()
+	assert.NoError(t, err)
+	assert.Equal(t, "c", val)
+	assert.GreaterOrEqual(t, time.Since(start), 5*time.Millisecond)
+
+	// An earlier one offered while waiting
+	delayQueue.Offer("late", time.Hour)
+	go func() {
+		time.Sleep(time.Millisecond)
+		delayQueue.Offer("d", 0)
+	}()
+	val, _ = delayQueue.Take()
+	assert.Equal(t, "d", val)
+
+	_, err = delayQueue.TakeWithTimeout(time.Millisecond)
+	assert.Equal(t, ErrQueueTakeTimeout, err)
+
+	go func() {
+		time.Sleep(time.Millisecond)
+		delayQueue.Close()
+	}()
+	_, err = delayQueue.Take()
+	assert.Equal(t, ErrQueueIsClosed, err)
+	assert.Equal(t, ErrQueueIsClosed, delayQueue.Offer("e", 0))
+}
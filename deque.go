@@ -0,0 +1,296 @@
+package fpgo
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Deque
+
+// Deque Double-ended BlockingQueue(backed by LinkedListQueue) inspired by Collection utils
+type Deque[T any] struct {
+	lock     sync.Mutex
+	isClosed bool
+	capacity int
+	list     *LinkedListQueue[T]
+
+	notEmptyCh chan struct{}
+	notFullCh  chan struct{}
+	closedCh   chan struct{}
+}
+
+// NewDeque New Deque instance with capacity(unbounded if <= 0)
+func NewDeque[T any](capacity int) *Deque[T] {
+	return &Deque[T]{
+		capacity: capacity,
+		list:     NewLinkedListQueue[T](),
+
+		notEmptyCh: make(chan struct{}, 1),
+		notFullCh:  make(chan struct{}, 1),
+		closedCh:   make(chan struct{}),
+	}
+}
+
+// OfferFirst Offer the T val to the first position(non-blocking)
+func (q *Deque[T]) OfferFirst(val T) error {
+	return q.offer(val, true)
+}
+
+// OfferLast Offer the T val to the last position(non-blocking)
+func (q *Deque[T]) OfferLast(val T) error {
+	return q.offer(val, false)
+}
+
+// PollFirst Poll the T val from the first position(non-blocking)
+func (q *Deque[T]) PollFirst() (T, error) {
+	return q.poll(true)
+}
+
+// PollLast Poll the T val from the last position(non-blocking)
+func (q *Deque[T]) PollLast() (T, error) {
+	return q.poll(false)
+}
+
+// PutFirst Put the T val to the first position(blocking)
+func (q *Deque[T]) PutFirst(val T) error {
+	return q.put(context.Background(), val, true, nil)
+}
+
+// PutLast Put the T val to the last position(blocking)
+func (q *Deque[T]) PutLast(val T) error {
+	return q.put(context.Background(), val, false, nil)
+}
+
+// PutFirstWithTimeout Put the T val to the first position(blocking), with timeout
+func (q *Deque[T]) PutFirstWithTimeout(val T, timeout time.Duration) error {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	return q.put(context.Background(), val, true, timer.C)
+}
+
+// PutLastWithTimeout Put the T val to the last position(blocking), with timeout
+func (q *Deque[T]) PutLastWithTimeout(val T, timeout time.Duration) error {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	return q.put(context.Background(), val, false, timer.C)
+}
+
+// TakeFirst Take the T val from the first position(blocking)
+func (q *Deque[T]) TakeFirst() (T, error) {
+	return q.take(context.Background(), true, nil)
+}
+
+// TakeLast Take the T val from the last position(blocking)
+func (q *Deque[T]) TakeLast() (T, error) {
+	return q.take(context.Background(), false, nil)
+}
+
+// TakeFirstWithTimeout Take the T val from the first position(blocking), with timeout
+func (q *Deque[T]) TakeFirstWithTimeout(timeout time.Duration) (T, error) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	return q.take(context.Background(), true, timer.C)
+}
+
+// TakeLastWithTimeout Take the T val from the last position(blocking), with timeout
+func (q *Deque[T]) TakeLastWithTimeout(timeout time.Duration) (T, error) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	return q.take(context.Background(), false, timer.C)
+}
+
+// OfferFirstWithContext Put the T val to the first position, blocking until there's space or the context is done(returning ctx.Err())
+func (q *Deque[T]) OfferFirstWithContext(ctx context.Context, val T) error {
+	return q.put(ctx, val, true, nil)
+}
+
+// OfferLastWithContext Put the T val to the last position, blocking until there's space or the context is done(returning ctx.Err())
+func (q *Deque[T]) OfferLastWithContext(ctx context.Context, val T) error {
+	return q.put(ctx, val, false, nil)
+}
+
+// TakeFirstWithContext Take the T val from the first position, blocking until there's one or the context is done(returning ctx.Err())
+func (q *Deque[T]) TakeFirstWithContext(ctx context.Context) (T, error) {
+	return q.take(ctx, true, nil)
+}
+
+// TakeLastWithContext Take the T val from the last position, blocking until there's one or the context is done(returning ctx.Err())
+func (q *Deque[T]) TakeLastWithContext(ctx context.Context) (T, error) {
+	return q.take(ctx, false, nil)
+}
+
+// OfferWithContext Put the T val to the last position, blocking until there's space or the context is done(Queue)
+func (q *Deque[T]) OfferWithContext(ctx context.Context, val T) error {
+	return q.OfferLastWithContext(ctx, val)
+}
+
+// TakeWithContext Take the T val from the first position, blocking until there's one or the context is done(Queue)
+func (q *Deque[T]) TakeWithContext(ctx context.Context) (T, error) {
+	return q.TakeFirstWithContext(ctx)
+}
+
+// Put Put the T val to the last position(blocking, Queue)
+func (q *Deque[T]) Put(val T) error {
+	return q.PutLast(val)
+}
+
+// Take Take the T val from the first position(blocking, Queue)
+func (q *Deque[T]) Take() (T, error) {
+	return q.TakeFirst()
+}
+
+// Offer Offer the T val to the last position(non-blocking, Queue)
+func (q *Deque[T]) Offer(val T) error {
+	return q.OfferLast(val)
+}
+
+// Poll Poll the T val from the first position(non-blocking, Queue)
+func (q *Deque[T]) Poll() (T, error) {
+	return q.PollFirst()
+}
+
+// Push Push the T val to the last position(non-blocking, Stack)
+func (q *Deque[T]) Push(val T) error {
+	return q.OfferLast(val)
+}
+
+// Pop Pop the T val from the last position(non-blocking, Stack)
+func (q *Deque[T]) Pop() (T, error) {
+	return q.PollLast()
+}
+
+// PeekFirst Peek the T val from the first position without removing it(non-blocking)
+func (q *Deque[T]) PeekFirst() (T, error) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if q.list.first == nil {
+		return *new(T), ErrQueueIsEmpty
+	}
+	return *q.list.first.Val, nil
+}
+
+// PeekLast Peek the T val from the last position without removing it(non-blocking)
+func (q *Deque[T]) PeekLast() (T, error) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if q.list.last == nil {
+		return *new(T), ErrQueueIsEmpty
+	}
+	return *q.list.last.Val, nil
+}
+
+// Count Count items
+func (q *Deque[T]) Count() int {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	return q.list.Count()
+}
+
+// IsClosed Is the Deque closed
+func (q *Deque[T]) IsClosed() bool {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	return q.isClosed
+}
+
+// Close Close the Deque, the blocking Put/Take calls return ErrQueueIsClosed(the remaining items could still be taken)
+func (q *Deque[T]) Close() {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if q.isClosed {
+		return
+	}
+	q.isClosed = true
+	close(q.closedCh)
+}
+
+func (q *Deque[T]) offer(val T, isFirst bool) error {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if q.isClosed {
+		return ErrQueueIsClosed
+	}
+	if q.capacity > 0 && q.list.Count() >= q.capacity {
+		return ErrQueueIsFull
+	}
+
+	if isFirst {
+		q.list.Unshift(val)
+	} else {
+		q.list.Offer(val)
+	}
+	queueSignal(q.notEmptyCh)
+	return nil
+}
+
+func (q *Deque[T]) poll(isFirst bool) (T, error) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if q.list.Count() == 0 {
+		if q.isClosed {
+			return *new(T), ErrQueueIsClosed
+		}
+		return *new(T), ErrQueueIsEmpty
+	}
+
+	var val T
+	if isFirst {
+		val, _ = q.list.Shift()
+	} else {
+		val, _ = q.list.Pop()
+	}
+	// Wake up the next waiting ones
+	if q.list.Count() > 0 {
+		queueSignal(q.notEmptyCh)
+	}
+	queueSignal(q.notFullCh)
+	return val, nil
+}
+
+func (q *Deque[T]) put(ctx context.Context, val T, isFirst bool, timeoutCh <-chan time.Time) error {
+	for {
+		err := q.offer(val, isFirst)
+		if err != ErrQueueIsFull {
+			return err
+		}
+
+		select {
+		case <-q.notFullCh:
+		case <-q.closedCh:
+		case <-timeoutCh:
+			return ErrQueuePutTimeout
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (q *Deque[T]) take(ctx context.Context, isFirst bool, timeoutCh <-chan time.Time) (T, error) {
+	for {
+		val, err := q.poll(isFirst)
+		if err != ErrQueueIsEmpty {
+			return val, err
+		}
+
+		select {
+		case <-q.notEmptyCh:
+		case <-q.closedCh:
+		case <-timeoutCh:
+			return *new(T), ErrQueueTakeTimeout
+		case <-ctx.Done():
+			return *new(T), ctx.Err()
+		}
+	}
+}
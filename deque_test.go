@@ -0,0 +1,68 @@
+package fpgo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeque(t *testing.T) {
+	var queue Queue[int]
+	var stack Stack[int]
+	deque := NewDeque[int](3)
+	queue, stack = deque, deque
+
+	assert.NoError(t, deque.OfferLast(2))
+	assert.NoError(t, deque.OfferFirst(1))
+	assert.NoError(t, deque.OfferLast(3))
+	assert.Equal(t, ErrQueueIsFull, deque.OfferFirst(0))
+	assert.Equal(t, ErrQueuePutTimeout, deque.PutFirstWithTimeout(0, time.Millisecond))
+	first, _ := deque.PeekFirst()
+	last, _ := deque.PeekLast()
+	assert.Equal(t, []int{1, 3}, []int{first, last})
+	assert.Equal(t, 3, deque.Count())
+
+	val, _ := deque.PollLast()
+	assert.Equal(t, 3, val)
+	val, _ = deque.PollFirst()
+	assert.Equal(t, 1, val)
+	val, _ = stack.Pop()
+	assert.Equal(t, 2, val)
+	_, err := queue.Poll()
+	assert.Equal(t, ErrQueueIsEmpty, err)
+	_, err = deque.TakeLastWithTimeout(time.Millisecond)
+	assert.Equal(t, ErrQueueTakeTimeout, err)
+
+	// Blocking
+	go func() {
+		time.Sleep(time.Millisecond)
+		deque.PutFirst(4)
+	}()
+	val, _ = deque.TakeLast()
+	assert.Equal(t, 4, val)
+
+	// LIFO by both ends of the same side
+	stack.Push(5)
+	stack.Push(6)
+	queue.Put(7)
+	putDone := make(chan error)
+	go func() {
+		putDone <- deque.PutFirst(8)
+	}()
+	val, _ = deque.TakeLastWithTimeout(time.Second)
+	assert.Equal(t, 7, val)
+	assert.NoError(t, <-putDone)
+	val, _ = queue.Take()
+	assert.Equal(t, 8, val)
+
+	deque.Close()
+	assert.Equal(t, true, deque.IsClosed())
+	assert.Equal(t, ErrQueueIsClosed, deque.PutLast(9))
+	val, _ = deque.TakeFirst()
+	assert.Equal(t, 5, val)
+	val, _ = deque.TakeFirstWithTimeout(time.Second)
+	assert.Equal(t, 6, val)
+	_, err = deque.TakeLast()
+	assert.Equal(t, ErrQueueIsClosed, err)
+}
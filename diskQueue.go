@@ -0,0 +1,516 @@
+package fpgo
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DiskQueue
+
+// ErrDiskQueueCorrupted The segment files of the DiskQueue are corrupted
+var ErrDiskQueueCorrupted = errors.New("disk queue is corrupted")
+
+const (
+	diskQueueSegmentExt  = ".seg"
+	diskQueueCorruptExt  = ".corrupt"
+	diskQueueCursorFile  = "cursor"
+	// diskQueueHeaderBytes The length & the CRC32-C checksum of the record
+	diskQueueHeaderBytes = 8
+
+	diskQueueDefaultCursorInterval = 64
+)
+
+var diskQueueCRCTable = crc32.MakeTable(crc32.Castagnoli)
+
+// QueueCodec Encode/Decode the T vals stored outside the memory(e.g. DiskQueue)
+type QueueCodec[T any] interface {
+	Encode(val T) ([]byte, error)
+	Decode(data []byte) (T, error)
+}
+
+// JSONQueueCodec QueueCodec implemented by encoding/json
+type JSONQueueCodec[T any] struct{}
+
+// Encode Encode the T val as JSON
+func (JSONQueueCodec[T]) Encode(val T) ([]byte, error) {
+	return json.Marshal(val)
+}
+
+// Decode Decode the T val from JSON
+func (JSONQueueCodec[T]) Decode(data []byte) (T, error) {
+	var val T
+	err := json.Unmarshal(data, &val)
+	return val, err
+}
+
+// QueueSpillStore The overflow storage of BufferedChannelQueue(e.g. DiskQueue)
+type QueueSpillStore[T any] interface {
+	Offer(val T) error
+	Poll() (T, error)
+	Count() int
+}
+
+// DiskQueue Unbounded FIFO queue persisted in append-only segment files of a directory(it survives restarts)
+//
+// NOTE: the read position is kept in the cursor file, fully read segments are removed.
+// Segments are fsynced when they're rotated and the cursor is fsynced on checkpoints(see SetCursorInterval()),
+// call Sync() for flushing them right away.
+//
+// NOTE: records are checksummed, Poll() returns ErrDiskQueueCorrupted for a corrupted record and goes on with the next valid one,
+// a segment without any valid record after the corrupted one is skipped(renamed with the ".corrupt" suffix).
+// Only the torn tail record of the last segment(an incomplete write) is truncated when it's reopened.
+type DiskQueue[T any] struct {
+	lock     sync.Mutex
+	isClosed bool
+
+	dir            string
+	codec          QueueCodec[T]
+	segmentSize    int
+	cursorInterval int
+
+	segments      []int64
+	segmentCounts []int
+	nextSegment   int64
+	count         int
+	writeFile     *os.File
+	writeCount    int
+	readFile      *os.File
+	readOffset    int64
+	unsavedPolls  int
+}
+
+// NewDiskQueue New DiskQueue instance in the dir(reopening the queued items), each segment file keeps at most segmentSize items
+func NewDiskQueue[T any](dir string, codec QueueCodec[T], segmentSize int) (*DiskQueue[T], error) {
+	if segmentSize <= 0 {
+		segmentSize = 1024
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	q := &DiskQueue[T]{dir: dir, codec: codec, segmentSize: segmentSize, cursorInterval: diskQueueDefaultCursorInterval}
+	if err := q.recover(); err != nil {
+		return nil, err
+	}
+	return q, nil
+}
+
+// Offer Append the T val to the last segment(non-blocking)
+func (q *DiskQueue[T]) Offer(val T) error {
+	data, err := q.codec.Encode(val)
+	if err != nil {
+		return err
+	}
+
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if q.isClosed {
+		return ErrQueueIsClosed
+	}
+	if q.writeFile == nil || q.writeCount >= q.segmentSize {
+		if err := q.openWriteSegment(); err != nil {
+			return err
+		}
+	}
+
+	record := make([]byte, diskQueueHeaderBytes+len(data))
+	binary.BigEndian.PutUint32(record, uint32(len(data)))
+	binary.BigEndian.PutUint32(record[4:], diskQueueChecksum(record[:4], data))
+	copy(record[diskQueueHeaderBytes:], data)
+	if _, err := q.writeFile.Write(record); err != nil {
+		return err
+	}
+	q.writeCount++
+	q.segmentCounts[len(q.segmentCounts)-1]++
+	q.count++
+	return nil
+}
+
+// Poll Poll the T val from the first segment(non-blocking)
+func (q *DiskQueue[T]) Poll() (T, error) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if q.isClosed {
+		return *new(T), ErrQueueIsClosed
+	}
+	if q.count == 0 {
+		return *new(T), ErrQueueIsEmpty
+	}
+
+	data, next, err := q.readRecord()
+	if err != nil {
+		return *new(T), err
+	}
+	// Checkpoint before it's consumed, a failed one keeps the record for the next Poll()
+	offset := q.readOffset
+	q.readOffset = next
+	if q.unsavedPolls++; q.unsavedPolls >= q.cursorInterval {
+		if err := q.saveCursor(); err != nil {
+			q.readOffset = offset
+			q.unsavedPolls--
+			return *new(T), err
+		}
+	}
+	q.count--
+	q.segmentCounts[0]--
+
+	val, err := q.codec.Decode(data)
+	if err != nil {
+		// It's consumed anyway, the next Poll() goes on with the next record
+		return val, fmt.Errorf("%w: %v", ErrDiskQueueCorrupted, err)
+	}
+	return val, nil
+}
+
+// Count Count items
+func (q *DiskQueue[T]) Count() int {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	return q.count
+}
+
+// SetCursorInterval Set the number of polls between cursor checkpoints(1 if <= 0, 64 by default)
+//
+// NOTE: items polled after the last checkpoint are polled again after a crash(Close() saves the cursor).
+func (q *DiskQueue[T]) SetCursorInterval(n int) *DiskQueue[T] {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if n <= 0 {
+		n = 1
+	}
+	q.cursorInterval = n
+	return q
+}
+
+// Sync Flush the segment being written & the cursor to the disk
+func (q *DiskQueue[T]) Sync() error {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if q.isClosed {
+		return ErrQueueIsClosed
+	}
+	return q.sync()
+}
+
+// Close Close the segment files after syncing them(the queued items are kept on the disk)
+func (q *DiskQueue[T]) Close() error {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if q.isClosed {
+		return nil
+	}
+	q.isClosed = true
+
+	err := q.sync()
+	if q.readFile != nil {
+		if closeErr := q.readFile.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	if q.writeFile != nil {
+		if closeErr := q.writeFile.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	return err
+}
+
+func (q *DiskQueue[T]) sync() error {
+	if q.writeFile != nil {
+		if err := q.writeFile.Sync(); err != nil {
+			return err
+		}
+	}
+	return q.saveCursor()
+}
+
+// readRecord Read the next record & the offset after it, removing the fully read segments(the corrupted record is skipped)
+func (q *DiskQueue[T]) readRecord() ([]byte, int64, error) {
+	for {
+		if q.readFile == nil {
+			file, err := os.Open(q.segmentPath(q.segments[0]))
+			if err != nil {
+				return nil, 0, q.skipReadSegment(err)
+			}
+			q.readFile = file
+		}
+
+		info, err := q.readFile.Stat()
+		if err != nil {
+			return nil, 0, err
+		}
+		size := info.Size()
+		if q.readOffset >= size {
+			if len(q.segments) == 1 {
+				// Counted records are missing
+				return nil, 0, q.skipReadSegment(fmt.Errorf("missing %d records", q.segmentCounts[0]))
+			}
+			// Move to the next segment
+			q.readFile.Close()
+			q.readFile = nil
+			os.Remove(q.segmentPath(q.segments[0]))
+			q.count -= q.segmentCounts[0]
+			q.segments, q.segmentCounts = q.segments[1:], q.segmentCounts[1:]
+			q.readOffset = 0
+			continue
+		}
+
+		header := make([]byte, diskQueueHeaderBytes)
+		if q.readOffset+diskQueueHeaderBytes <= size {
+			if _, err := q.readFile.ReadAt(header, q.readOffset); err != nil {
+				return nil, 0, err
+			}
+			next := q.readOffset + diskQueueHeaderBytes + int64(binary.BigEndian.Uint32(header))
+			// Don't allocate a corrupted length
+			if next <= size {
+				data := make([]byte, next-q.readOffset-diskQueueHeaderBytes)
+				if _, err := q.readFile.ReadAt(data, q.readOffset+diskQueueHeaderBytes); err != nil {
+					return nil, 0, err
+				}
+				if diskQueueChecksum(header[:4], data) == binary.BigEndian.Uint32(header[4:]) {
+					return data, next, nil
+				}
+			}
+		}
+		return nil, 0, q.skipReadRecord(size)
+	}
+}
+
+// skipReadRecord Skip the corrupted record being read to the next valid one(or the rest of the segment if there's none), returns ErrDiskQueueCorrupted
+func (q *DiskQueue[T]) skipReadRecord(size int64) error {
+	rest := make([]byte, size-q.readOffset)
+	if _, err := q.readFile.ReadAt(rest, q.readOffset); err != nil {
+		return err
+	}
+	next, ok := diskQueueNextRecord(rest)
+	if !ok {
+		return q.skipReadSegment(fmt.Errorf("no valid record after offset %d", q.readOffset))
+	}
+
+	cause := fmt.Errorf("skipped %d bytes at offset %d", next, q.readOffset)
+	q.readOffset += next
+	q.count--
+	q.segmentCounts[0]--
+	return fmt.Errorf("%w: %v", ErrDiskQueueCorrupted, cause)
+}
+
+// skipReadSegment Skip the rest of the segment being read(renamed with the ".corrupt" suffix for inspection), returns ErrDiskQueueCorrupted
+func (q *DiskQueue[T]) skipReadSegment(cause error) error {
+	if q.readFile != nil {
+		q.readFile.Close()
+		q.readFile = nil
+	}
+	// Writing to it, the next Offer() starts a new segment
+	if len(q.segments) == 1 && q.writeFile != nil {
+		q.writeFile.Close()
+		q.writeFile = nil
+	}
+
+	path := q.segmentPath(q.segments[0])
+	os.Rename(path, path+diskQueueCorruptExt)
+	q.count -= q.segmentCounts[0]
+	q.segments, q.segmentCounts = q.segments[1:], q.segmentCounts[1:]
+	q.readOffset = 0
+
+	if err := q.saveCursor(); err != nil {
+		return fmt.Errorf("%w: %v(saving the cursor: %v)", ErrDiskQueueCorrupted, cause, err)
+	}
+	return fmt.Errorf("%w: %v", ErrDiskQueueCorrupted, cause)
+}
+
+func (q *DiskQueue[T]) openWriteSegment() error {
+	id := q.nextSegment
+	file, err := os.OpenFile(q.segmentPath(id), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	if q.writeFile != nil {
+		// Rotated, the full segment won't be written anymore
+		syncErr := q.writeFile.Sync()
+		q.writeFile.Close()
+		if syncErr != nil {
+			file.Close()
+			os.Remove(q.segmentPath(id))
+			q.writeFile = nil
+			return syncErr
+		}
+	}
+	q.writeFile = file
+	q.writeCount = 0
+	q.nextSegment++
+	q.segments = append(q.segments, id)
+	q.segmentCounts = append(q.segmentCounts, 0)
+	return nil
+}
+
+// saveCursor Save the read position by replacing the cursor file with a fsynced one
+func (q *DiskQueue[T]) saveCursor() error {
+	cursor := make([]byte, 16)
+	if len(q.segments) > 0 {
+		binary.BigEndian.PutUint64(cursor, uint64(q.segments[0]))
+		binary.BigEndian.PutUint64(cursor[8:], uint64(q.readOffset))
+	} else {
+		binary.BigEndian.PutUint64(cursor, uint64(q.nextSegment))
+	}
+
+	path := filepath.Join(q.dir, diskQueueCursorFile)
+	file, err := os.OpenFile(path+".tmp", os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	_, err = file.Write(cursor)
+	if err == nil {
+		err = file.Sync()
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(path+".tmp", path)
+	}
+	if err != nil {
+		return err
+	}
+
+	q.unsavedPolls = 0
+	return nil
+}
+
+// recover Load the segments & the cursor, count the remaining records and drop the torn tail record
+func (q *DiskQueue[T]) recover() error {
+	entries, err := os.ReadDir(q.dir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		if !strings.HasSuffix(name, diskQueueSegmentExt) {
+			continue
+		}
+		id, err := strconv.ParseInt(strings.TrimSuffix(name, diskQueueSegmentExt), 10, 64)
+		if err == nil {
+			q.segments = append(q.segments, id)
+		}
+	}
+	sort.Slice(q.segments, func(i, j int) bool {
+		return q.segments[i] < q.segments[j]
+	})
+
+	if len(q.segments) > 0 {
+		q.nextSegment = q.segments[len(q.segments)-1] + 1
+	}
+
+	cursor, err := os.ReadFile(filepath.Join(q.dir, diskQueueCursorFile))
+	if err == nil && len(cursor) == 16 {
+		cursorSegment := int64(binary.BigEndian.Uint64(cursor))
+		// Segment ids below the cursor would be removed as read ones
+		if q.nextSegment < cursorSegment {
+			q.nextSegment = cursorSegment
+		}
+		for len(q.segments) > 0 && q.segments[0] < cursorSegment {
+			os.Remove(q.segmentPath(q.segments[0]))
+			q.segments = q.segments[1:]
+		}
+		if len(q.segments) > 0 && q.segments[0] == cursorSegment {
+			q.readOffset = int64(binary.BigEndian.Uint64(cursor[8:]))
+		}
+	}
+
+	for i, id := range q.segments {
+		offset := int64(0)
+		if i == 0 {
+			offset = q.readOffset
+		}
+		count, err := q.countRecords(id, offset, i == len(q.segments)-1)
+		if err != nil {
+			return err
+		}
+		q.segmentCounts = append(q.segmentCounts, count)
+		q.count += count
+	}
+	return nil
+}
+
+// countRecords Count the records from the offset(a corrupted one counts as a record, Poll() returns ErrDiskQueueCorrupted for it),
+// the torn tail record of the last segment is truncated
+func (q *DiskQueue[T]) countRecords(id int64, offset int64, isLast bool) (int, error) {
+	path := q.segmentPath(id)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for offset < int64(len(data)) {
+		rest := data[offset:]
+		if next, ok := diskQueueParseRecord(rest); ok {
+			offset += next
+			count++
+			continue
+		}
+		if next, ok := diskQueueNextRecord(rest); ok {
+			offset += next
+			count++
+			continue
+		}
+		// An incomplete write, nothing valid follows it
+		if isLast && diskQueueIsIncomplete(rest) {
+			return count, os.Truncate(path, offset)
+		}
+		// The rest of the segment is corrupted
+		return count + 1, nil
+	}
+	return count, nil
+}
+
+// diskQueueParseRecord Get the offset after the record at the beginning of the data(ok is false if it's incomplete or its checksum doesn't match)
+func diskQueueParseRecord(data []byte) (int64, bool) {
+	if diskQueueIsIncomplete(data) {
+		return 0, false
+	}
+	next := diskQueueHeaderBytes + int64(binary.BigEndian.Uint32(data))
+	return next, diskQueueChecksum(data[:4], data[diskQueueHeaderBytes:next]) == binary.BigEndian.Uint32(data[4:])
+}
+
+// diskQueueChecksum Checksum the length & the data of the record(so zero-filled bytes aren't valid empty records)
+func diskQueueChecksum(length []byte, data []byte) uint32 {
+	return crc32.Update(crc32.Checksum(length, diskQueueCRCTable), diskQueueCRCTable, data)
+}
+
+// diskQueueNextRecord Get the offset of the first valid record after the corrupted one at the beginning of the data
+// (the end of the data if the corrupted one ends there by its length)
+func diskQueueNextRecord(data []byte) (int64, bool) {
+	if !diskQueueIsIncomplete(data) && diskQueueHeaderBytes+int64(binary.BigEndian.Uint32(data)) == int64(len(data)) {
+		return int64(len(data)), true
+	}
+	for offset := 1; offset < len(data); offset++ {
+		if _, ok := diskQueueParseRecord(data[offset:]); ok {
+			return int64(offset), true
+		}
+	}
+	return 0, false
+}
+
+// diskQueueIsIncomplete Is the record at the beginning of the data cut off(by the header or by its length)
+func diskQueueIsIncomplete(data []byte) bool {
+	return len(data) < diskQueueHeaderBytes || diskQueueHeaderBytes+int64(binary.BigEndian.Uint32(data)) > int64(len(data))
+}
+
+func (q *DiskQueue[T]) segmentPath(id int64) string {
+	return filepath.Join(q.dir, fmt.Sprintf("%020d%s", id, diskQueueSegmentExt))
+}
@@ -0,0 +1,252 @@
+package fpgo
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiskQueue(t *testing.T) {
+	dir := t.TempDir()
+	diskQueue, err := NewDiskQueue[string](dir, JSONQueueCodec[string]{}, 2)
+	assert.NoError(t, err)
+
+	for _, val := range []string{"a", "b", "c", "d", "e"} {
+		assert.NoError(t, diskQueue.Offer(val))
+	}
+	assert.Equal(t, 5, diskQueue.Count())
+	val, _ := diskQueue.Poll()
+	assert.Equal(t, "a", val)
+	val, _ = diskQueue.Poll()
+	assert.Equal(t, "b", val)
+	val, _ = diskQueue.Poll()
+	assert.Equal(t, "c", val)
+	// The fully read segment is removed
+	segments, _ := filepath.Glob(filepath.Join(dir, "*.seg"))
+	assert.Equal(t, 2, len(segments))
+	assert.NoError(t, diskQueue.Close())
+	assert.Equal(t, ErrQueueIsClosed, diskQueue.Offer("x"))
+
+	// Reopened with a torn tail record
+	file, _ := os.OpenFile(segments[len(segments)-1], os.O_WRONLY|os.O_APPEND, 0o644)
+	file.Write([]byte{0, 0, 0, 9, '"'})
+	file.Close()
+	diskQueue, err = NewDiskQueue[string](dir, JSONQueueCodec[string]{}, 2)
+	assert.NoError(t, err)
+	defer diskQueue.Close()
+	assert.Equal(t, 2, diskQueue.Count())
+	diskQueue.Offer("f")
+	var actual []string
+	for {
+		val, err := diskQueue.Poll()
+		if err != nil {
+			assert.Equal(t, ErrQueueIsEmpty, err)
+			break
+		}
+		actual = append(actual, val)
+	}
+	assert.Equal(t, []string{"d", "e", "f"}, actual)
+}
+
+func TestBufferedChannelQueueSpill(t *testing.T) {
+	diskQueue, _ := NewDiskQueue[int](t.TempDir(), JSONQueueCodec[int]{}, 2)
+	defer diskQueue.Close()
+	bufferedChannelQueue := NewBufferedChannelQueue[int](1, 1, 10).
+		SetLoadFromPoolDuration(time.Millisecond / 10).
+		SetSpillStore(diskQueue)
+	defer bufferedChannelQueue.Close()
+
+	for i := 1; i <= 6; i++ {
+		assert.NoError(t, bufferedChannelQueue.Offer(i))
+	}
+	assert.Equal(t, 6, bufferedChannelQueue.Count())
+	assert.Greater(t, diskQueue.Count(), 0)
+
+	var actual []int
+	for i := 1; i <= 6; i++ {
+		val, err := bufferedChannelQueue.TakeWithTimeout(time.Second)
+		assert.NoError(t, err)
+		actual = append(actual, val)
+	}
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6}, actual)
+	assert.Equal(t, 0, diskQueue.Count())
+
+	// Corrupted spilled records don't keep spilling the new items
+	for i := 1; i <= 6; i++ {
+		bufferedChannelQueue.Offer(i)
+	}
+	segments, _ := filepath.Glob(filepath.Join(diskQueue.dir, "*.seg"))
+	for _, segment := range segments {
+		os.Truncate(segment, 0)
+	}
+	actual = nil
+	for i := 0; i < 2; i++ {
+		val, _ := bufferedChannelQueue.TakeWithTimeout(time.Second)
+		actual = append(actual, val)
+	}
+	assert.Equal(t, []int{1, 2}, actual)
+	assert.NoError(t, bufferedChannelQueue.Offer(7))
+	val, err := bufferedChannelQueue.TakeWithTimeout(time.Second)
+	assert.NoError(t, err)
+	assert.Equal(t, 7, val)
+	assert.Equal(t, 0, diskQueue.Count())
+}
+
+func TestDiskQueueCorrupted(t *testing.T) {
+	dir := t.TempDir()
+	diskQueue, _ := NewDiskQueue[string](dir, JSONQueueCodec[string]{}, 3)
+	defer diskQueue.Close()
+	for _, val := range []string{"a", "b", "c", "d"} {
+		diskQueue.Offer(val)
+	}
+	segments, _ := filepath.Glob(filepath.Join(dir, "*.seg"))
+	assert.Equal(t, 2, len(segments))
+	recordSize := int64(diskQueueHeaderBytes + len(`"a"`))
+
+	// A record mismatching its checksum is skipped
+	val, _ := diskQueue.Poll()
+	assert.Equal(t, "a", val)
+	file, _ := os.OpenFile(segments[0], os.O_WRONLY, 0o644)
+	file.WriteAt([]byte("!"), recordSize+diskQueueHeaderBytes+1)
+	file.Close()
+	_, err := diskQueue.Poll()
+	assert.True(t, errors.Is(err, ErrDiskQueueCorrupted))
+	assert.Equal(t, 2, diskQueue.Count())
+	val, err = diskQueue.Poll()
+	assert.NoError(t, err)
+	assert.Equal(t, "c", val)
+	val, _ = diskQueue.Poll()
+	assert.Equal(t, "d", val)
+	_, err = diskQueue.Poll()
+	assert.Equal(t, ErrQueueIsEmpty, err)
+
+	// The segment being written is corrupted
+	diskQueue.Offer("e")
+	segments, _ = filepath.Glob(filepath.Join(dir, "*.seg"))
+	os.Truncate(segments[len(segments)-1], 0)
+	_, err = diskQueue.Poll()
+	assert.True(t, errors.Is(err, ErrDiskQueueCorrupted))
+	assert.Equal(t, 0, diskQueue.Count())
+	diskQueue.Offer("f")
+	val, _ = diskQueue.Poll()
+	assert.Equal(t, "f", val)
+}
+
+func TestDiskQueueCorruptedSegment(t *testing.T) {
+	dir := t.TempDir()
+	diskQueue, _ := NewDiskQueue[string](dir, JSONQueueCodec[string]{}, 3)
+	defer diskQueue.Close()
+	for _, val := range []string{"a", "b", "c", "d"} {
+		diskQueue.Offer(val)
+	}
+	segments, _ := filepath.Glob(filepath.Join(dir, "*.seg"))
+	recordSize := int64(diskQueueHeaderBytes + len(`"a"`))
+
+	// No valid record after the corrupted one, the rest of the segment is skipped & kept for inspection
+	file, _ := os.OpenFile(segments[0], os.O_WRONLY, 0o644)
+	file.WriteAt([]byte{0xff, 0xff, 0xff, 0xff}, recordSize)
+	file.WriteAt([]byte{0, 0, 0, 0}, 2*recordSize)
+	file.Close()
+	val, _ := diskQueue.Poll()
+	assert.Equal(t, "a", val)
+	_, err := diskQueue.Poll()
+	assert.True(t, errors.Is(err, ErrDiskQueueCorrupted))
+	assert.Equal(t, 1, diskQueue.Count())
+	corrupted, _ := filepath.Glob(filepath.Join(dir, "*.corrupt"))
+	assert.Equal(t, []string{segments[0] + ".corrupt"}, corrupted)
+	val, err = diskQueue.Poll()
+	assert.NoError(t, err)
+	assert.Equal(t, "d", val)
+}
+
+func TestDiskQueueRecoverCorrupted(t *testing.T) {
+	dir := t.TempDir()
+	diskQueue, _ := NewDiskQueue[string](dir, JSONQueueCodec[string]{}, 10)
+	for _, val := range []string{"a", "b", "c", "d"} {
+		diskQueue.Offer(val)
+	}
+	diskQueue.Close()
+	segments, _ := filepath.Glob(filepath.Join(dir, "*.seg"))
+	recordSize := int64(diskQueueHeaderBytes + len(`"a"`))
+
+	// A corrupted length in the middle isn't taken as a torn tail, the valid records after it are kept
+	file, _ := os.OpenFile(segments[0], os.O_WRONLY, 0o644)
+	file.WriteAt([]byte{0xff, 0xff, 0xff, 0xff}, recordSize)
+	file.Close()
+	diskQueue, _ = NewDiskQueue[string](dir, JSONQueueCodec[string]{}, 10)
+	defer diskQueue.Close()
+	assert.Equal(t, 4, diskQueue.Count())
+	var actual []string
+	var errs int
+	for diskQueue.Count() > 0 {
+		val, err := diskQueue.Poll()
+		if err != nil {
+			assert.True(t, errors.Is(err, ErrDiskQueueCorrupted))
+			errs++
+			continue
+		}
+		actual = append(actual, val)
+	}
+	assert.Equal(t, []string{"a", "c", "d"}, actual)
+	assert.Equal(t, 1, errs)
+	info, _ := os.Stat(segments[0])
+	assert.Equal(t, 4*recordSize, info.Size())
+}
+
+func TestDiskQueueCursorInterval(t *testing.T) {
+	dir := t.TempDir()
+	diskQueue, _ := NewDiskQueue[int](dir, JSONQueueCodec[int]{}, 10)
+	diskQueue.SetCursorInterval(2)
+	for i := 1; i <= 5; i++ {
+		diskQueue.Offer(i)
+	}
+	diskQueue.Poll()
+	_, err := os.Stat(filepath.Join(dir, diskQueueCursorFile))
+	assert.True(t, os.IsNotExist(err))
+	diskQueue.Poll()
+	diskQueue.Poll()
+
+	// Crashed without Close(), polled after the last checkpoint are polled again
+	assert.NoError(t, diskQueue.Sync())
+	reopened, _ := NewDiskQueue[int](dir, JSONQueueCodec[int]{}, 10)
+	assert.Equal(t, 2, reopened.Count())
+	reopened.Close()
+	diskQueue.Poll()
+	reopened, _ = NewDiskQueue[int](dir, JSONQueueCodec[int]{}, 10)
+	assert.Equal(t, 2, reopened.Count())
+	reopened.Close()
+
+	// Close() saves the cursor
+	diskQueue.Close()
+	reopened, _ = NewDiskQueue[int](dir, JSONQueueCodec[int]{}, 10)
+	defer reopened.Close()
+	assert.Equal(t, 1, reopened.Count())
+	val, _ := reopened.Poll()
+	assert.Equal(t, 5, val)
+}
+
+func TestDiskQueueCursorFailed(t *testing.T) {
+	dir := t.TempDir()
+	diskQueue, _ := NewDiskQueue[string](dir, JSONQueueCodec[string]{}, 10)
+	defer diskQueue.Close()
+	diskQueue.SetCursorInterval(1)
+	diskQueue.Offer("a")
+	diskQueue.Offer("b")
+
+	// The checkpoint fails, the record isn't consumed
+	tmpPath := filepath.Join(dir, diskQueueCursorFile+".tmp")
+	os.Mkdir(tmpPath, 0o755)
+	_, err := diskQueue.Poll()
+	assert.Error(t, err)
+	assert.Equal(t, 2, diskQueue.Count())
+
+	os.Remove(tmpPath)
+	val, err := diskQueue.Poll()
+	assert.NoError(t, err)
+	assert.Equal(t, "a", val)
+	assert.Equal(t, 1, diskQueue.Count())
+}
@@ -0,0 +1,94 @@
+package fpgo
+
+import (
+	"context"
+	"sync"
+)
+
+// DisposeBag
+
+// Disposable Resources which could be disposed(e.g. *Subscription[T])
+type Disposable interface {
+	Dispose()
+}
+
+// DisposableFunc Disposable by a function
+type DisposableFunc func()
+
+// Dispose Call the function
+func (fn DisposableFunc) Dispose() {
+	fn()
+}
+
+// DisposeBag Collect Disposable(s) & dispose them all at once, inspired by RxSwift/CompositeDisposable(the zero value is ready to use)
+type DisposeBag struct {
+	lock        sync.Mutex
+	disposables []Disposable
+	isClosed    bool
+	closedCh    chan bool
+}
+
+// NewDisposeBag New a DisposeBag
+func NewDisposeBag() *DisposeBag {
+	return &DisposeBag{closedCh: make(chan bool)}
+}
+
+// NewDisposeBagWithContext New a DisposeBag closed when the ctx is done
+func NewDisposeBagWithContext(ctx context.Context) *DisposeBag {
+	bag := NewDisposeBag()
+	go func() {
+		select {
+		case <-ctx.Done():
+			bag.Close()
+		case <-bag.closedCh:
+		}
+	}()
+
+	return bag
+}
+
+// Add Add Disposable(s) into the bag(disposed right away if the bag has been closed)
+func (bagSelf *DisposeBag) Add(disposables ...Disposable) *DisposeBag {
+	bagSelf.lock.Lock()
+	isClosed := bagSelf.isClosed
+	if !isClosed {
+		bagSelf.disposables = append(bagSelf.disposables, disposables...)
+	}
+	bagSelf.lock.Unlock()
+
+	if isClosed {
+		for _, disposable := range disposables {
+			disposable.Dispose()
+		}
+	}
+	return bagSelf
+}
+
+// Close Dispose all Disposable(s) in the bag
+func (bagSelf *DisposeBag) Close() {
+	bagSelf.lock.Lock()
+	if bagSelf.isClosed {
+		bagSelf.lock.Unlock()
+		return
+	}
+	bagSelf.isClosed = true
+	disposables := bagSelf.disposables
+	bagSelf.disposables = nil
+	// A zero value DisposeBag has no closedCh
+	if bagSelf.closedCh != nil {
+		close(bagSelf.closedCh)
+	}
+	bagSelf.lock.Unlock()
+
+	for _, disposable := range disposables {
+		disposable.Dispose()
+	}
+}
+
+// IsClosed Is the bag closed
+func (bagSelf *DisposeBag) IsClosed() bool {
+	bagSelf.lock.Lock()
+	defer bagSelf.lock.Unlock()
+
+	return bagSelf.isClosed
+}
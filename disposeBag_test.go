@@ -0,0 +1,57 @@
+package fpgo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisposeBag(t *testing.T) {
+	p1 := PublisherNewGenerics[int]()
+	p2 := PublisherNewGenerics[string]()
+	disposed := 0
+
+	bag := NewDisposeBag()
+	bag.Add(
+		p1.Subscribe(Subscription[int]{}),
+		p1.Subscribe(Subscription[int]{}),
+		p2.Subscribe(Subscription[string]{}),
+		DisposableFunc(func() {
+			disposed++
+		}),
+	)
+	assert.Equal(t, 2, len(p1.subscribers))
+	assert.Equal(t, 1, len(p2.subscribers))
+	bag.Close()
+	bag.Close()
+	assert.Equal(t, true, bag.IsClosed())
+	assert.Equal(t, 0, len(p1.subscribers))
+	assert.Equal(t, 0, len(p2.subscribers))
+	assert.Equal(t, 1, disposed)
+
+	// Disposed right away after closed
+	bag.Add(p1.Subscribe(Subscription[int]{}))
+	assert.Equal(t, 0, len(p1.subscribers))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan bool)
+	bag = NewDisposeBagWithContext(ctx)
+	bag.Add(p1.Subscribe(Subscription[int]{}), DisposableFunc(func() {
+		close(done)
+	}))
+	cancel()
+	<-done
+	assert.Equal(t, 0, len(p1.subscribers))
+	assert.Equal(t, true, bag.IsClosed())
+
+	// The zero value
+	var zeroBag DisposeBag
+	disposed = 0
+	zeroBag.Add(DisposableFunc(func() {
+		disposed++
+	}))
+	zeroBag.Close()
+	assert.Equal(t, true, zeroBag.IsClosed())
+	assert.Equal(t, 1, disposed)
+}
@@ -0,0 +1,214 @@
+package fpgo
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// EventBus
+
+// Event An event published to a topic of EventBus(for pattern subscriptions)
+type Event struct {
+	Topic   string
+	Payload interface{}
+}
+
+// EventBusOption Options of EventBus
+type EventBusOption struct {
+	// Scheduler Dispatch events to subscribers on it(e.g. a HandlerDef or a worker.WorkerPool), synchronously if nil
+	Scheduler Scheduler
+}
+
+// TopicOption Options of a topic of EventBus(applied when the topic is created)
+type TopicOption struct {
+	// Backpressure Deliver events to each subscriber of the topic on its own goroutine with the backpressure option if it's set
+	Backpressure *SubscribeOption
+}
+
+// EventBus Typed topics(Topic[T]) built on Publisher with pattern subscriptions
+type EventBus struct {
+	lock   sync.Mutex
+	option EventBusOption
+	topics map[string]eventBusTopic
+	events *PublisherDef[Event]
+}
+
+// eventBusTopic The type-erased TopicDef
+type eventBusTopic interface {
+	typeName() string
+}
+
+// NewEventBus New EventBus
+func NewEventBus(opts ...EventBusOption) *EventBus {
+	var option EventBusOption
+	if len(opts) > 0 {
+		option = opts[0]
+	}
+
+	events := PublisherNewGenerics[Event]()
+	if option.Scheduler != nil {
+		events.SubscribeOn(option.Scheduler)
+	}
+	return &EventBus{
+		option: option,
+		topics: map[string]eventBusTopic{},
+		events: events,
+	}
+}
+
+// SubscribePattern Subscribe events of the topics matching the pattern(segments separated by '.',
+// "*" matches one segment & "**" matches any number of segments, e.g. "order.*" & "order.**")
+//
+// NOTE: events are delivered on the caller of Publish()(or the Scheduler of the EventBus),
+// use SubscribePatternWithOptions() for a slow subscriber; UnsubscribePattern() it when it's not needed.
+func (busSelf *EventBus) SubscribePattern(pattern string, sub Subscription[Event]) *Subscription[Event] {
+	isMatching := eventBusPatternMatcher(pattern)
+	onNext := sub.OnNext
+	sub.OnNext = func(event Event) {
+		if onNext != nil && isMatching(event) {
+			onNext(event)
+		}
+	}
+	return busSelf.events.Subscribe(sub)
+}
+
+// SubscribePatternWithOptions SubscribePattern delivering the matching events on its own goroutine with the backpressure option,
+// so a slow subscriber doesn't stall the publishers of the topics(events are filtered before being buffered)
+func (busSelf *EventBus) SubscribePatternWithOptions(pattern string, sub Subscription[Event], option SubscribeOption) *Subscription[Event] {
+	isMatching := eventBusPatternMatcher(pattern)
+	matched := PublisherNewGenerics[Event]()
+	upstream := &Subscription[Event]{
+		OnNext: func(event Event) {
+			if isMatching(event) {
+				matched.Publish(event)
+			}
+		},
+	}
+	// Unsubscribed(by UnsubscribePattern() or an overflow), stop receiving events of the EventBus
+	matched.onLastUnsubscribe = func() {
+		busSelf.events.Unsubscribe(upstream)
+	}
+
+	s := matched.SubscribeWithOptions(sub, option)
+	busSelf.events.subscribe(upstream)
+	return s
+}
+
+// UnsubscribePattern Unsubscribe the Subscription made by SubscribePattern()/SubscribePatternWithOptions()
+func (busSelf *EventBus) UnsubscribePattern(s *Subscription[Event]) {
+	s.Dispose()
+}
+
+// eventBusPatternMatcher Check whether the topic of the event matches the pattern
+func eventBusPatternMatcher(pattern string) func(Event) bool {
+	patternSegments := strings.Split(pattern, ".")
+	return func(event Event) bool {
+		return matchTopicSegments(patternSegments, strings.Split(event.Topic, "."))
+	}
+}
+
+// Topics Get the names of the created topics(sorted)
+func (busSelf *EventBus) Topics() []string {
+	busSelf.lock.Lock()
+	defer busSelf.lock.Unlock()
+
+	return SortOrderedAscending(Keys(busSelf.topics)...)
+}
+
+// matchTopicSegments Match the topic segments by the pattern segments
+func matchTopicSegments(pattern []string, topic []string) bool {
+	if len(pattern) == 0 {
+		return len(topic) == 0
+	}
+	switch pattern[0] {
+	case "**":
+		for i := 0; i <= len(topic); i++ {
+			if matchTopicSegments(pattern[1:], topic[i:]) {
+				return true
+			}
+		}
+		return false
+	case "*":
+		return len(topic) > 0 && matchTopicSegments(pattern[1:], topic[1:])
+	}
+	return len(topic) > 0 && pattern[0] == topic[0] && matchTopicSegments(pattern[1:], topic[1:])
+}
+
+// TopicDef A typed topic of EventBus
+type TopicDef[T any] struct {
+	bus       *EventBus
+	name      string
+	option    TopicOption
+	publisher *PublisherDef[T]
+}
+
+// Topic Get the typed topic of the name from the EventBus(created if it doesn't exist)
+//
+// NOTE: it panics if the topic has been created with another type
+func Topic[T any](bus *EventBus, name string) *TopicDef[T] {
+	return TopicWithOption[T](bus, name, TopicOption{})
+}
+
+// TopicWithOption Get the typed topic of the name from the EventBus(created with the option if it doesn't exist)
+//
+// NOTE: it panics if the topic has been created with another type
+func TopicWithOption[T any](bus *EventBus, name string, option TopicOption) *TopicDef[T] {
+	bus.lock.Lock()
+	defer bus.lock.Unlock()
+
+	if existing, ok := bus.topics[name]; ok {
+		topic, ok := existing.(*TopicDef[T])
+		if !ok {
+			panic(fmt.Sprintf("fpgo: EventBus topic %q is %s, not %s", name, existing.typeName(), reflect.TypeOf((*T)(nil)).Elem()))
+		}
+		return topic
+	}
+
+	publisher := PublisherNewGenerics[T]()
+	if bus.option.Scheduler != nil && option.Backpressure == nil {
+		publisher.SubscribeOn(bus.option.Scheduler)
+	}
+	topic := &TopicDef[T]{
+		bus:       bus,
+		name:      name,
+		option:    option,
+		publisher: publisher,
+	}
+	bus.topics[name] = topic
+	return topic
+}
+
+// Name Get the name of the topic
+func (topicSelf *TopicDef[T]) Name() string {
+	return topicSelf.name
+}
+
+// Publish Publish the event to the subscribers of the topic & the matching pattern subscribers of the EventBus
+func (topicSelf *TopicDef[T]) Publish(event T) {
+	topicSelf.publisher.Publish(event)
+	topicSelf.bus.events.Publish(Event{Topic: topicSelf.name, Payload: event})
+}
+
+// Subscribe Subscribe events of the topic(with the backpressure option of the topic if it's set)
+func (topicSelf *TopicDef[T]) Subscribe(sub Subscription[T]) *Subscription[T] {
+	if topicSelf.option.Backpressure != nil {
+		return topicSelf.publisher.SubscribeWithOptions(sub, *topicSelf.option.Backpressure)
+	}
+	return topicSelf.publisher.Subscribe(sub)
+}
+
+// Unsubscribe Unsubscribe the Subscription of the topic
+func (topicSelf *TopicDef[T]) Unsubscribe(s *Subscription[T]) {
+	topicSelf.publisher.Unsubscribe(s)
+}
+
+// Publisher Get the Publisher of the topic(for Publisher operators)
+func (topicSelf *TopicDef[T]) Publisher() *PublisherDef[T] {
+	return topicSelf.publisher
+}
+
+func (topicSelf *TopicDef[T]) typeName() string {
+	return reflect.TypeOf((*T)(nil)).Elem().String()
+}
@@ -0,0 +1,149 @@
+package fpgo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventBus(t *testing.T) {
+	bus := NewEventBus()
+	created := Topic[int](bus, "order.created")
+	assert.Equal(t, created, Topic[int](bus, "order.created"))
+	assert.Equal(t, "order.created", created.Name())
+	shipped := Topic[string](bus, "order.item.shipped")
+	Topic[error](bus, "user.error")
+	assert.Equal(t, []string{"order.created", "order.item.shipped", "user.error"}, bus.Topics())
+	assert.PanicsWithValue(t, `fpgo: EventBus topic "order.created" is int, not string`, func() {
+		Topic[string](bus, "order.created")
+	})
+	assert.PanicsWithValue(t, `fpgo: EventBus topic "user.error" is error, not int`, func() {
+		Topic[int](bus, "user.error")
+	})
+
+	var createdIDs []int
+	s := created.Subscribe(Subscription[int]{
+		OnNext: func(id int) {
+			createdIDs = append(createdIDs, id)
+		},
+	})
+	doubled := collectPublisher(PublisherMap(created.Publisher(), func(id int) int {
+		return id * 2
+	}))
+	var oneSegment, anySegments, all []Event
+	bus.SubscribePattern("order.*", Subscription[Event]{OnNext: func(event Event) {
+		oneSegment = append(oneSegment, event)
+	}})
+	bus.SubscribePattern("order.**", Subscription[Event]{OnNext: func(event Event) {
+		anySegments = append(anySegments, event)
+	}})
+	bus.SubscribePattern("**", Subscription[Event]{OnNext: func(event Event) {
+		all = append(all, event)
+	}})
+
+	created.Publish(1)
+	shipped.Publish("book")
+	s.Dispose()
+	created.Publish(2)
+
+	assert.Equal(t, []int{1}, createdIDs)
+	assert.Equal(t, []int{2, 4}, *doubled)
+	assert.Equal(t, []Event{{"order.created", 1}, {"order.created", 2}}, oneSegment)
+	assert.Equal(t, []Event{{"order.created", 1}, {"order.item.shipped", "book"}, {"order.created", 2}}, anySegments)
+	assert.Equal(t, anySegments, all)
+
+	// Unsubscribe pattern subscriptions
+	var unsubscribed []Event
+	patterned := bus.SubscribePattern("order.*", Subscription[Event]{OnNext: func(event Event) {
+		unsubscribed = append(unsubscribed, event)
+	}})
+	assert.Equal(t, 4, len(bus.events.subscribers))
+	created.Publish(3)
+	bus.UnsubscribePattern(patterned)
+	created.Publish(4)
+	assert.Equal(t, []Event{{"order.created", 3}}, unsubscribed)
+	assert.Equal(t, 3, len(bus.events.subscribers))
+
+	assert.Equal(t, true, matchTopicSegments([]string{"a", "**", "c"}, []string{"a", "c"}))
+	assert.Equal(t, true, matchTopicSegments([]string{"a", "**", "c"}, []string{"a", "b", "b", "c"}))
+	assert.Equal(t, false, matchTopicSegments([]string{"a", "*", "c"}, []string{"a", "c"}))
+	assert.Equal(t, false, matchTopicSegments([]string{"a"}, []string{"a", "b"}))
+}
+
+func TestEventBusAsync(t *testing.T) {
+	h := Handler.New()
+	defer h.Close()
+	bus := NewEventBus(EventBusOption{Scheduler: h})
+	received := make(chan int, 10)
+	topic := Topic[int](bus, "tick")
+	topic.Subscribe(Subscription[int]{OnNext: func(v int) {
+		received <- v
+	}})
+	patterned := make(chan Event, 10)
+	bus.SubscribePattern("*", Subscription[Event]{OnNext: func(event Event) {
+		patterned <- event
+	}})
+	topic.Publish(1)
+	topic.Publish(2)
+	assert.Equal(t, []int{1, 2}, []int{<-received, <-received})
+	assert.Equal(t, Event{"tick", 2}, func() Event {
+		<-patterned
+		return <-patterned
+	}())
+
+	// Backpressure
+	slow := TopicWithOption[int](bus, "slow", TopicOption{Backpressure: &SubscribeOption{Backpressure: BackpressureLatest}})
+	release := make(chan bool)
+	latest := make(chan int, 10)
+	slow.Subscribe(Subscription[int]{OnNext: func(v int) {
+		<-release
+		latest <- v
+	}})
+	slow.Publish(1)
+	time.Sleep(5 * time.Millisecond)
+	slow.Publish(2)
+	slow.Publish(3)
+	close(release)
+	assert.Equal(t, 1, <-latest)
+	assert.Equal(t, 3, <-latest)
+	select {
+	case v := <-latest:
+		assert.Fail(t, "unexpected", v)
+	case <-time.After(5 * time.Millisecond):
+	}
+}
+
+func TestEventBusPatternBackpressure(t *testing.T) {
+	bus := NewEventBus()
+	fast := Topic[int](bus, "metric.fast")
+	other := Topic[int](bus, "log.other")
+	release := make(chan bool)
+	latest := make(chan Event, 10)
+	s := bus.SubscribePatternWithOptions("metric.*", Subscription[Event]{OnNext: func(event Event) {
+		<-release
+		latest <- event
+	}}, SubscribeOption{Backpressure: BackpressureLatest})
+
+	// The publisher isn't blocked by the slow subscriber
+	fast.Publish(1)
+	time.Sleep(5 * time.Millisecond)
+	fast.Publish(2)
+	fast.Publish(3)
+	// Not matching, it doesn't replace the latest matching one
+	other.Publish(4)
+	close(release)
+	assert.Equal(t, Event{"metric.fast", 1}, <-latest)
+	assert.Equal(t, Event{"metric.fast", 3}, <-latest)
+
+	// Unsubscribed from the EventBus as well
+	assert.Equal(t, 1, len(bus.events.subscribers))
+	bus.UnsubscribePattern(s)
+	assert.Equal(t, 0, len(bus.events.subscribers))
+	fast.Publish(5)
+	select {
+	case event := <-latest:
+		assert.Fail(t, "unexpected", event)
+	case <-time.After(5 * time.Millisecond):
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"regexp"
 	"sort"
+	"strings"
 	"sync"
 )
 
@@ -108,6 +109,138 @@ func PipeInterface(fnList ...func(...interface{}) []interface{}) func(...interfa
 	return Pipe(fnList...)
 }
 
+// ComposeErr Compose the error-returning functions from right to left, short-circuiting on the first error
+func ComposeErr[T any](fnList ...func(T) (T, error)) func(T) (T, error) {
+	return func(s T) (T, error) {
+		var err error
+		for i := len(fnList) - 1; i >= 0; i-- {
+			s, err = fnList[i](s)
+			if err != nil {
+				return s, err
+			}
+		}
+
+		return s, nil
+	}
+}
+
+// PipeErr Pipe the error-returning functions from left to right, short-circuiting on the first error
+func PipeErr[T any](fnList ...func(T) (T, error)) func(T) (T, error) {
+	return func(s T) (T, error) {
+		var err error
+		for _, fn := range fnList {
+			s, err = fn(s)
+			if err != nil {
+				return s, err
+			}
+		}
+
+		return s, nil
+	}
+}
+
+// Pipe2 Pipe 2 functions from left to right, each one could change the type
+func Pipe2[A any, B any, C any](f func(A) B, g func(B) C) func(A) C {
+	return func(a A) C {
+		return g(f(a))
+	}
+}
+
+// Pipe3 Pipe 3 functions from left to right, each one could change the type
+func Pipe3[A any, B any, C any, D any](f func(A) B, g func(B) C, h func(C) D) func(A) D {
+	return func(a A) D {
+		return h(g(f(a)))
+	}
+}
+
+// Pipe4 Pipe 4 functions from left to right, each one could change the type
+func Pipe4[A any, B any, C any, D any, E any](f func(A) B, g func(B) C, h func(C) D, i func(D) E) func(A) E {
+	return func(a A) E {
+		return i(h(g(f(a))))
+	}
+}
+
+// Pipe5 Pipe 5 functions from left to right, each one could change the type
+func Pipe5[A any, B any, C any, D any, E any, F any](f func(A) B, g func(B) C, h func(C) D, i func(D) E, j func(E) F) func(A) F {
+	return func(a A) F {
+		return j(i(h(g(f(a)))))
+	}
+}
+
+// Pipe6 Pipe 6 functions from left to right, each one could change the type
+func Pipe6[A any, B any, C any, D any, E any, F any, G any](f func(A) B, g func(B) C, h func(C) D, i func(D) E, j func(E) F, k func(F) G) func(A) G {
+	return func(a A) G {
+		return k(j(i(h(g(f(a))))))
+	}
+}
+
+// Pipe7 Pipe 7 functions from left to right, each one could change the type
+func Pipe7[A any, B any, C any, D any, E any, F any, G any, H any](f func(A) B, g func(B) C, h func(C) D, i func(D) E, j func(E) F, k func(F) G, l func(G) H) func(A) H {
+	return func(a A) H {
+		return l(k(j(i(h(g(f(a)))))))
+	}
+}
+
+// Pipe8 Pipe 8 functions from left to right, each one could change the type
+func Pipe8[A any, B any, C any, D any, E any, F any, G any, H any, I any](f func(A) B, g func(B) C, h func(C) D, i func(D) E, j func(E) F, k func(F) G, l func(G) H, m func(H) I) func(A) I {
+	return func(a A) I {
+		return m(l(k(j(i(h(g(f(a))))))))
+	}
+}
+
+// Pipe9 Pipe 9 functions from left to right, each one could change the type
+func Pipe9[A any, B any, C any, D any, E any, F any, G any, H any, I any, J any](f func(A) B, g func(B) C, h func(C) D, i func(D) E, j func(E) F, k func(F) G, l func(G) H, m func(H) I, n func(I) J) func(A) J {
+	return func(a A) J {
+		return n(m(l(k(j(i(h(g(f(a)))))))))
+	}
+}
+
+// PipeErr2 Pipe 2 error-returning functions from left to right(each one could change the type), short-circuiting on the first error
+func PipeErr2[A any, B any, C any](f func(A) (B, error), g func(B) (C, error)) func(A) (C, error) {
+	return func(a A) (C, error) {
+		b, err := f(a)
+		if err != nil {
+			return *new(C), err
+		}
+		return g(b)
+	}
+}
+
+// PipeErr3 Pipe 3 error-returning functions from left to right(each one could change the type), short-circuiting on the first error
+func PipeErr3[A any, B any, C any, D any](f func(A) (B, error), g func(B) (C, error), h func(C) (D, error)) func(A) (D, error) {
+	return PipeErr2(PipeErr2(f, g), h)
+}
+
+// PipeErr4 Pipe 4 error-returning functions from left to right(each one could change the type), short-circuiting on the first error
+func PipeErr4[A any, B any, C any, D any, E any](f func(A) (B, error), g func(B) (C, error), h func(C) (D, error), i func(D) (E, error)) func(A) (E, error) {
+	return PipeErr2(PipeErr3(f, g, h), i)
+}
+
+// PipeErr5 Pipe 5 error-returning functions from left to right(each one could change the type), short-circuiting on the first error
+func PipeErr5[A any, B any, C any, D any, E any, F any](f func(A) (B, error), g func(B) (C, error), h func(C) (D, error), i func(D) (E, error), j func(E) (F, error)) func(A) (F, error) {
+	return PipeErr2(PipeErr4(f, g, h, i), j)
+}
+
+// PipeErr6 Pipe 6 error-returning functions from left to right(each one could change the type), short-circuiting on the first error
+func PipeErr6[A any, B any, C any, D any, E any, F any, G any](f func(A) (B, error), g func(B) (C, error), h func(C) (D, error), i func(D) (E, error), j func(E) (F, error), k func(F) (G, error)) func(A) (G, error) {
+	return PipeErr2(PipeErr5(f, g, h, i, j), k)
+}
+
+// PipeErr7 Pipe 7 error-returning functions from left to right(each one could change the type), short-circuiting on the first error
+func PipeErr7[A any, B any, C any, D any, E any, F any, G any, H any](f func(A) (B, error), g func(B) (C, error), h func(C) (D, error), i func(D) (E, error), j func(E) (F, error), k func(F) (G, error), l func(G) (H, error)) func(A) (H, error) {
+	return PipeErr2(PipeErr6(f, g, h, i, j, k), l)
+}
+
+// PipeErr8 Pipe 8 error-returning functions from left to right(each one could change the type), short-circuiting on the first error
+func PipeErr8[A any, B any, C any, D any, E any, F any, G any, H any, I any](f func(A) (B, error), g func(B) (C, error), h func(C) (D, error), i func(D) (E, error), j func(E) (F, error), k func(F) (G, error), l func(G) (H, error), m func(H) (I, error)) func(A) (I, error) {
+	return PipeErr2(PipeErr7(f, g, h, i, j, k, l), m)
+}
+
+// PipeErr9 Pipe 9 error-returning functions from left to right(each one could change the type), short-circuiting on the first error
+func PipeErr9[A any, B any, C any, D any, E any, F any, G any, H any, I any, J any](f func(A) (B, error), g func(B) (C, error), h func(C) (D, error), i func(D) (E, error), j func(E) (F, error), k func(F) (G, error), l func(G) (H, error), m func(H) (I, error), n func(I) (J, error)) func(A) (J, error) {
+	return PipeErr2(PipeErr8(f, g, h, i, j, k, l, m), n)
+}
+
 // Map Map the values to the function from left to right
 func Map[T any, R any](fn TransformerFunctor[T, R], values ...T) []R {
 	result := make([]R, len(values))
@@ -823,6 +956,59 @@ func ValuesForInterface[R any](m map[interface{}]R) []R {
 	return keys
 }
 
+// MapKeys returns a new map with the keys transformed by fn (the last one wins for the same new key)
+func MapKeys[K comparable, V any, R comparable](m map[K]V, fn func(K) R) map[R]V {
+	result := make(map[R]V, len(m))
+	for k, v := range m {
+		result[fn(k)] = v
+	}
+	return result
+}
+
+// MapValues returns a new map with the values transformed by fn
+func MapValues[K comparable, V any, R any](m map[K]V, fn func(V) R) map[K]R {
+	result := make(map[K]R, len(m))
+	for k, v := range m {
+		result[k] = fn(v)
+	}
+	return result
+}
+
+// FilterMapByKV returns a new map of the entries satisfying the predicate
+func FilterMapByKV[K comparable, V any](m map[K]V, predicate func(K, V) bool) map[K]V {
+	result := make(map[K]V)
+	for k, v := range m {
+		if predicate(k, v) {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// MergeMaps merges maps into a new map, resolve(key, existing, incoming) decides the value of a conflicting key
+// (the latter one wins if resolve is nil)
+func MergeMaps[K comparable, V any](resolve func(K, V, V) V, maps ...map[K]V) map[K]V {
+	result := make(map[K]V)
+	for _, m := range maps {
+		for k, v := range m {
+			if existing, ok := result[k]; ok && resolve != nil {
+				v = resolve(k, existing, v)
+			}
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// InvertMap returns a new map with keys & values swapped (any of the keys wins for the same value)
+func InvertMap[K comparable, V comparable](m map[K]V) map[V]K {
+	result := make(map[V]K, len(m))
+	for k, v := range m {
+		result[v] = k
+	}
+	return result
+}
+
 // Max returns max item from the list.
 // Return 0 if the list is either empty or nil
 func Max[T Numeric](list ...T) T {
@@ -1421,6 +1607,50 @@ func SplitEvery[T any](size int, list ...T) [][]T {
 	return result
 }
 
+// ZipSlices pairs up elements of two slices by index as Tuple2 values (stopped at the shorter one)
+func ZipSlices[A any, B any](list1 []A, list2 []B) []Tuple2[A, B] {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	result := make([]Tuple2[A, B], minLen)
+	for i := 0; i < minLen; i++ {
+		result[i] = NewTuple2(list1[i], list2[i])
+	}
+	return result
+}
+
+// UnzipSlices splits Tuple2 values into the slices of their first & second values (the reverse of ZipSlices)
+func UnzipSlices[A any, B any](list []Tuple2[A, B]) ([]A, []B) {
+	result1 := make([]A, len(list))
+	result2 := make([]B, len(list))
+	for i, tuple := range list {
+		result1[i], result2[i] = tuple.Unpack()
+	}
+	return result1, result2
+}
+
+// CartesianProduct pairs up every element of the first slice with every element of the second one as Tuple2 values (in row-major order)
+func CartesianProduct[A any, B any](list1 []A, list2 []B) []Tuple2[A, B] {
+	result := make([]Tuple2[A, B], 0, len(list1)*len(list2))
+	for _, v1 := range list1 {
+		for _, v2 := range list2 {
+			result = append(result, NewTuple2(v1, v2))
+		}
+	}
+	return result
+}
+
+// IndexBy creates a map where the key is the identifier of the element (the last one wins for the same identifier)
+func IndexBy[T any, R comparable](identify TransformerFunctor[T, R], list ...T) map[R]T {
+	result := make(map[R]T, len(list))
+	for _, v := range list {
+		result[identify(v)] = v
+	}
+	return result
+}
+
 // Trampoline Trampoline
 func Trampoline[T any](fn func(...T) ([]T, bool, error), input ...T) ([]T, error) {
 	result := input
@@ -1699,6 +1929,85 @@ func CurryParam6[T any, R any, A any, B any, C any, D any, E any, F any](fn func
 	}
 }
 
+// Partial Application
+
+// Partial1Of2 Bind the first 1 arg of the 2-arity fn (Partial Application)
+func Partial1Of2[A any, B any, R any](fn func(A, B) R, a A) func(B) R {
+	return func(b B) R {
+		return fn(a, b)
+	}
+}
+
+// Partial1Of3 Bind the first 1 arg of the 3-arity fn (Partial Application)
+func Partial1Of3[A any, B any, C any, R any](fn func(A, B, C) R, a A) func(B, C) R {
+	return func(b B, c C) R {
+		return fn(a, b, c)
+	}
+}
+
+// Partial2Of3 Bind the first 2 args of the 3-arity fn (Partial Application)
+func Partial2Of3[A any, B any, C any, R any](fn func(A, B, C) R, a A, b B) func(C) R {
+	return func(c C) R {
+		return fn(a, b, c)
+	}
+}
+
+// Partial1Of4 Bind the first 1 arg of the 4-arity fn (Partial Application)
+func Partial1Of4[A any, B any, C any, D any, R any](fn func(A, B, C, D) R, a A) func(B, C, D) R {
+	return func(b B, c C, d D) R {
+		return fn(a, b, c, d)
+	}
+}
+
+// Partial2Of4 Bind the first 2 args of the 4-arity fn (Partial Application)
+func Partial2Of4[A any, B any, C any, D any, R any](fn func(A, B, C, D) R, a A, b B) func(C, D) R {
+	return func(c C, d D) R {
+		return fn(a, b, c, d)
+	}
+}
+
+// Partial3Of4 Bind the first 3 args of the 4-arity fn (Partial Application)
+func Partial3Of4[A any, B any, C any, D any, R any](fn func(A, B, C, D) R, a A, b B, c C) func(D) R {
+	return func(d D) R {
+		return fn(a, b, c, d)
+	}
+}
+
+// Partial1Of5 Bind the first 1 arg of the 5-arity fn (Partial Application)
+func Partial1Of5[A any, B any, C any, D any, E any, R any](fn func(A, B, C, D, E) R, a A) func(B, C, D, E) R {
+	return func(b B, c C, d D, e E) R {
+		return fn(a, b, c, d, e)
+	}
+}
+
+// Partial2Of5 Bind the first 2 args of the 5-arity fn (Partial Application)
+func Partial2Of5[A any, B any, C any, D any, E any, R any](fn func(A, B, C, D, E) R, a A, b B) func(C, D, E) R {
+	return func(c C, d D, e E) R {
+		return fn(a, b, c, d, e)
+	}
+}
+
+// Partial3Of5 Bind the first 3 args of the 5-arity fn (Partial Application)
+func Partial3Of5[A any, B any, C any, D any, E any, R any](fn func(A, B, C, D, E) R, a A, b B, c C) func(D, E) R {
+	return func(d D, e E) R {
+		return fn(a, b, c, d, e)
+	}
+}
+
+// Partial4Of5 Bind the first 4 args of the 5-arity fn (Partial Application)
+func Partial4Of5[A any, B any, C any, D any, E any, R any](fn func(A, B, C, D, E) R, a A, b B, c C, d D) func(E) R {
+	return func(e E) R {
+		return fn(a, b, c, d, e)
+	}
+}
+
+// Flip Swap the 2 args of fn
+func Flip[A any, B any, R any](fn func(A, B) R) func(B, A) R {
+	return func(b B, a A) R {
+		return fn(a, b)
+	}
+}
+
 // CurryDef Curry inspired by Currying in Java ways
 type CurryDef[T any, R any] struct {
 	fn     func(c *CurryDef[T, R], args ...T) R
@@ -1914,6 +2223,219 @@ func Either(value interface{}, patterns ...Pattern) interface{} {
 	return DefPattern(patterns...).MatchFor(value)
 }
 
+// PatternMatching Guards & Extractors
+
+// PredicatePatternDef Pattern which matching when the predicate passes
+type PredicatePatternDef struct {
+	predicate Predicate[interface{}]
+	effect    fnObj
+}
+
+// GuardPatternDef Pattern which matching when the inner Pattern matches and the guard passes
+type GuardPatternDef struct {
+	pattern Pattern
+	guard   Predicate[interface{}]
+}
+
+// ExtractorPatternDef Pattern which matching when a sub-value is extracted and it matches the inner Pattern
+type ExtractorPatternDef struct {
+	extract func(interface{}) (interface{}, bool)
+	pattern Pattern
+}
+
+// ProductPatternDef Pattern which matching when each element of the []interface{} matches the Pattern at the same index
+type ProductPatternDef struct {
+	patterns []Pattern
+	effect   fnObj
+}
+
+// Matches Match the given value by the pattern
+func (patternSelf PredicatePatternDef) Matches(value interface{}) bool {
+	return patternSelf.predicate(value)
+}
+
+// Matches Match the given value by the pattern
+func (patternSelf GuardPatternDef) Matches(value interface{}) bool {
+	return patternSelf.pattern.Matches(value) && patternSelf.guard(value)
+}
+
+// Matches Match the given value by the pattern
+func (patternSelf ExtractorPatternDef) Matches(value interface{}) bool {
+	extracted, ok := patternSelf.extract(value)
+	return ok && patternSelf.pattern.Matches(extracted)
+}
+
+// Matches Match the given value by the pattern
+func (patternSelf ProductPatternDef) Matches(value interface{}) bool {
+	values, ok := value.([]interface{})
+	if !ok || len(values) != len(patternSelf.patterns) {
+		return false
+	}
+	for i, pattern := range patternSelf.patterns {
+		if !pattern.Matches(values[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Apply Evaluate the result by its given effect function
+func (patternSelf PredicatePatternDef) Apply(value interface{}) interface{} {
+	return patternSelf.effect(value)
+}
+
+// Apply Evaluate the result by the inner Pattern
+func (patternSelf GuardPatternDef) Apply(value interface{}) interface{} {
+	return patternSelf.pattern.Apply(value)
+}
+
+// Apply Evaluate the result by the inner Pattern with the extracted sub-value
+func (patternSelf ExtractorPatternDef) Apply(value interface{}) interface{} {
+	extracted, _ := patternSelf.extract(value)
+	return patternSelf.pattern.Apply(extracted)
+}
+
+// Apply Evaluate the result by its given effect function
+func (patternSelf ProductPatternDef) Apply(value interface{}) interface{} {
+	return patternSelf.effect(value)
+}
+
+// InCaseOfPredicate In case of the predicate passes for its value
+func InCaseOfPredicate(predicate Predicate[interface{}], effect fnObj) Pattern {
+	return PredicatePatternDef{predicate: predicate, effect: effect}
+}
+
+// InCaseOfGuard In case of the pattern matches and the guard passes for its value
+func InCaseOfGuard(pattern Pattern, guard Predicate[interface{}]) Pattern {
+	return GuardPatternDef{pattern: pattern, guard: guard}
+}
+
+// InCaseOfExtractor In case of a sub-value is extracted from its value and the pattern matches the sub-value(bound to the effect)
+func InCaseOfExtractor(extract func(interface{}) (interface{}, bool), pattern Pattern) Pattern {
+	return ExtractorPatternDef{extract: extract, pattern: pattern}
+}
+
+// InCaseOfProduct In case of its value is a []interface{} and each element matches the pattern at the same index
+func InCaseOfProduct(effect fnObj, patterns ...Pattern) Pattern {
+	return ProductPatternDef{patterns: patterns, effect: effect}
+}
+
+// InCaseOfType In case of its value is a T(the T value is bound to the effect)
+func InCaseOfType[T any](effect func(T) interface{}) Pattern {
+	return InCaseOfExtractor(ExtractType[T](), Otherwise(func(value interface{}) interface{} {
+		return effect(value.(T))
+	}))
+}
+
+// RegexGroupsPatternDef Pattern which matching when the regex matches the given string(the captured groups are bound to the effect)
+type RegexGroupsPatternDef struct {
+	regex  *regexp.Regexp
+	effect func([]string) interface{}
+}
+
+// Matches Match the given value by the pattern
+func (patternSelf RegexGroupsPatternDef) Matches(value interface{}) bool {
+	str, ok := value.(string)
+	return ok && patternSelf.regex.MatchString(str)
+}
+
+// Apply Evaluate the result by its given effect function with the whole match & the captured groups
+func (patternSelf RegexGroupsPatternDef) Apply(value interface{}) interface{} {
+	return patternSelf.effect(patternSelf.regex.FindStringSubmatch(value.(string)))
+}
+
+// InCaseOfRegexGroups In case of the given regex rule matches its value, the effect gets the whole match & the captured groups
+// (it panics if the regex can't be compiled, like regexp.MustCompile)
+func InCaseOfRegexGroups(pattern string, effect func(groups []string) interface{}) Pattern {
+	return RegexGroupsPatternDef{regex: regexp.MustCompile(pattern), effect: effect}
+}
+
+// InCaseOfPrefix In case of its value is a string with the prefix, the rest after the prefix is bound to the effect
+func InCaseOfPrefix(prefix string, effect fnObj) Pattern {
+	return InCaseOfExtractor(func(value interface{}) (interface{}, bool) {
+		str, ok := value.(string)
+		if !ok || !strings.HasPrefix(str, prefix) {
+			return nil, false
+		}
+		return str[len(prefix):], true
+	}, Otherwise(effect))
+}
+
+// InCaseOfSuffix In case of its value is a string with the suffix, the rest before the suffix is bound to the effect
+func InCaseOfSuffix(suffix string, effect fnObj) Pattern {
+	return InCaseOfExtractor(func(value interface{}) (interface{}, bool) {
+		str, ok := value.(string)
+		if !ok || !strings.HasSuffix(str, suffix) {
+			return nil, false
+		}
+		return str[:len(str)-len(suffix)], true
+	}, Otherwise(effect))
+}
+
+// InCaseOfRange In case of its value is a number(int/uint/float kinds) and min <= value <= max
+func InCaseOfRange(min float64, max float64, effect fnObj) Pattern {
+	return InCaseOfPredicate(func(value interface{}) bool {
+		if value == nil {
+			return false
+		}
+
+		var number float64
+		reflectValue := reflect.ValueOf(value)
+		switch reflectValue.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			number = float64(reflectValue.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			number = float64(reflectValue.Uint())
+		case reflect.Float32, reflect.Float64:
+			number = reflectValue.Float()
+		default:
+			return false
+		}
+		return min <= number && number <= max
+	}, effect)
+}
+
+// ExtractType Extractor of the T value
+func ExtractType[T any]() func(interface{}) (interface{}, bool) {
+	return func(value interface{}) (interface{}, bool) {
+		result, ok := value.(T)
+		return result, ok
+	}
+}
+
+// ExtractJust Extractor of the inner value of a present MaybeDef[T]
+func ExtractJust[T any]() func(interface{}) (interface{}, bool) {
+	return func(value interface{}) (interface{}, bool) {
+		maybe, ok := value.(MaybeDef[T])
+		if !ok || !maybe.IsPresent() {
+			return nil, false
+		}
+		return maybe.Unwrap(), true
+	}
+}
+
+// ExtractTuple2 Extractor of the fields of a Tuple2[A, B] as a []interface{}(for InCaseOfProduct)
+func ExtractTuple2[A any, B any]() func(interface{}) (interface{}, bool) {
+	return func(value interface{}) (interface{}, bool) {
+		tuple, ok := value.(Tuple2[A, B])
+		if !ok {
+			return nil, false
+		}
+		return []interface{}{tuple.V1, tuple.V2}, true
+	}
+}
+
+// ExtractTuple3 Extractor of the fields of a Tuple3[A, B, C] as a []interface{}(for InCaseOfProduct)
+func ExtractTuple3[A any, B any, C any]() func(interface{}) (interface{}, bool) {
+	return func(value interface{}) (interface{}, bool) {
+		tuple, ok := value.(Tuple3[A, B, C])
+		if !ok {
+			return nil, false
+		}
+		return []interface{}{tuple.V1, tuple.V2, tuple.V3}, true
+	}
+}
+
 // SumType
 
 // CompData Composite Data with values & its CompType(SumType)
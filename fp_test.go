@@ -1,8 +1,10 @@
 package fpgo
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
+	"strconv"
 	"strings"
 	"testing"
 
@@ -70,6 +72,99 @@ func TestCompose(t *testing.T) {
 	assert.Equal(t, expectedinteger, Pipe(fn01, fn02, fn03)((0))[0])
 }
 
+func TestComposeErr(t *testing.T) {
+	var trace []string
+	step := func(name string, fail bool) func(int) (int, error) {
+		return func(val int) (int, error) {
+			trace = append(trace, name)
+			if fail {
+				return val, fmt.Errorf("%s failed", name)
+			}
+			return val*10 + len(name), nil
+		}
+	}
+
+	result, err := ComposeErr(step("a", false), step("bb", false))(0)
+	assert.NoError(t, err)
+	assert.Equal(t, 21, result)
+	assert.Equal(t, []string{"bb", "a"}, trace)
+
+	trace = nil
+	result, err = PipeErr(step("a", false), step("bb", false))(0)
+	assert.NoError(t, err)
+	assert.Equal(t, 12, result)
+	assert.Equal(t, []string{"a", "bb"}, trace)
+
+	trace = nil
+	_, err = PipeErr(step("a", false), step("bb", true), step("ccc", false))(0)
+	assert.EqualError(t, err, "bb failed")
+	assert.Equal(t, []string{"a", "bb"}, trace)
+
+	result, err = PipeErr[int]()(7)
+	assert.NoError(t, err)
+	assert.Equal(t, 7, result)
+}
+
+func TestPipeN(t *testing.T) {
+	length := func(s string) int {
+		return len(s)
+	}
+	double := func(v int) int {
+		return v * 2
+	}
+	isEven := func(v int) bool {
+		return v%2 == 0
+	}
+	describe := func(b bool) string {
+		return fmt.Sprint(b)
+	}
+
+	assert.Equal(t, 6, Pipe2(length, double)("abc"))
+	assert.Equal(t, true, Pipe3(length, double, isEven)("abc"))
+	assert.Equal(t, "false", Pipe3(length, isEven, describe)("abc"))
+	assert.Equal(t, 4, Pipe5(length, double, isEven, describe, length)("abc"))
+	assert.Equal(t, 256, Pipe9(length, double, double, double, double, double, double, double, double)("a"))
+}
+
+func TestPipeErrN(t *testing.T) {
+	errOdd := errors.New("odd")
+	calls := 0
+	parse := func(s string) (int, error) {
+		calls++
+		return strconv.Atoi(s)
+	}
+	half := func(v int) (int, error) {
+		calls++
+		if v%2 != 0 {
+			return 0, errOdd
+		}
+		return v / 2, nil
+	}
+	describe := func(v int) (string, error) {
+		calls++
+		return "#" + strconv.Itoa(v), nil
+	}
+
+	result, err := PipeErr2(parse, half)("8")
+	assert.NoError(t, err)
+	assert.Equal(t, 4, result)
+	described, err := PipeErr3(parse, half, describe)("8")
+	assert.NoError(t, err)
+	assert.Equal(t, "#4", described)
+	described, err = PipeErr5(parse, half, half, half, describe)("8")
+	assert.NoError(t, err)
+	assert.Equal(t, "#1", described)
+
+	// Short-circuited
+	calls = 0
+	described, err = PipeErr9(parse, half, half, half, half, half, half, half, describe)("8")
+	assert.Equal(t, errOdd, err)
+	assert.Equal(t, "", described)
+	assert.Equal(t, 5, calls)
+	_, err = PipeErr2(parse, half)("x")
+	assert.True(t, errors.Is(err, strconv.ErrSyntax))
+}
+
 func TestFPFunctions(t *testing.T) {
 	expectedinteger := 0
 
@@ -149,6 +244,13 @@ func TestFPFunctions(t *testing.T) {
 	assert.Equal(t, []int{2, 5}, SortOrderedAscending(Keys(IntersectionMapByKey(map[int]int{2: 11, 5: 11, 1: 12}, map[int]int{41: 1, 2: 77, 42: 1, 5: 66, 43: 2}))...))
 	assert.Equal(t, []int{1, 2, 3}, SortOrderedAscending(Keys(map[int]int{2: 8, 1: 5, 3: 4})...))
 	assert.Equal(t, []int{4, 5, 8}, SortOrderedAscending(Values(map[int]int{2: 8, 1: 5, 3: 4})...))
+	assert.Equal(t, map[string]int{"2": 8, "1": 5}, MapKeys(map[int]int{2: 8, 1: 5}, strconv.Itoa))
+	assert.Equal(t, map[int]string{2: "8", 1: "5"}, MapValues(map[int]int{2: 8, 1: 5}, strconv.Itoa))
+	assert.Equal(t, map[int]int{2: 8}, FilterMapByKV(map[int]int{2: 8, 1: 5, 3: 4}, func(k int, v int) bool { return k%2 == 0 || v == 8 }))
+	assert.Equal(t, map[int]int{1: 5, 2: 9, 3: 4}, MergeMaps(nil, map[int]int{2: 8, 1: 5}, map[int]int{3: 4}, map[int]int{2: 9}))
+	assert.Equal(t, map[int]int{1: 5, 2: 17, 3: 4}, MergeMaps(func(k int, a int, b int) int { return a + b }, map[int]int{2: 8, 1: 5}, nil, map[int]int{3: 4, 2: 9}))
+	assert.Equal(t, map[int]int{}, MergeMaps[int, int](nil))
+	assert.Equal(t, map[int]string{8: "a", 5: "b"}, InvertMap(map[string]int{"a": 8, "b": 5}))
 	assert.Equal(t, []int{5, 8, 8}, Minus([]int{5, 1, 8, 3, 2, 8}, []int{7, 6, 4, 3, 1, 2}))
 	assert.Equal(t, []int{7, 6, 4}, Minus([]int{7, 6, 4, 3, 1, 2}, []int{5, 1, 8, 3, 2, 8}))
 	assert.Equal(t, []int{1}, SortOrderedAscending(Keys(MinusMapByKey(map[int]int{2: 11, 5: 11, 1: 12}, map[int]int{41: 1, 2: 77, 42: 1, 5: 66, 43: 2}))...))
@@ -197,6 +299,14 @@ func TestFPFunctions(t *testing.T) {
 	assert.Equal(t, [][]int{{1, 2, 3}, {4, 5, 6}, {7, 8}}, SplitEvery(3, 1, 2, 3, 4, 5, 6, 7, 8))
 	assert.Equal(t, map[int][]int{1: {1, 3, 5, 7}, 0: {2, 4, 6, 8}}, GroupBy(func(a int) int { return a % 2 }, 1, 2, 3, 4, 5, 6, 7, 8))
 	assert.Equal(t, []int{1, 2}, UniqBy(func(a int) int { return a % 2 }, 1, 2, 3, 4, 5, 6, 7, 8))
+	assert.Equal(t, []Tuple2[int, string]{NewTuple2(1, "a"), NewTuple2(2, "b")}, ZipSlices([]int{1, 2, 3}, []string{"a", "b"}))
+	assert.Equal(t, []Tuple2[int, string]{}, ZipSlices([]int{1, 2, 3}, []string{}))
+	unzipped1, unzipped2 := UnzipSlices(ZipSlices([]int{1, 2, 3}, []string{"a", "b"}))
+	assert.Equal(t, []int{1, 2}, unzipped1)
+	assert.Equal(t, []string{"a", "b"}, unzipped2)
+	assert.Equal(t, []Tuple2[int, string]{NewTuple2(1, "a"), NewTuple2(1, "b"), NewTuple2(2, "a"), NewTuple2(2, "b")}, CartesianProduct([]int{1, 2}, []string{"a", "b"}))
+	assert.Equal(t, []Tuple2[int, string]{}, CartesianProduct([]int{1, 2}, []string{}))
+	assert.Equal(t, map[int]int{1: 7, 0: 8}, IndexBy(func(a int) int { return a % 2 }, 1, 2, 3, 4, 5, 6, 7, 8))
 }
 
 func TestVariadic(t *testing.T) {
@@ -254,6 +364,38 @@ func TestVariadic(t *testing.T) {
 	}, 1, 2, 3, 4, 5, 6)(7))
 }
 
+func TestPartial(t *testing.T) {
+	concat2 := func(a string, b int) string {
+		return a + strconv.Itoa(b)
+	}
+	concat3 := func(a string, b int, c bool) string {
+		return concat2(a, b) + strconv.FormatBool(c)
+	}
+	concat4 := func(a string, b int, c bool, d float64) string {
+		return concat3(a, b, c) + strconv.FormatFloat(d, 'f', 1, 64)
+	}
+	concat5 := func(a string, b int, c bool, d float64, e string) string {
+		return concat4(a, b, c, d) + e
+	}
+
+	assert.Equal(t, "a1", Partial1Of2(concat2, "a")(1))
+	assert.Equal(t, "a1true", Partial1Of3(concat3, "a")(1, true))
+	assert.Equal(t, "a1true", Partial2Of3(concat3, "a", 1)(true))
+	assert.Equal(t, "a1true0.5", Partial1Of4(concat4, "a")(1, true, 0.5))
+	assert.Equal(t, "a1true0.5", Partial2Of4(concat4, "a", 1)(true, 0.5))
+	assert.Equal(t, "a1true0.5", Partial3Of4(concat4, "a", 1, true)(0.5))
+	assert.Equal(t, "a1true0.5z", Partial1Of5(concat5, "a")(1, true, 0.5, "z"))
+	assert.Equal(t, "a1true0.5z", Partial2Of5(concat5, "a", 1)(true, 0.5, "z"))
+	assert.Equal(t, "a1true0.5z", Partial3Of5(concat5, "a", 1, true)(0.5, "z"))
+	assert.Equal(t, "a1true0.5z", Partial4Of5(concat5, "a", 1, true, 0.5)("z"))
+
+	// Point-free
+	assert.Equal(t, []string{"a1", "a2"}, Map(Partial1Of2(concat2, "a"), 1, 2))
+
+	assert.Equal(t, "a1", Flip(concat2)(1, "a"))
+	assert.Equal(t, "b1", Partial1Of2(Flip(concat2), 1)("b"))
+}
+
 func TestCurry(t *testing.T) {
 	c := CurryNew(func(c *CurryDef[interface{}, interface{}], args ...interface{}) interface{} {
 		result := 0
@@ -284,6 +426,93 @@ func TestCurry(t *testing.T) {
 	assert.Equal(t, 6, c.Result())
 }
 
+func TestPatternMatchingGuardExtractor(t *testing.T) {
+	isPositive := func(x interface{}) bool {
+		return x.(int) > 0
+	}
+	patterns := []Pattern{
+		InCaseOfGuard(InCaseOfKind(reflect.Int, func(x interface{}) interface{} {
+			return fmt.Sprintf("Positive: %v", x)
+		}), isPositive),
+		InCaseOfPredicate(func(x interface{}) bool {
+			v, ok := x.(int)
+			return ok && v%2 == 0
+		}, func(x interface{}) interface{} {
+			return fmt.Sprintf("Even: %v", x)
+		}),
+		InCaseOfExtractor(ExtractJust[int](), InCaseOfGuard(Otherwise(func(x interface{}) interface{} {
+			return fmt.Sprintf("Just positive: %v", x)
+		}), isPositive)),
+		InCaseOfExtractor(ExtractJust[int](), Otherwise(func(x interface{}) interface{} {
+			return fmt.Sprintf("Just: %v", x)
+		})),
+		InCaseOfExtractor(ExtractTuple2[string, int](), InCaseOfProduct(func(x interface{}) interface{} {
+			fields := x.([]interface{})
+			return fmt.Sprintf("Hello %v %v", fields[0], fields[1])
+		}, InCaseOfEqual("world", nil), InCaseOfPredicate(isPositive, nil))),
+		InCaseOfExtractor(ExtractTuple3[int, int, int](), InCaseOfProduct(func(x interface{}) interface{} {
+			return fmt.Sprintf("Triple: %v", x)
+		}, Otherwise(nil), Otherwise(nil), Otherwise(nil))),
+		InCaseOfType(func(x Tuple2[string, int]) interface{} {
+			return fmt.Sprintf("Tuple2: %v %v", x.V1, x.V2)
+		}),
+		Otherwise(func(x interface{}) interface{} {
+			return fmt.Sprintf("Other: %v", x)
+		}),
+	}
+
+	assert.Equal(t, "Positive: 3", Either(3, patterns...))
+	assert.Equal(t, "Even: -2", Either(-2, patterns...))
+	assert.Equal(t, "Other: -3", Either(-3, patterns...))
+	assert.Equal(t, "Just positive: 1", Either(JustGenerics(1), patterns...))
+	assert.Equal(t, "Just: -1", Either(JustGenerics(-1), patterns...))
+	assert.Equal(t, "Hello world 1", Either(NewTuple2("world", 1), patterns...))
+	assert.Equal(t, "Tuple2: world -1", Either(NewTuple2("world", -1), patterns...))
+	assert.Equal(t, "Tuple2: a 1", Either(NewTuple2("a", 1), patterns...))
+	assert.Equal(t, "Triple: [1 2 3]", Either(NewTuple3(1, 2, 3), patterns...))
+	assert.Equal(t, "Other: [1 2]", Either([]interface{}{1, 2}, patterns...))
+}
+
+func TestPatternMatchingStringRange(t *testing.T) {
+	route := func(path interface{}) interface{} {
+		return Either(path,
+			InCaseOfRegexGroups(`^/users/(\d+)/posts/(\w+)$`, func(groups []string) interface{} {
+				return fmt.Sprintf("user %s post %s", groups[1], groups[2])
+			}),
+			InCaseOfPrefix("/static/", func(rest interface{}) interface{} {
+				return fmt.Sprintf("file %v", rest)
+			}),
+			InCaseOfSuffix(".json", func(rest interface{}) interface{} {
+				return fmt.Sprintf("json %v", rest)
+			}),
+			InCaseOfRange(200, 299, func(code interface{}) interface{} {
+				return fmt.Sprintf("ok %v", code)
+			}),
+			InCaseOfRange(-1.5, 1.5, func(x interface{}) interface{} {
+				return fmt.Sprintf("small %v", x)
+			}),
+			Otherwise(func(x interface{}) interface{} {
+				return fmt.Sprintf("other %v", x)
+			}),
+		)
+	}
+
+	assert.Equal(t, "user 12 post abc", route("/users/12/posts/abc"))
+	assert.Equal(t, "file css/a.css", route("/static/css/a.css"))
+	assert.Equal(t, "json /api/list", route("/api/list.json"))
+	assert.Equal(t, "other /users/x/posts/abc", route("/users/x/posts/abc"))
+	assert.Equal(t, "ok 200", route(200))
+	assert.Equal(t, "ok 299", route(uint16(299)))
+	assert.Equal(t, "ok 250.5", route(250.5))
+	assert.Equal(t, "other 300", route(int64(300)))
+	assert.Equal(t, "small -1.5", route(float32(-1.5)))
+	assert.Equal(t, "other 2", route("2"))
+	assert.Equal(t, "other <nil>", route(nil))
+	assert.Panics(t, func() {
+		InCaseOfRegexGroups("(", nil)
+	})
+}
+
 func TestCompType(t *testing.T) {
 	compTypeA := DefProduct(reflect.Int, reflect.String)
 	compTypeB := DefProduct(reflect.String)
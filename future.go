@@ -0,0 +1,161 @@
+package fpgo
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// Future
+
+// ErrFutureTimeout The Future isn't done before the timeout
+var ErrFutureTimeout = errors.New("future timeout")
+
+// Future Future inspired by Java/Scala, a result(value or error) which will be available later
+type Future[T any] struct {
+	once   sync.Once
+	doneCh chan struct{}
+
+	val T
+	err error
+}
+
+// NewFuture New a Future which will be done by Complete()/Fail()
+func NewFuture[T any]() *Future[T] {
+	return &Future[T]{doneCh: make(chan struct{})}
+}
+
+// FutureFrom New a Future done by the result of fn running on a new goroutine
+func FutureFrom[T any](fn func() (T, error)) *Future[T] {
+	future := NewFuture[T]()
+	go func() {
+		future.settle(fn())
+	}()
+
+	return future
+}
+
+// Complete Complete the Future by the value(false if it's done already)
+func (futureSelf *Future[T]) Complete(val T) bool {
+	return futureSelf.settle(val, nil)
+}
+
+// Fail Fail the Future by the error(false if it's done already)
+func (futureSelf *Future[T]) Fail(err error) bool {
+	return futureSelf.settle(*new(T), err)
+}
+
+func (futureSelf *Future[T]) settle(val T, err error) bool {
+	isSettled := false
+	futureSelf.once.Do(func() {
+		futureSelf.val, futureSelf.err = val, err
+		isSettled = true
+		close(futureSelf.doneCh)
+	})
+	return isSettled
+}
+
+// Done Get the channel closed when the Future is done
+func (futureSelf *Future[T]) Done() <-chan struct{} {
+	return futureSelf.doneCh
+}
+
+// IsDone Is the Future done
+func (futureSelf *Future[T]) IsDone() bool {
+	select {
+	case <-futureSelf.doneCh:
+		return true
+	default:
+		return false
+	}
+}
+
+// Get Wait for the result
+func (futureSelf *Future[T]) Get() (T, error) {
+	<-futureSelf.doneCh
+	return futureSelf.val, futureSelf.err
+}
+
+// GetWithTimeout Wait for the result until the timeout(ErrFutureTimeout)
+func (futureSelf *Future[T]) GetWithTimeout(timeout time.Duration) (T, error) {
+	select {
+	case <-futureSelf.doneCh:
+		return futureSelf.val, futureSelf.err
+	case <-time.After(timeout):
+		return *new(T), ErrFutureTimeout
+	}
+}
+
+// Future Chaining
+
+// FutureOption Options of the Future chaining
+type FutureOption struct {
+	// Scheduler Run the chained functions on it(e.g. a HandlerDef or a worker.WorkerPool),
+	// they run on the goroutine waiting for the Future if nil
+	Scheduler Scheduler
+}
+
+// futureChain New a Future done by onDone(called with the result of the upstream on the Scheduler of the opts)
+func futureChain[T any, R any](futureSelf *Future[T], onDone func(next *Future[R], val T, err error), opts ...FutureOption) *Future[R] {
+	var option FutureOption
+	if len(opts) > 0 {
+		option = opts[0]
+	}
+
+	next := NewFuture[R]()
+	go func() {
+		val, err := futureSelf.Get()
+		if option.Scheduler == nil {
+			onDone(next, val, err)
+			return
+		}
+		if scheduleErr := option.Scheduler.Schedule(func() {
+			onDone(next, val, err)
+		}); scheduleErr != nil {
+			next.Fail(scheduleErr)
+		}
+	}()
+	return next
+}
+
+// ThenFuture Chain fn after the Future succeeded(the error is passed through if it failed), the result type could be different
+func ThenFuture[T any, R any](futureSelf *Future[T], fn func(T) (R, error), opts ...FutureOption) *Future[R] {
+	return futureChain(futureSelf, func(next *Future[R], val T, err error) {
+		if err != nil {
+			next.Fail(err)
+			return
+		}
+		next.settle(fn(val))
+	}, opts...)
+}
+
+// MapFuture Map the value of the Future after it succeeded(the error is passed through if it failed), the result type could be different
+func MapFuture[T any, R any](futureSelf *Future[T], fn func(T) R, opts ...FutureOption) *Future[R] {
+	return ThenFuture(futureSelf, func(val T) (R, error) {
+		return fn(val), nil
+	}, opts...)
+}
+
+// Then Chain fn after the Future succeeded(the error is passed through if it failed)
+func (futureSelf *Future[T]) Then(fn func(T) (T, error), opts ...FutureOption) *Future[T] {
+	return ThenFuture(futureSelf, fn, opts...)
+}
+
+// Catch Recover from the error by the handler after the Future failed(the value is passed through if it succeeded)
+func (futureSelf *Future[T]) Catch(handler func(error) (T, error), opts ...FutureOption) *Future[T] {
+	return futureChain(futureSelf, func(next *Future[T], val T, err error) {
+		if err == nil {
+			next.Complete(val)
+			return
+		}
+		next.settle(handler(err))
+	}, opts...)
+}
+
+// Finally Call fn after the Future is done whether it succeeded or failed, the result is passed through
+func (futureSelf *Future[T]) Finally(fn func(), opts ...FutureOption) *Future[T] {
+	return futureChain(futureSelf, func(next *Future[T], val T, err error) {
+		fn()
+		next.settle(val, err)
+	}, opts...)
+}
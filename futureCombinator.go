@@ -0,0 +1,143 @@
+package fpgo
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// Future Combinators
+
+// ErrFutureNoTask There's no task to race
+var ErrFutureNoTask = errors.New("future no task")
+
+// FutureTask A task producing the result of a Future, it should stop early when the ctx is done
+type FutureTask[T any] func(ctx context.Context) (T, error)
+
+// FutureFromContext New a Future done by the result of the task running on a new goroutine with the ctx
+func FutureFromContext[T any](ctx context.Context, task FutureTask[T]) *Future[T] {
+	return FutureFrom(func() (T, error) {
+		return task(ctx)
+	})
+}
+
+// FutureAll Run the tasks concurrently, complete with all the values(in order) or fail with the first error
+// (the other tasks are canceled once it's done, like Promise.all)
+func FutureAll[T any](ctx context.Context, tasks ...FutureTask[T]) *Future[[]T] {
+	future := NewFuture[[]T]()
+	results := make([]T, len(tasks))
+	if len(tasks) == 0 {
+		future.Complete(results)
+		return future
+	}
+
+	var lock sync.Mutex
+	remaining := len(tasks)
+	futureRun(ctx, future, tasks, func(i int, val T, err error) {
+		if err != nil {
+			future.Fail(err)
+			return
+		}
+
+		lock.Lock()
+		results[i] = val
+		remaining--
+		isLast := remaining == 0
+		lock.Unlock()
+		if isLast {
+			future.Complete(results)
+		}
+	})
+	return future
+}
+
+// FutureAny Run the tasks concurrently, complete with the first value or fail with MultiError if all of them failed
+// (the other tasks are canceled once it's done, like Promise.any)
+func FutureAny[T any](ctx context.Context, tasks ...FutureTask[T]) *Future[T] {
+	future := NewFuture[T]()
+	errs := make([]error, len(tasks))
+	if len(tasks) == 0 {
+		future.Fail(&MultiError{Errors: errs})
+		return future
+	}
+
+	var lock sync.Mutex
+	remaining := len(tasks)
+	futureRun(ctx, future, tasks, func(i int, val T, err error) {
+		if err == nil {
+			future.Complete(val)
+			return
+		}
+
+		lock.Lock()
+		errs[i] = err
+		remaining--
+		isLast := remaining == 0
+		lock.Unlock()
+		if isLast {
+			future.Fail(&MultiError{Errors: errs})
+		}
+	})
+	return future
+}
+
+// FutureRace Run the tasks concurrently, be done with the first result whether it succeeded or failed(ErrFutureNoTask if there's no task)
+// (the other tasks are canceled once it's done, like Promise.race)
+func FutureRace[T any](ctx context.Context, tasks ...FutureTask[T]) *Future[T] {
+	future := NewFuture[T]()
+	if len(tasks) == 0 {
+		future.Fail(ErrFutureNoTask)
+		return future
+	}
+
+	futureRun(ctx, future, tasks, func(_ int, val T, err error) {
+		future.settle(val, err)
+	})
+	return future
+}
+
+// FutureAllSettled Run the tasks concurrently, complete with all the results(in order) after all of them are done
+// (it fails only if the ctx is done before that, like Promise.allSettled)
+func FutureAllSettled[T any](ctx context.Context, tasks ...FutureTask[T]) *Future[[]Result[T]] {
+	future := NewFuture[[]Result[T]]()
+	results := make([]Result[T], len(tasks))
+	if len(tasks) == 0 {
+		future.Complete(results)
+		return future
+	}
+
+	var lock sync.Mutex
+	remaining := len(tasks)
+	futureRun(ctx, future, tasks, func(i int, val T, err error) {
+		lock.Lock()
+		results[i] = ResultFrom(val, err)
+		remaining--
+		isLast := remaining == 0
+		lock.Unlock()
+		if isLast {
+			future.Complete(results)
+		}
+	})
+	return future
+}
+
+// futureRun Run the tasks with a child ctx canceled once the future is done,
+// the future fails with the ctx error if the ctx is done first
+func futureRun[T any, R any](ctx context.Context, future *Future[R], tasks []FutureTask[T], onResult func(i int, val T, err error)) {
+	childCtx, cancel := context.WithCancel(ctx)
+	go func() {
+		select {
+		case <-future.Done():
+		case <-childCtx.Done():
+			future.Fail(ctx.Err())
+		}
+		cancel()
+	}()
+
+	for i, task := range tasks {
+		go func(i int, task FutureTask[T]) {
+			val, err := task(childCtx)
+			onResult(i, val, err)
+		}(i, task)
+	}
+}
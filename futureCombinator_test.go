@@ -0,0 +1,127 @@
+package fpgo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func futureTaskAfter[T any](delay time.Duration, val T, err error, canceled chan<- bool) FutureTask[T] {
+	return func(ctx context.Context) (T, error) {
+		select {
+		case <-time.After(delay):
+			return val, err
+		case <-ctx.Done():
+			if canceled != nil {
+				canceled <- true
+			}
+			return *new(T), ctx.Err()
+		}
+	}
+}
+
+func TestFutureAll(t *testing.T) {
+	errFailed := errors.New("failed")
+	canceled := make(chan bool, 3)
+
+	results, err := FutureAll(context.Background(),
+		futureTaskAfter(3*time.Millisecond, 1, nil, nil),
+		futureTaskAfter(time.Millisecond, 2, nil, nil),
+		futureTaskAfter(2*time.Millisecond, 3, nil, nil),
+	).Get()
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, results)
+
+	_, err = FutureAll(context.Background(),
+		futureTaskAfter(time.Second, 1, nil, canceled),
+		futureTaskAfter(time.Millisecond, 2, errFailed, nil),
+	).Get()
+	assert.Equal(t, errFailed, err)
+	assert.Equal(t, true, <-canceled)
+
+	results, err = FutureAll[int](context.Background()).Get()
+	assert.NoError(t, err)
+	assert.Equal(t, []int{}, results)
+
+	// Parent canceled
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	_, err = FutureAll(ctx, func(context.Context) (int, error) {
+		// Not respecting the ctx
+		time.Sleep(10 * time.Millisecond)
+		return 1, nil
+	}).Get()
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
+
+func TestFutureAny(t *testing.T) {
+	errFailed1 := errors.New("failed1")
+	errFailed2 := errors.New("failed2")
+	canceled := make(chan bool, 3)
+
+	val, err := FutureAny(context.Background(),
+		futureTaskAfter(time.Millisecond, 1, errFailed1, nil),
+		futureTaskAfter(3*time.Millisecond, 2, nil, nil),
+		futureTaskAfter(time.Second, 3, nil, canceled),
+	).Get()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, val)
+	assert.Equal(t, true, <-canceled)
+
+	_, err = FutureAny(context.Background(),
+		futureTaskAfter(2*time.Millisecond, 1, errFailed1, nil),
+		futureTaskAfter(time.Millisecond, 2, errFailed2, nil),
+	).Get()
+	var aggregateErr *MultiError
+	assert.True(t, errors.As(err, &aggregateErr))
+	assert.Equal(t, []error{errFailed1, errFailed2}, aggregateErr.Errors)
+	assert.Equal(t, "2 error(s) occurred: [failed1; failed2]", err.Error())
+
+	_, err = FutureAny[int](context.Background()).Get()
+	assert.True(t, errors.As(err, &aggregateErr))
+}
+
+func TestFutureRace(t *testing.T) {
+	errFailed := errors.New("failed")
+	canceled := make(chan bool, 3)
+
+	_, err := FutureRace(context.Background(),
+		futureTaskAfter(time.Second, 1, nil, canceled),
+		futureTaskAfter(time.Millisecond, 2, errFailed, nil),
+	).Get()
+	assert.Equal(t, errFailed, err)
+	assert.Equal(t, true, <-canceled)
+
+	val, err := FutureRace(context.Background(),
+		futureTaskAfter(time.Second, 1, nil, canceled),
+		futureTaskAfter(time.Millisecond, 2, nil, nil),
+	).Get()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, val)
+	assert.Equal(t, true, <-canceled)
+
+	_, err = FutureRace[int](context.Background()).Get()
+	assert.Equal(t, ErrFutureNoTask, err)
+}
+
+func TestFutureAllSettled(t *testing.T) {
+	errFailed := errors.New("failed")
+
+	results, err := FutureAllSettled(context.Background(),
+		futureTaskAfter(2*time.Millisecond, 1, nil, nil),
+		futureTaskAfter(time.Millisecond, 2, errFailed, nil),
+	).Get()
+	assert.NoError(t, err)
+	assert.Equal(t, []Result[int]{ResultOk(1), ResultErr[int](errFailed)}, results)
+
+	results, err = FutureAllSettled[int](context.Background()).Get()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(results))
+
+	val, err := FutureFromContext(context.Background(), futureTaskAfter(0, "a", nil, nil)).Get()
+	assert.NoError(t, err)
+	assert.Equal(t, "a", val)
+}
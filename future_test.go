@@ -0,0 +1,90 @@
+package fpgo
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFuture(t *testing.T) {
+	var val int
+	var err error
+
+	future := NewFuture[int]()
+	assert.Equal(t, false, future.IsDone())
+	val, err = future.GetWithTimeout(time.Millisecond)
+	assert.Equal(t, ErrFutureTimeout, err)
+	assert.Equal(t, true, future.Complete(1))
+	assert.Equal(t, false, future.Fail(errors.New("ignored")))
+	assert.Equal(t, true, future.IsDone())
+	val, err = future.Get()
+	assert.Equal(t, 1, val)
+	assert.NoError(t, err)
+
+	future = FutureFrom(func() (int, error) {
+		return 0, errors.New("failed")
+	})
+	<-future.Done()
+	val, err = future.Get()
+	assert.Equal(t, 0, val)
+	assert.EqualError(t, err, "failed")
+}
+
+func TestFutureChaining(t *testing.T) {
+	errFailed := errors.New("failed")
+	var finallyCalled AtomBool
+
+	result, err := MapFuture(FutureFrom(func() (int, error) {
+		return 2, nil
+	}).Then(func(val int) (int, error) {
+		return val * 10, nil
+	}), strconv.Itoa).Finally(func() {
+		finallyCalled.Set(true)
+	}).Get()
+	assert.NoError(t, err)
+	assert.Equal(t, "20", result)
+	assert.Equal(t, true, finallyCalled.Get())
+
+	// Errors skip Then and are recovered by Catch
+	thenCalled := false
+	val, err := ThenFuture(FutureFrom(func() (int, error) {
+		return 0, errFailed
+	}), func(val int) (string, error) {
+		thenCalled = true
+		return "", nil
+	}).Catch(func(err error) (string, error) {
+		return "recovered: " + err.Error(), nil
+	}).Get()
+	assert.NoError(t, err)
+	assert.Equal(t, "recovered: failed", val)
+	assert.Equal(t, false, thenCalled)
+
+	_, err = NewFuture[int]().Then(func(val int) (int, error) {
+		return val, nil
+	}).GetWithTimeout(time.Millisecond)
+	assert.Equal(t, ErrFutureTimeout, err)
+
+	// Scheduler
+	h := Handler.New()
+	defer h.Close()
+	future := NewFuture[int]()
+	chained := future.Then(func(val int) (int, error) {
+		return val + 1, errFailed
+	}, FutureOption{Scheduler: h}).Catch(func(err error) (int, error) {
+		return 0, err
+	}, FutureOption{Scheduler: ImmediateScheduler})
+	future.Complete(1)
+	val2, err := chained.Get()
+	assert.Equal(t, 0, val2)
+	assert.Equal(t, errFailed, err)
+
+	closed := Handler.New()
+	closed.Close()
+	_, err = FutureFrom(func() (int, error) {
+		return 1, nil
+	}).Finally(func() {}, FutureOption{Scheduler: closed}).Get()
+	assert.Equal(t, ErrHandlerIsClosed, err)
+}
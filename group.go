@@ -0,0 +1,98 @@
+package fpgo
+
+import (
+	"context"
+	"sync"
+)
+
+// Group
+
+// GroupOption Options of Group
+type GroupOption struct {
+	// Limit The maximum number of concurrent tasks(unlimited if <= 0), Go() blocks until a task is done beyond it
+	Limit int
+}
+
+// Group Run typed tasks concurrently, collect their results in the submission order(like errgroup with results)
+type Group[T any] struct {
+	cancel    context.CancelFunc
+	semaphore *Semaphore
+
+	wg      sync.WaitGroup
+	lock    sync.Mutex
+	results []T
+	err     error
+}
+
+// NewGroup New a Group & its derived ctx, which is canceled by the first error of the tasks or by Wait()
+func NewGroup[T any](ctx context.Context, opts ...GroupOption) (*Group[T], context.Context) {
+	var option GroupOption
+	if len(opts) > 0 {
+		option = opts[0]
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	group := &Group[T]{cancel: cancel}
+	if option.Limit > 0 {
+		group.semaphore = NewSemaphore(option.Limit)
+	}
+	return group, ctx
+}
+
+// Go Run the task on a new goroutine(blocking until a slot is released if the Limit is reached)
+func (groupSelf *Group[T]) Go(fn func() (T, error)) {
+	if groupSelf.semaphore != nil {
+		groupSelf.semaphore.Acquire(context.Background())
+	}
+	groupSelf.run(fn)
+}
+
+// TryGo Run the task on a new goroutine only if the Limit is not reached, false if it's not started
+func (groupSelf *Group[T]) TryGo(fn func() (T, error)) bool {
+	if groupSelf.semaphore != nil && !groupSelf.semaphore.TryAcquire() {
+		return false
+	}
+	groupSelf.run(fn)
+	return true
+}
+
+// Wait Wait until all the tasks are done, get their results(in the submission order) or the first error
+func (groupSelf *Group[T]) Wait() ([]T, error) {
+	groupSelf.wg.Wait()
+	groupSelf.cancel()
+
+	groupSelf.lock.Lock()
+	defer groupSelf.lock.Unlock()
+	if groupSelf.err != nil {
+		return nil, groupSelf.err
+	}
+	return groupSelf.results, nil
+}
+
+func (groupSelf *Group[T]) run(fn func() (T, error)) {
+	groupSelf.lock.Lock()
+	index := len(groupSelf.results)
+	var zero T
+	groupSelf.results = append(groupSelf.results, zero)
+	groupSelf.lock.Unlock()
+
+	groupSelf.wg.Add(1)
+	go func() {
+		defer groupSelf.wg.Done()
+		if groupSelf.semaphore != nil {
+			defer groupSelf.semaphore.Release()
+		}
+
+		val, err := fn()
+		groupSelf.lock.Lock()
+		defer groupSelf.lock.Unlock()
+		if err != nil {
+			if groupSelf.err == nil {
+				groupSelf.err = err
+				groupSelf.cancel()
+			}
+			return
+		}
+		groupSelf.results[index] = val
+	}()
+}
@@ -0,0 +1,83 @@
+package fpgo
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroup(t *testing.T) {
+	group, _ := NewGroup[int](context.Background())
+	for i := 1; i <= 5; i++ {
+		i := i
+		group.Go(func() (int, error) {
+			// Finish in the reversed order
+			time.Sleep(time.Duration(5-i) * time.Millisecond)
+			return i * 10, nil
+		})
+	}
+	actual, err := group.Wait()
+	assert.NoError(t, err)
+	assert.Equal(t, []int{10, 20, 30, 40, 50}, actual)
+
+	group, _ = NewGroup[int](context.Background())
+	actual, err = group.Wait()
+	assert.NoError(t, err)
+	assert.Equal(t, []int(nil), actual)
+}
+
+func TestGroupError(t *testing.T) {
+	errFirst := errors.New("first")
+	group, ctx := NewGroup[string](context.Background())
+	group.Go(func() (string, error) {
+		<-ctx.Done()
+		return "", ctx.Err()
+	})
+	group.Go(func() (string, error) {
+		return "", errFirst
+	})
+	actual, err := group.Wait()
+	assert.Equal(t, errFirst, err)
+	assert.Nil(t, actual)
+	assert.Equal(t, context.Canceled, ctx.Err())
+}
+
+func TestGroupLimit(t *testing.T) {
+	group, _ := NewGroup[int](context.Background(), GroupOption{Limit: 2})
+	var lock sync.Mutex
+	current, peak := 0, 0
+	release := make(chan bool)
+	task := func() (int, error) {
+		lock.Lock()
+		current++
+		if current > peak {
+			peak = current
+		}
+		lock.Unlock()
+		<-release
+		lock.Lock()
+		current--
+		lock.Unlock()
+		return 1, nil
+	}
+
+	assert.True(t, group.TryGo(task))
+	assert.True(t, group.TryGo(task))
+	assert.False(t, group.TryGo(task))
+	go func() {
+		for i := 0; i < 6; i++ {
+			release <- true
+		}
+	}()
+	for i := 0; i < 4; i++ {
+		group.Go(task)
+	}
+	actual, err := group.Wait()
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 1, 1, 1, 1, 1}, actual)
+	assert.True(t, peak > 0 && peak <= 2)
+}
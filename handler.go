@@ -1,5 +1,10 @@
 package fpgo
 
+import "errors"
+
+// ErrHandlerIsClosed The Handler is closed
+var ErrHandlerIsClosed = errors.New("handler is closed")
+
 // HandlerDef Handler inspired by Android/WebWorker
 type HandlerDef struct {
 	isClosed bool
@@ -36,6 +41,16 @@ func (handlerSelf *HandlerDef) Post(fn func()) {
 	handlerSelf.ch <- fn
 }
 
+// Schedule Post a function to execute on the Handler(Scheduler)
+func (handlerSelf *HandlerDef) Schedule(fn func()) error {
+	if handlerSelf.isClosed {
+		return ErrHandlerIsClosed
+	}
+
+	handlerSelf.Post(fn)
+	return nil
+}
+
 // Close Close the Handler
 func (handlerSelf *HandlerDef) Close() {
 	handlerSelf.isClosed = true
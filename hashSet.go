@@ -0,0 +1,136 @@
+package fpgo
+
+// HashSet
+
+// HashSet Set of comparable values with set algebra(unordered, mutated only by Add/Remove)
+type HashSet[T comparable] map[T]struct{}
+
+// NewHashSet New HashSet instance with the values
+func NewHashSet[T comparable](values ...T) HashSet[T] {
+	result := make(HashSet[T], len(values))
+	return result.Add(values...)
+}
+
+// NewHashSetFromStream New HashSet instance with the values of the Stream
+func NewHashSetFromStream[T comparable](stream *StreamDef[T]) HashSet[T] {
+	if stream == nil {
+		return NewHashSet[T]()
+	}
+	return NewHashSet(*stream...)
+}
+
+// Add Add the values into the HashSet
+func (setSelf HashSet[T]) Add(values ...T) HashSet[T] {
+	for _, v := range values {
+		setSelf[v] = struct{}{}
+	}
+	return setSelf
+}
+
+// Remove Remove the values from the HashSet
+func (setSelf HashSet[T]) Remove(values ...T) HashSet[T] {
+	for _, v := range values {
+		delete(setSelf, v)
+	}
+	return setSelf
+}
+
+// Contains Check the value exists or not in the HashSet
+func (setSelf HashSet[T]) Contains(value T) bool {
+	_, ok := setSelf[value]
+	return ok
+}
+
+// Size Get size
+func (setSelf HashSet[T]) Size() int {
+	return len(setSelf)
+}
+
+// Clone Clone this HashSet
+func (setSelf HashSet[T]) Clone() HashSet[T] {
+	result := make(HashSet[T], len(setSelf))
+	for v := range setSelf {
+		result[v] = struct{}{}
+	}
+	return result
+}
+
+// Union New Set of the values in this HashSet or the other one
+func (setSelf HashSet[T]) Union(other HashSet[T]) HashSet[T] {
+	result := setSelf.Clone()
+	for v := range other {
+		result[v] = struct{}{}
+	}
+	return result
+}
+
+// Intersect New Set of the values in both this HashSet and the other one
+func (setSelf HashSet[T]) Intersect(other HashSet[T]) HashSet[T] {
+	small, large := setSelf, other
+	if len(small) > len(large) {
+		small, large = large, small
+	}
+
+	result := make(HashSet[T])
+	for v := range small {
+		if large.Contains(v) {
+			result[v] = struct{}{}
+		}
+	}
+	return result
+}
+
+// Difference New Set of the values in this HashSet but not in the other one
+func (setSelf HashSet[T]) Difference(other HashSet[T]) HashSet[T] {
+	result := make(HashSet[T])
+	for v := range setSelf {
+		if !other.Contains(v) {
+			result[v] = struct{}{}
+		}
+	}
+	return result
+}
+
+// SymmetricDifference New Set of the values in exactly one of this HashSet and the other one
+func (setSelf HashSet[T]) SymmetricDifference(other HashSet[T]) HashSet[T] {
+	result := setSelf.Difference(other)
+	for v := range other {
+		if !setSelf.Contains(v) {
+			result[v] = struct{}{}
+		}
+	}
+	return result
+}
+
+// IsSubset Check all values of this HashSet are in the other one
+func (setSelf HashSet[T]) IsSubset(other HashSet[T]) bool {
+	if len(setSelf) > len(other) {
+		return false
+	}
+	for v := range setSelf {
+		if !other.Contains(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset Check all values of the other HashSet are in this one
+func (setSelf HashSet[T]) IsSuperset(other HashSet[T]) bool {
+	return other.IsSubset(setSelf)
+}
+
+// Equal Check this HashSet & the other one have the same values
+func (setSelf HashSet[T]) Equal(other HashSet[T]) bool {
+	return len(setSelf) == len(other) && setSelf.IsSubset(other)
+}
+
+// ToSlice Convert HashSet to slice(unordered)
+func (setSelf HashSet[T]) ToSlice() []T {
+	return Keys(setSelf)
+}
+
+// ToStream Convert HashSet to Stream(unordered)
+func (setSelf HashSet[T]) ToStream() *StreamDef[T] {
+	return StreamFromArray(setSelf.ToSlice())
+}
@@ -0,0 +1,18 @@
+//go:build go1.23
+
+package fpgo
+
+import "iter"
+
+// HashSet Iterators
+
+// Iter Iterate the values of the HashSet(unordered)
+func (setSelf HashSet[T]) Iter() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range setSelf {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
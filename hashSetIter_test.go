@@ -0,0 +1,19 @@
+//go:build go1.23
+
+package fpgo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHashSetIter(t *testing.T) {
+	actual := []int{}
+	NewHashSet(1, 2, 3).Iter()(func(val int) bool {
+		actual = append(actual, val)
+		return len(actual) < 2
+	})
+	assert.Equal(t, 2, len(actual))
+	assert.Equal(t, true, NewHashSet(1, 2, 3).IsSuperset(NewHashSet(actual...)))
+}
@@ -0,0 +1,38 @@
+package fpgo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHashSet(t *testing.T) {
+	set := NewHashSet(1, 2, 2, 3)
+	assert.Equal(t, 3, set.Size())
+	assert.Equal(t, true, set.Contains(2))
+	assert.Equal(t, false, set.Contains(4))
+	assert.Equal(t, []int{1, 2, 3}, SortOrderedAscending(set.ToSlice()...))
+
+	clone := set.Clone().Add(4).Remove(1)
+	assert.Equal(t, NewHashSet(1, 2, 3), set)
+	assert.Equal(t, NewHashSet(2, 3, 4), clone)
+
+	assert.Equal(t, NewHashSet(1, 2, 3, 4), set.Union(clone))
+	assert.Equal(t, NewHashSet(2, 3), set.Intersect(clone))
+	assert.Equal(t, NewHashSet(1), set.Difference(clone))
+	assert.Equal(t, NewHashSet(1, 4), set.SymmetricDifference(clone))
+	assert.Equal(t, NewHashSet[int](), set.Intersect(nil))
+	assert.Equal(t, set, set.Union(nil))
+
+	assert.Equal(t, true, NewHashSet(2, 3).IsSubset(set))
+	assert.Equal(t, false, clone.IsSubset(set))
+	assert.Equal(t, true, NewHashSet[int]().IsSubset(set))
+	assert.Equal(t, true, set.IsSuperset(NewHashSet(1, 3)))
+	assert.Equal(t, true, set.Equal(NewHashSet(3, 2, 1)))
+	assert.Equal(t, false, set.Equal(clone))
+
+	// Stream
+	assert.Equal(t, NewHashSet(1, 2), NewHashSetFromStream(StreamFrom(1, 2, 1)))
+	assert.Equal(t, NewHashSet[int](), NewHashSetFromStream[int](nil))
+	assert.Equal(t, []int{1, 2, 3}, SortOrderedAscending(set.ToStream().ToArray()...))
+}
@@ -0,0 +1,86 @@
+package fpgo
+
+import (
+	"sync"
+)
+
+// KeyedLock
+
+// KeyedLock Mutex per key by lock striping(keys are hashed into a fixed number of stripes),
+// so operations of the same key are serialized without a global mutex
+//
+// NOTE: different keys may share a stripe, so don't Lock() another key while holding one(use WithLocks() instead)
+type KeyedLock[K comparable] struct {
+	stripes []sync.Mutex
+	hasher  func(K) uint64
+}
+
+// NewKeyedLock New KeyedLock with the number of stripes(rounded up to a power of 2, 64 if <= 0)
+func NewKeyedLock[K comparable](stripes int) *KeyedLock[K] {
+	return NewKeyedLockWithHasher[K](stripes, defaultPersistentMapHash[K])
+}
+
+// NewKeyedLockWithHasher New KeyedLock with the number of stripes(rounded up to a power of 2, 64 if <= 0) & the hash function of keys
+func NewKeyedLockWithHasher[K comparable](stripes int, hasher func(K) uint64) *KeyedLock[K] {
+	if stripes <= 0 {
+		stripes = 64
+	}
+	size := 1
+	for size < stripes {
+		size <<= 1
+	}
+	return &KeyedLock[K]{
+		stripes: make([]sync.Mutex, size),
+		hasher:  hasher,
+	}
+}
+
+// Lock Lock the key(blocking), returns the function to unlock it
+//
+// NOTE: the stripe is remembered by the returned function, the key isn't hashed again when unlocking.
+func (keyedLockSelf *KeyedLock[K]) Lock(key K) (unlock func()) {
+	stripe := &keyedLockSelf.stripes[keyedLockSelf.stripe(key)]
+	stripe.Lock()
+	return stripe.Unlock
+}
+
+// TryLock Lock the key without blocking, returns the function to unlock it, or false if it's locked already
+func (keyedLockSelf *KeyedLock[K]) TryLock(key K) (unlock func(), ok bool) {
+	stripe := &keyedLockSelf.stripes[keyedLockSelf.stripe(key)]
+	if !stripe.TryLock() {
+		return nil, false
+	}
+	return stripe.Unlock, true
+}
+
+// WithLock Call fn while holding the lock of the key
+func (keyedLockSelf *KeyedLock[K]) WithLock(key K, fn func()) {
+	unlock := keyedLockSelf.Lock(key)
+	defer unlock()
+
+	fn()
+}
+
+// WithLocks Call fn while holding the locks of all the keys(locked in the stripe order to avoid deadlocks)
+func (keyedLockSelf *KeyedLock[K]) WithLocks(keys []K, fn func()) {
+	stripes := make([]int, 0, len(keys))
+	for _, key := range keys {
+		stripes = append(stripes, keyedLockSelf.stripe(key))
+	}
+	stripes = SortOrderedAscending(Distinct(stripes...)...)
+
+	for _, stripe := range stripes {
+		keyedLockSelf.stripes[stripe].Lock()
+	}
+	defer func() {
+		for i := len(stripes) - 1; i >= 0; i-- {
+			keyedLockSelf.stripes[stripes[i]].Unlock()
+		}
+	}()
+
+	fn()
+}
+
+func (keyedLockSelf *KeyedLock[K]) stripe(key K) int {
+	return int(mixPersistentMapHash(keyedLockSelf.hasher(key)) & uint64(len(keyedLockSelf.stripes)-1))
+}
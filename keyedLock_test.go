@@ -0,0 +1,113 @@
+package fpgo
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyedLock(t *testing.T) {
+	keyedLock := NewKeyedLock[string](0)
+	assert.Equal(t, 64, len(keyedLock.stripes))
+	assert.Equal(t, 8, len(NewKeyedLock[int](5).stripes))
+
+	unlock := keyedLock.Lock("a")
+	_, ok := keyedLock.TryLock("a")
+	assert.Equal(t, false, ok)
+	unlock()
+	unlock, ok = keyedLock.TryLock("a")
+	assert.Equal(t, true, ok)
+	unlock()
+
+	// Serialized per key
+	counters := map[string]int{"a": 0, "b": 0}
+	var countersLock sync.Mutex
+	inside := map[string]int{}
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		key := []string{"a", "b"}[i%2]
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			keyedLock.WithLock(key, func() {
+				countersLock.Lock()
+				inside[key]++
+				assert.Equal(t, 1, inside[key])
+				value := counters[key]
+				countersLock.Unlock()
+
+				time.Sleep(time.Microsecond)
+
+				countersLock.Lock()
+				counters[key] = value + 1
+				inside[key]--
+				countersLock.Unlock()
+			})
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, map[string]int{"a": 50, "b": 50}, counters)
+
+	// Keys sharing a stripe
+	oneStripe := NewKeyedLockWithHasher[int](1, func(int) uint64 {
+		return 0
+	})
+	oneStripe.WithLocks([]int{1, 2, 1}, func() {
+		_, ok := oneStripe.TryLock(3)
+		assert.Equal(t, false, ok)
+	})
+	unlock, ok = oneStripe.TryLock(3)
+	assert.Equal(t, true, ok)
+	unlock()
+
+	// Transfers locking 2 keys in different orders don't deadlock
+	balances := map[int]int{}
+	for i := 0; i < 10; i++ {
+		balances[i] = 100
+	}
+	keyedInts := NewKeyedLock[int](4)
+	for i := 0; i < 100; i++ {
+		from, to := i%10, (i*7+3)%10
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			keyedInts.WithLocks([]int{from, to}, func() {
+				countersLock.Lock()
+				balances[from]--
+				balances[to]++
+				countersLock.Unlock()
+			})
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 1000, CombineAll(MonoidSum[int](), Values(balances)...))
+}
+
+func TestKeyedLockPointerKey(t *testing.T) {
+	type account struct{ Balance int }
+	keyedLock := NewKeyedLock[*account](0)
+	a := &account{}
+
+	// Mutating the pointed value while holding the lock
+	unlock := keyedLock.Lock(a)
+	a.Balance = 100
+	unlock()
+	keyedLock.WithLock(a, func() {
+		a.Balance++
+	})
+	unlock, ok := keyedLock.TryLock(a)
+	assert.Equal(t, true, ok)
+	unlock()
+	assert.Equal(t, 101, a.Balance)
+
+	// Unlocking doesn't hash the key again
+	calls := uint64(0)
+	unstable := NewKeyedLockWithHasher[*account](64, func(*account) uint64 {
+		calls++
+		return calls
+	})
+	unstable.WithLock(a, func() {})
+	assert.Equal(t, uint64(1), calls)
+}
@@ -0,0 +1,75 @@
+package fpgo
+
+import "sync"
+
+// Lazy
+
+// Thunk A deferred computation of a T value
+type Thunk[T any] func() T
+
+// Lazy Deferred value computed by its Thunk at most once on the first Force()(concurrency-safe)
+type Lazy[T any] struct {
+	once        sync.Once
+	thunk       Thunk[T]
+	val         T
+	panicVal    interface{}
+	isEvaluated AtomBool
+}
+
+// NewLazy New Lazy instance computed by the thunk on demand
+func NewLazy[T any](thunk Thunk[T]) *Lazy[T] {
+	return &Lazy[T]{thunk: thunk}
+}
+
+// LazyFrom New Lazy instance evaluated as the value already
+func LazyFrom[T any](val T) *Lazy[T] {
+	lazy := &Lazy[T]{val: val}
+	lazy.once.Do(func() {})
+	lazy.isEvaluated.Set(true)
+	return lazy
+}
+
+// Force Get the value, computed by the thunk only on the first call(others wait for it)
+//
+// NOTE: if the thunk panics, every Force() panics with the same value.
+func (lazySelf *Lazy[T]) Force() T {
+	lazySelf.once.Do(func() {
+		defer func() {
+			if panicVal := recover(); panicVal != nil {
+				lazySelf.panicVal = panicVal
+			}
+			// Release the captured variables
+			lazySelf.thunk = nil
+			lazySelf.isEvaluated.Set(true)
+		}()
+		lazySelf.val = lazySelf.thunk()
+	})
+	if lazySelf.panicVal != nil {
+		panic(lazySelf.panicVal)
+	}
+	return lazySelf.val
+}
+
+// IsEvaluated Check the value has been computed or not
+func (lazySelf *Lazy[T]) IsEvaluated() bool {
+	return lazySelf.isEvaluated.Get()
+}
+
+// Map New Lazy of the value transformed by fn(nothing is computed until it's forced)
+func (lazySelf *Lazy[T]) Map(fn func(T) T) *Lazy[T] {
+	return LazyMap(lazySelf, fn)
+}
+
+// LazyMap New Lazy of the value transformed by fn into another type(nothing is computed until it's forced)
+func LazyMap[T any, R any](lazy *Lazy[T], fn func(T) R) *Lazy[R] {
+	return NewLazy(func() R {
+		return fn(lazy.Force())
+	})
+}
+
+// LazyFlatMap New Lazy of the Lazy returned by fn(nothing is computed until it's forced)
+func LazyFlatMap[T any, R any](lazy *Lazy[T], fn func(T) *Lazy[R]) *Lazy[R] {
+	return NewLazy(func() R {
+		return fn(lazy.Force()).Force()
+	})
+}
@@ -0,0 +1,204 @@
+package fpgo
+
+// LazyStream
+
+// LazyStream LazyStream inspired by Java8Stream/Kotlin Sequence, items are pulled from its generator on demand
+//
+// NOTE: a LazyStream is consumed while pulling, and it's not goroutine-safe.
+type LazyStream[T any] struct {
+	next func() (T, bool)
+}
+
+// LazyStreamFromGenerator New LazyStream instance by a generator(returns false when there's no more item)
+func LazyStreamFromGenerator[T any](next func() (T, bool)) *LazyStream[T] {
+	return &LazyStream[T]{next: next}
+}
+
+// LazyStreamFrom New LazyStream instance from T items
+func LazyStreamFrom[T any](list ...T) *LazyStream[T] {
+	return LazyStreamFromArray(list)
+}
+
+// LazyStreamFromArray New LazyStream instance from a T array
+func LazyStreamFromArray[T any](list []T) *LazyStream[T] {
+	index := 0
+	return LazyStreamFromGenerator(func() (T, bool) {
+		if index >= len(list) {
+			return *new(T), false
+		}
+		val := list[index]
+		index++
+		return val, true
+	})
+}
+
+// StreamIterate New infinite LazyStream: seed, next(seed), next(next(seed)), ...
+func StreamIterate[T any](seed T, next func(T) T) *LazyStream[T] {
+	current := seed
+	isStarted := false
+	return LazyStreamFromGenerator(func() (T, bool) {
+		if isStarted {
+			current = next(current)
+		}
+		isStarted = true
+		return current, true
+	})
+}
+
+// StreamRepeat New LazyStream repeating the value n times(infinitely if n < 0)
+func StreamRepeat[T any](val T, n int) *LazyStream[T] {
+	count := 0
+	return LazyStreamFromGenerator(func() (T, bool) {
+		if n >= 0 && count >= n {
+			return *new(T), false
+		}
+		count++
+		return val, true
+	})
+}
+
+// StreamRangeInt New LazyStream of ints from `from`(inclusive) to `to`(exclusive) by step(could be negative, empty if it's 0)
+func StreamRangeInt(from int, to int, step int) *LazyStream[int] {
+	current := from
+	return LazyStreamFromGenerator(func() (int, bool) {
+		if step == 0 || (step > 0 && current >= to) || (step < 0 && current <= to) {
+			return 0, false
+		}
+		val := current
+		current += step
+		return val, true
+	})
+}
+
+// LazyStreamMap Map all items of LazyStream by function(lazily, the result type could be different)
+func LazyStreamMap[T any, R any](lazyStream *LazyStream[T], fn func(T) R) *LazyStream[R] {
+	return LazyStreamFromGenerator(func() (R, bool) {
+		val, ok := lazyStream.Next()
+		if !ok {
+			return *new(R), false
+		}
+		return fn(val), true
+	})
+}
+
+// Next Pull the next item(false if there's no more item)
+func (lazyStreamSelf *LazyStream[T]) Next() (T, bool) {
+	if lazyStreamSelf.next == nil {
+		return *new(T), false
+	}
+
+	val, ok := lazyStreamSelf.next()
+	if !ok {
+		// Done, release the generator
+		lazyStreamSelf.next = nil
+	}
+	return val, ok
+}
+
+// Map Map all items of LazyStream by function(lazily)
+func (lazyStreamSelf *LazyStream[T]) Map(fn func(T) T) *LazyStream[T] {
+	return LazyStreamMap(lazyStreamSelf, fn)
+}
+
+// Filter Filter items of LazyStream by function(lazily)
+func (lazyStreamSelf *LazyStream[T]) Filter(fn Predicate[T]) *LazyStream[T] {
+	return LazyStreamFromGenerator(func() (T, bool) {
+		for {
+			val, ok := lazyStreamSelf.Next()
+			if !ok {
+				return val, false
+			}
+			if fn(val) {
+				return val, true
+			}
+		}
+	})
+}
+
+// Reject Reject items of LazyStream by function(lazily)
+func (lazyStreamSelf *LazyStream[T]) Reject(fn Predicate[T]) *LazyStream[T] {
+	return lazyStreamSelf.Filter(func(val T) bool {
+		return !fn(val)
+	})
+}
+
+// Take Take the first n items(lazily)
+func (lazyStreamSelf *LazyStream[T]) Take(count int) *LazyStream[T] {
+	taken := 0
+	return LazyStreamFromGenerator(func() (T, bool) {
+		if taken >= count {
+			return *new(T), false
+		}
+		taken++
+		return lazyStreamSelf.Next()
+	})
+}
+
+// TakeWhile Take items as long as the predicate satisfies(lazily)
+func (lazyStreamSelf *LazyStream[T]) TakeWhile(fn Predicate[T]) *LazyStream[T] {
+	isDone := false
+	return LazyStreamFromGenerator(func() (T, bool) {
+		if isDone {
+			return *new(T), false
+		}
+		val, ok := lazyStreamSelf.Next()
+		if !ok || !fn(val) {
+			isDone = true
+			return *new(T), false
+		}
+		return val, true
+	})
+}
+
+// Skip Skip the first n items(lazily)
+func (lazyStreamSelf *LazyStream[T]) Skip(count int) *LazyStream[T] {
+	skipped := false
+	return LazyStreamFromGenerator(func() (T, bool) {
+		if !skipped {
+			skipped = true
+			for i := 0; i < count; i++ {
+				if _, ok := lazyStreamSelf.Next(); !ok {
+					return *new(T), false
+				}
+			}
+		}
+		return lazyStreamSelf.Next()
+	})
+}
+
+// Concat Concat LazyStream by other LazyStream(s)(lazily)
+func (lazyStreamSelf *LazyStream[T]) Concat(lazyStreams ...*LazyStream[T]) *LazyStream[T] {
+	sources := append([]*LazyStream[T]{lazyStreamSelf}, lazyStreams...)
+	return LazyStreamFromGenerator(func() (T, bool) {
+		for len(sources) > 0 {
+			if sources[0] != nil {
+				val, ok := sources[0].Next()
+				if ok {
+					return val, true
+				}
+			}
+			sources = sources[1:]
+		}
+		return *new(T), false
+	})
+}
+
+// ForEach Pull all items and do the given function
+func (lazyStreamSelf *LazyStream[T]) ForEach(fn func(T)) {
+	for {
+		val, ok := lazyStreamSelf.Next()
+		if !ok {
+			return
+		}
+		fn(val)
+	}
+}
+
+// ToArray Pull all items into a slice
+func (lazyStreamSelf *LazyStream[T]) ToArray() []T {
+	result := make([]T, 0)
+	lazyStreamSelf.ForEach(func(val T) {
+		result = append(result, val)
+	})
+	return result
+}
@@ -0,0 +1,21 @@
+//go:build go1.23
+
+package fpgo
+
+import "iter"
+
+// LazyStream Iterators
+
+// LazyStreamFromSeq New LazyStream instance pulling the items from an iter.Seq on demand
+//
+// NOTE: the seq is suspended between pulls(by iter.Pull), an unfinished LazyStream keeps it suspended until it's drained.
+func LazyStreamFromSeq[T any](seq iter.Seq[T]) *LazyStream[T] {
+	next, stop := iter.Pull(seq)
+	return LazyStreamFromGenerator(func() (T, bool) {
+		val, ok := next()
+		if !ok {
+			stop()
+		}
+		return val, ok
+	})
+}
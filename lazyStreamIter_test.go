@@ -0,0 +1,35 @@
+//go:build go1.23
+
+package fpgo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLazyStreamFromSeq(t *testing.T) {
+	pulled := 0
+	naturals := func(yield func(int) bool) {
+		for i := 1; ; i++ {
+			pulled++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+	assert.Equal(t, []int{2, 4, 6}, LazyStreamFromSeq(naturals).Filter(func(v int) bool {
+		return v%2 == 0
+	}).Take(3).ToArray())
+	// On demand
+	assert.Equal(t, 6, pulled)
+
+	// Finite
+	assert.Equal(t, []int{1, 2, 3}, SortOrderedAscending(LazyStreamFromSeq(NewHashSet(1, 2, 3).Iter()).ToArray()...))
+	stream := LazyStreamFromSeq(func(yield func(string) bool) {
+		yield("a")
+	})
+	assert.Equal(t, []string{"a"}, stream.ToArray())
+	_, ok := stream.Next()
+	assert.Equal(t, false, ok)
+}
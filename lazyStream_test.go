@@ -0,0 +1,78 @@
+package fpgo
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLazyStream(t *testing.T) {
+	assert.Equal(t, []int{1, 2, 3}, LazyStreamFrom(1, 2, 3).ToArray())
+	assert.Equal(t, []int{}, LazyStreamFrom[int]().ToArray())
+
+	// Infinite generator
+	naturals := func() *LazyStream[int] {
+		i := 0
+		return LazyStreamFromGenerator(func() (int, bool) {
+			i++
+			return i, true
+		})
+	}
+	assert.Equal(t, []int{4, 16, 36}, naturals().Filter(func(v int) bool {
+		return v%2 == 0
+	}).Map(func(v int) int {
+		return v * v
+	}).Take(3).ToArray())
+	assert.Equal(t, []int{1, 3, 5}, naturals().Reject(func(v int) bool {
+		return v%2 == 0
+	}).Take(3).ToArray())
+	assert.Equal(t, []int{1, 2, 3}, naturals().TakeWhile(func(v int) bool {
+		return v < 4
+	}).ToArray())
+	assert.Equal(t, []int{11, 12}, naturals().Skip(10).Take(2).ToArray())
+	assert.Equal(t, []int{}, LazyStreamFrom(1, 2).Skip(3).ToArray())
+	assert.Equal(t, []string{"1", "2"}, LazyStreamMap(naturals().Take(2), strconv.Itoa).ToArray())
+
+	// Lazy evaluation
+	pulled := 0
+	counted := LazyStreamFromGenerator(func() (int, bool) {
+		pulled++
+		return pulled, true
+	})
+	lazyStream := counted.Map(func(v int) int {
+		return v * 10
+	}).Take(2)
+	assert.Equal(t, 0, pulled)
+	assert.Equal(t, []int{10, 20}, lazyStream.ToArray())
+	assert.Equal(t, 2, pulled)
+
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, LazyStreamFrom(1, 2).Concat(LazyStreamFrom(3), nil, LazyStreamFrom(4, 5)).ToArray())
+
+	actual := 0
+	LazyStreamFrom(1, 2, 3).ForEach(func(v int) {
+		actual += v
+	})
+	assert.Equal(t, 6, actual)
+}
+
+func TestLazyStreamGenerators(t *testing.T) {
+	assert.Equal(t, []int{1, 2, 4, 8, 16}, StreamIterate(1, func(v int) int {
+		return v * 2
+	}).Take(5).ToArray())
+	assert.Equal(t, []int{1, 2, 4}, StreamIterate(1, func(v int) int {
+		return v * 2
+	}).TakeWhile(func(v int) bool {
+		return v < 5
+	}).ToArray())
+
+	assert.Equal(t, []string{"a", "a", "a"}, StreamRepeat("a", 3).ToArray())
+	assert.Equal(t, []string{}, StreamRepeat("a", 0).ToArray())
+	assert.Equal(t, []string{"a", "a"}, StreamRepeat("a", -1).Take(2).ToArray())
+
+	assert.Equal(t, []int{0, 1, 2}, StreamRangeInt(0, 3, 1).ToArray())
+	assert.Equal(t, []int{1, 4, 7}, StreamRangeInt(1, 10, 3).ToArray())
+	assert.Equal(t, []int{3, 1, -1}, StreamRangeInt(3, -3, -2).ToArray())
+	assert.Equal(t, []int{}, StreamRangeInt(3, 0, 1).ToArray())
+	assert.Equal(t, []int{}, StreamRangeInt(0, 3, 0).ToArray())
+}
@@ -0,0 +1,69 @@
+package fpgo
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLazy(t *testing.T) {
+	computed := 0
+	lazy := NewLazy(func() int {
+		computed++
+		return 3
+	})
+	mapped := lazy.Map(func(v int) int {
+		return v * 2
+	})
+	stringified := LazyMap(mapped, strconv.Itoa)
+	flatMapped := LazyFlatMap(lazy, func(v int) *Lazy[string] {
+		return LazyFrom(strconv.Itoa(v + 1))
+	})
+	assert.Equal(t, false, lazy.IsEvaluated())
+	assert.Equal(t, 0, computed)
+
+	assert.Equal(t, "6", stringified.Force())
+	assert.Equal(t, "4", flatMapped.Force())
+	assert.Equal(t, 3, lazy.Force())
+	assert.Equal(t, 1, computed)
+	assert.Equal(t, true, lazy.IsEvaluated())
+	assert.Equal(t, true, mapped.IsEvaluated())
+
+	evaluated := LazyFrom("a")
+	assert.Equal(t, true, evaluated.IsEvaluated())
+	assert.Equal(t, "a", evaluated.Force())
+
+	// Once-only for concurrent calls
+	var lock sync.Mutex
+	computed = 0
+	lazy = NewLazy(func() int {
+		lock.Lock()
+		defer lock.Unlock()
+		computed++
+		return computed
+	})
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.Equal(t, 1, lazy.Force())
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 1, computed)
+
+	// Panics are kept
+	panicked := NewLazy(func() int {
+		panic("failed")
+	})
+	assert.PanicsWithValue(t, "failed", func() {
+		panicked.Force()
+	})
+	assert.PanicsWithValue(t, "failed", func() {
+		panicked.Force()
+	})
+	assert.Equal(t, true, panicked.IsEvaluated())
+}
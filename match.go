@@ -0,0 +1,82 @@
+package fpgo
+
+import (
+	"errors"
+)
+
+var (
+	// ErrMatchNotFound No case matches the value (Get()/Unwrap() of MatchDef)
+	ErrMatchNotFound = errors.New("match not found")
+)
+
+// Match
+
+// MatchDef Type-safe pattern matching builder, the first matching case decides the result(the later ones are skipped)
+type MatchDef[T any, R any] struct {
+	value     T
+	result    R
+	isMatched bool
+}
+
+// Match New MatchDef of the value returning R
+func Match[T any, R any](value T) *MatchDef[T, R] {
+	return &MatchDef[T, R]{value: value}
+}
+
+// Case In case of the predicate passes for the value
+func (matchSelf *MatchDef[T, R]) Case(predicate Predicate[T], fn func(T) R) *MatchDef[T, R] {
+	if !matchSelf.isMatched && predicate(matchSelf.value) {
+		matchSelf.result = fn(matchSelf.value)
+		matchSelf.isMatched = true
+	}
+	return matchSelf
+}
+
+// CaseValue In case of the value is equal(==) to the given one
+//
+// NOTE: like == of interfaces, it panics if the dynamic type isn't comparable.
+func (matchSelf *MatchDef[T, R]) CaseValue(value T, fn func(T) R) *MatchDef[T, R] {
+	return matchSelf.Case(func(in T) bool {
+		return interface{}(in) == interface{}(value)
+	}, fn)
+}
+
+// MatchCaseType In case of the value(e.g. an interface of a sealed-style sum type) is an S(the S value is bound to fn)
+func MatchCaseType[S any, T any, R any](matchDef *MatchDef[T, R], fn func(S) R) *MatchDef[T, R] {
+	if !matchDef.isMatched {
+		if value, ok := interface{}(matchDef.value).(S); ok {
+			matchDef.result = fn(value)
+			matchDef.isMatched = true
+		}
+	}
+	return matchDef
+}
+
+// IsMatched Check does any case match the value
+func (matchSelf *MatchDef[T, R]) IsMatched() bool {
+	return matchSelf.isMatched
+}
+
+// Default Get the result, or fn(value) if no case matches
+func (matchSelf *MatchDef[T, R]) Default(fn func(T) R) R {
+	if !matchSelf.isMatched {
+		return fn(matchSelf.value)
+	}
+	return matchSelf.result
+}
+
+// Get Get the result(strict), ErrMatchNotFound if no case matches
+func (matchSelf *MatchDef[T, R]) Get() (R, error) {
+	if !matchSelf.isMatched {
+		return matchSelf.result, ErrMatchNotFound
+	}
+	return matchSelf.result, nil
+}
+
+// Unwrap Get the result(strict), panic if no case matches
+func (matchSelf *MatchDef[T, R]) Unwrap() R {
+	if !matchSelf.isMatched {
+		panic(ErrMatchNotFound)
+	}
+	return matchSelf.result
+}
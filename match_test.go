@@ -0,0 +1,94 @@
+package fpgo
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type matchTestShape interface {
+	isMatchTestShape()
+}
+
+type matchTestCircle struct {
+	radius float64
+}
+
+type matchTestSquare struct {
+	side float64
+}
+
+func (matchTestCircle) isMatchTestShape() {}
+func (matchTestSquare) isMatchTestShape() {}
+
+func TestMatch(t *testing.T) {
+	describe := func(v int) string {
+		return Match[int, string](v).
+			CaseValue(0, func(int) string {
+				return "zero"
+			}).
+			Case(func(v int) bool {
+				return v < 0
+			}, func(v int) string {
+				return "negative " + strconv.Itoa(-v)
+			}).
+			Case(func(v int) bool {
+				return v%2 == 0
+			}, func(v int) string {
+				return "even"
+			}).
+			Default(strconv.Itoa)
+	}
+	assert.Equal(t, "zero", describe(0))
+	assert.Equal(t, "negative 2", describe(-2))
+	assert.Equal(t, "even", describe(4))
+	assert.Equal(t, "5", describe(5))
+
+	// The later cases are skipped
+	called := false
+	matched := Match[int, int](1).CaseValue(1, func(v int) int {
+		return 10
+	}).Case(func(int) bool {
+		called = true
+		return true
+	}, func(v int) int {
+		return 20
+	})
+	assert.Equal(t, true, matched.IsMatched())
+	assert.Equal(t, 10, matched.Unwrap())
+	assert.Equal(t, false, called)
+
+	// Strict
+	unmatched := Match[int, int](2).CaseValue(1, func(v int) int {
+		return v
+	})
+	_, err := unmatched.Get()
+	assert.Equal(t, ErrMatchNotFound, err)
+	assert.PanicsWithValue(t, ErrMatchNotFound, func() {
+		unmatched.Unwrap()
+	})
+}
+
+func TestMatchCaseType(t *testing.T) {
+	area := func(shape matchTestShape) (float64, error) {
+		matchDef := Match[matchTestShape, float64](shape)
+		MatchCaseType(matchDef, func(circle matchTestCircle) float64 {
+			return math.Pi * circle.radius * circle.radius
+		})
+		MatchCaseType(matchDef, func(square matchTestSquare) float64 {
+			return square.side * square.side
+		})
+		return matchDef.Get()
+	}
+
+	result, err := area(matchTestSquare{side: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, float64(4), result)
+	result, err = area(matchTestCircle{radius: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, math.Pi, result)
+	_, err = area(nil)
+	assert.Equal(t, ErrMatchNotFound, err)
+}
@@ -126,6 +126,15 @@ func (maybeSelf someDef[T]) ToString() string {
 	}
 }
 
+// Format Format as Some(value) or None for fmt, the verb & flags are applied to the value(e.g. %+v)
+func (maybeSelf someDef[T]) Format(f fmt.State, verb rune) {
+	if maybeSelf.IsNil() {
+		fmt.Fprint(f, "None")
+		return
+	}
+	fmt.Fprintf(f, "Some("+formatDirective(f, verb)+")", maybeSelf.ref)
+}
+
 // ToPtr Maybe to Ptr
 func (maybeSelf someDef[T]) ToPtr() *T {
 	if maybeSelf.IsPtr() {
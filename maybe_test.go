@@ -2,6 +2,7 @@ package fpgo
 
 import (
 	"errors"
+	"fmt"
 	"reflect"
 	"testing"
 
@@ -322,3 +323,11 @@ func TestCast(t *testing.T) {
 	assert.Equal(t, false, b)
 	assert.Equal(t, errors.New("<nil>"), err)
 }
+
+func TestMaybeFormat(t *testing.T) {
+	assert.Equal(t, "Some(1)", fmt.Sprintf("%v", Maybe.Just(1)))
+	assert.Equal(t, "Some(1.50)", fmt.Sprintf("%.2f", JustGenerics(1.5)))
+	assert.Equal(t, "Some({A:1})", fmt.Sprintf("%+v", JustGenerics(struct{ A int }{1})))
+	assert.Equal(t, "None", fmt.Sprintf("%v", Maybe.Just(nil)))
+	assert.Equal(t, "None", fmt.Sprintf("%v", None))
+}
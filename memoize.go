@@ -0,0 +1,137 @@
+package fpgo
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// Memoize
+
+// MemoizeOption Options for Memoize usages
+type MemoizeOption struct {
+	// MaxEntries The maximum number of cached results, the least recently used ones are evicted(unlimited if <= 0)
+	MaxEntries int
+	// TTL The cached results expire after the TTL(never if <= 0)
+	TTL time.Duration
+	// CacheErrors Cache the failed results of MemoizeErr() too
+	CacheErrors bool
+	// Dedupe Collapse the concurrent calls of the same key(not cached yet) into one call of fn by SingleFlight
+	Dedupe bool
+	// TimeScheduler The clock of the TTL(DefaultTimeScheduler if nil)
+	TimeScheduler TimeScheduler
+}
+
+type memoizeEntry[K comparable, V any] struct {
+	key      K
+	val      V
+	err      error
+	cachedAt time.Time
+}
+
+// memoizeCache LRU cache with TTL shared by Memoize & MemoizeErr
+type memoizeCache[K comparable, V any] struct {
+	lock    sync.Mutex
+	option  MemoizeOption
+	entries map[K]*list.Element
+	order   *list.List
+}
+
+// Memoize Cache the results of fn by the argument(concurrency-safe), bounded by the MemoizeOption
+//
+// NOTE: fn could be called more than once for the same key if they're called concurrently before cached(unless Dedupe is set).
+func Memoize[K comparable, V any](fn func(K) V, opts ...MemoizeOption) func(K) V {
+	memoized := MemoizeErr(func(key K) (V, error) {
+		return fn(key), nil
+	}, opts...)
+	return func(key K) V {
+		val, _ := memoized(key)
+		return val
+	}
+}
+
+// MemoizeErr Cache the results of fn by the argument(concurrency-safe), the failed ones aren't cached unless CacheErrors is set
+func MemoizeErr[K comparable, V any](fn func(K) (V, error), opts ...MemoizeOption) func(K) (V, error) {
+	cache := newMemoizeCache[K, V](opts...)
+	load := func(key K) (V, error) {
+		val, err := fn(key)
+		if err == nil || cache.option.CacheErrors {
+			cache.put(key, val, err)
+		}
+		return val, err
+	}
+	if cache.option.Dedupe {
+		loads := NewSingleFlight[K, V]()
+		loadOnce := load
+		load = func(key K) (V, error) {
+			return loads.Dedupe(key, func() (V, error) {
+				// Cached by the previous call
+				if entry, ok := cache.get(key); ok {
+					return entry.val, entry.err
+				}
+				return loadOnce(key)
+			})
+		}
+	}
+
+	return func(key K) (V, error) {
+		if entry, ok := cache.get(key); ok {
+			return entry.val, entry.err
+		}
+		return load(key)
+	}
+}
+
+func newMemoizeCache[K comparable, V any](opts ...MemoizeOption) *memoizeCache[K, V] {
+	var option MemoizeOption
+	if len(opts) > 0 {
+		option = opts[0]
+	}
+	if option.TimeScheduler == nil {
+		option.TimeScheduler = DefaultTimeScheduler
+	}
+
+	return &memoizeCache[K, V]{
+		option:  option,
+		entries: map[K]*list.Element{},
+		order:   list.New(),
+	}
+}
+
+func (cacheSelf *memoizeCache[K, V]) get(key K) (*memoizeEntry[K, V], bool) {
+	cacheSelf.lock.Lock()
+	defer cacheSelf.lock.Unlock()
+
+	element, ok := cacheSelf.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := element.Value.(*memoizeEntry[K, V])
+	if cacheSelf.option.TTL > 0 && cacheSelf.option.TimeScheduler.Now().Sub(entry.cachedAt) >= cacheSelf.option.TTL {
+		cacheSelf.order.Remove(element)
+		delete(cacheSelf.entries, key)
+		return nil, false
+	}
+
+	cacheSelf.order.MoveToFront(element)
+	return entry, true
+}
+
+func (cacheSelf *memoizeCache[K, V]) put(key K, val V, err error) {
+	cacheSelf.lock.Lock()
+	defer cacheSelf.lock.Unlock()
+
+	entry := &memoizeEntry[K, V]{key: key, val: val, err: err, cachedAt: cacheSelf.option.TimeScheduler.Now()}
+	if element, ok := cacheSelf.entries[key]; ok {
+		element.Value = entry
+		cacheSelf.order.MoveToFront(element)
+		return
+	}
+
+	cacheSelf.entries[key] = cacheSelf.order.PushFront(entry)
+	if cacheSelf.option.MaxEntries > 0 && cacheSelf.order.Len() > cacheSelf.option.MaxEntries {
+		oldest := cacheSelf.order.Back()
+		cacheSelf.order.Remove(oldest)
+		delete(cacheSelf.entries, oldest.Value.(*memoizeEntry[K, V]).key)
+	}
+}
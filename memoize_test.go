@@ -0,0 +1,71 @@
+package fpgo
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoize(t *testing.T) {
+	var calls []int
+	square := func(v int) int {
+		calls = append(calls, v)
+		return v * v
+	}
+
+	memoized := Memoize(square)
+	assert.Equal(t, 4, memoized(2))
+	assert.Equal(t, 4, memoized(2))
+	assert.Equal(t, 9, memoized(3))
+	assert.Equal(t, []int{2, 3}, calls)
+
+	// LRU
+	calls = nil
+	memoized = Memoize(square, MemoizeOption{MaxEntries: 2})
+	memoized(1)
+	memoized(2)
+	memoized(1)
+	memoized(3)
+	memoized(1)
+	memoized(2)
+	assert.Equal(t, []int{1, 2, 3, 2}, calls)
+
+	// TTL
+	calls = nil
+	timeScheduler := NewVirtualTimeScheduler(time.Now())
+	memoized = Memoize(square, MemoizeOption{TTL: 10 * time.Millisecond, TimeScheduler: timeScheduler})
+	memoized(1)
+	timeScheduler.Advance(9 * time.Millisecond)
+	memoized(1)
+	timeScheduler.Advance(1 * time.Millisecond)
+	memoized(1)
+	assert.Equal(t, []int{1, 1}, calls)
+}
+
+func TestMemoizeErr(t *testing.T) {
+	var calls []string
+	parse := func(s string) (int, error) {
+		calls = append(calls, s)
+		return strconv.Atoi(s)
+	}
+
+	memoized := MemoizeErr(parse)
+	result, err := memoized("1")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, result)
+	memoized("1")
+	_, err = memoized("x")
+	assert.True(t, errors.Is(err, strconv.ErrSyntax))
+	memoized("x")
+	assert.Equal(t, []string{"1", "x", "x"}, calls)
+
+	calls = nil
+	memoized = MemoizeErr(parse, MemoizeOption{CacheErrors: true})
+	memoized("x")
+	_, err = memoized("x")
+	assert.True(t, errors.Is(err, strconv.ErrSyntax))
+	assert.Equal(t, []string{"x"}, calls)
+}
@@ -2,7 +2,7 @@ package fpgo
 
 // MonadIODef MonadIO inspired by Rx/Observable
 type MonadIODef[T any] struct {
-	effect func() T
+	effect func() (T, error)
 
 	obOn  *HandlerDef
 	subOn *HandlerDef
@@ -11,6 +11,22 @@ type MonadIODef[T any] struct {
 // Subscription the delegation/callback of MonadIO/Publisher
 type Subscription[T any] struct {
 	OnNext func(T)
+	// OnError Called once when the source terminates with an error
+	OnError func(error)
+	// OnComplete Called once when the source terminates normally
+	OnComplete func()
+
+	onUnsubscribe func()
+	dispose       func()
+	// replay Queue the items published while it's being replayed(nil if there's no replay)
+	replay *publisherReplay
+}
+
+// Dispose Unsubscribe the Publisher it subscribed(Disposable)
+func (subscriptionSelf *Subscription[T]) Dispose() {
+	if subscriptionSelf.dispose != nil {
+		subscriptionSelf.dispose()
+	}
 }
 
 // Just New MonadIO by a given value
@@ -20,8 +36,8 @@ func (monadIOSelf MonadIODef[T]) Just(in interface{}) *MonadIODef[interface{}] {
 
 // MonadIOJustGenerics New MonadIO by a given value
 func MonadIOJustGenerics[T any](in T) *MonadIODef[T] {
-	return &MonadIODef[T]{effect: func() T {
-		return in
+	return &MonadIODef[T]{effect: func() (T, error) {
+		return in, nil
 	}}
 }
 
@@ -32,17 +48,66 @@ func (monadIOSelf *MonadIODef[T]) New(effect func() T) *MonadIODef[T] {
 
 // MonadIONewGenerics New MonadIO by effect function
 func MonadIONewGenerics[T any](effect func() T) *MonadIODef[T] {
+	return &MonadIODef[T]{effect: func() (T, error) {
+		return effect(), nil
+	}}
+}
+
+// MonadIONewWithError New MonadIO by effect function carrying an error alongside the value
+func MonadIONewWithError[T any](effect func() (T, error)) *MonadIODef[T] {
 	return &MonadIODef[T]{effect: effect}
 }
 
-// FlatMap FlatMap the MonadIO by function
+// MonadIOFail New MonadIO failing with the given error
+func MonadIOFail[T any](err error) *MonadIODef[T] {
+	return &MonadIODef[T]{effect: func() (T, error) {
+		var zero T
+		return zero, err
+	}}
+}
+
+// FlatMap FlatMap the MonadIO by function(skipped if it failed)
 func (monadIOSelf *MonadIODef[T]) FlatMap(fn func(T) *MonadIODef[T]) *MonadIODef[T] {
-	return &MonadIODef[T]{effect: func() T {
-		next := fn(monadIOSelf.doEffect())
-		return next.doEffect()
+	return &MonadIODef[T]{effect: func() (T, error) {
+		result, err := monadIOSelf.doEffect()
+		if err != nil {
+			return result, err
+		}
+		return fn(result).doEffect()
 	}}
 }
 
+// MonadIO Error Handling
+
+// Rescue Recover from the error by the MonadIO returned by the handler
+func (monadIOSelf *MonadIODef[T]) Rescue(handler func(error) *MonadIODef[T]) *MonadIODef[T] {
+	return &MonadIODef[T]{effect: func() (T, error) {
+		result, err := monadIOSelf.doEffect()
+		if err == nil {
+			return result, nil
+		}
+		return handler(err).doEffect()
+	}}
+}
+
+// MapErr Map the error by function(skipped if it succeeded)
+func (monadIOSelf *MonadIODef[T]) MapErr(fn func(error) error) *MonadIODef[T] {
+	return &MonadIODef[T]{effect: func() (T, error) {
+		result, err := monadIOSelf.doEffect()
+		if err != nil {
+			err = fn(err)
+		}
+		return result, err
+	}}
+}
+
+// OrElse Eval the other MonadIO if it failed
+func (monadIOSelf *MonadIODef[T]) OrElse(other *MonadIODef[T]) *MonadIODef[T] {
+	return monadIOSelf.Rescue(func(error) *MonadIODef[T] {
+		return other
+	})
+}
+
 // Subscribe Subscribe the MonadIO by Subscription
 func (monadIOSelf *MonadIODef[T]) Subscribe(s Subscription[T]) *Subscription[T] {
 	obOn := monadIOSelf.obOn
@@ -63,14 +128,27 @@ func (monadIOSelf *MonadIODef[T]) ObserveOn(h *HandlerDef) *MonadIODef[T] {
 }
 
 func (monadIOSelf *MonadIODef[T]) doSubscribe(s *Subscription[T], obOn *HandlerDef, subOn *HandlerDef) *Subscription[T] {
-	if s.OnNext != nil {
+	if s.OnNext != nil || s.OnError != nil {
 		var result T
+		var err error
 
 		doSub := func() {
-			s.OnNext(result)
+			if err != nil {
+				if s.OnError != nil {
+					s.OnError(err)
+				}
+				return
+			}
+
+			if s.OnNext != nil {
+				s.OnNext(result)
+			}
+			if s.OnComplete != nil {
+				s.OnComplete()
+			}
 		}
 		doOb := func() {
-			result = monadIOSelf.doEffect()
+			result, err = monadIOSelf.doEffect()
 
 			if subOn != nil {
 				subOn.Post(doSub)
@@ -88,12 +166,18 @@ func (monadIOSelf *MonadIODef[T]) doSubscribe(s *Subscription[T], obOn *HandlerD
 	return s
 }
 
-func (monadIOSelf *MonadIODef[T]) doEffect() T {
+func (monadIOSelf *MonadIODef[T]) doEffect() (T, error) {
 	return monadIOSelf.effect()
 }
 
-// Eval Eval the value right now(sync)
+// Eval Eval the value right now(sync), it's the zero value if it failed
 func (monadIOSelf *MonadIODef[T]) Eval() T {
+	result, _ := monadIOSelf.doEffect()
+	return result
+}
+
+// EvalWithError Eval the value & the error right now(sync)
+func (monadIOSelf *MonadIODef[T]) EvalWithError() (T, error) {
 	return monadIOSelf.doEffect()
 }
 
@@ -0,0 +1,195 @@
+package fpgo
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// MonadIO Operators
+
+// ErrMonadIOTimeout The effect of the MonadIO isn't done before the timeout
+var ErrMonadIOTimeout = errors.New("monadIO timeout")
+
+// Retry Retry the effect by the RetryPolicy when Eval() is called, the last error is kept if it's still failed
+func (monadIOSelf *MonadIODef[T]) Retry(policy RetryPolicy) *MonadIODef[T] {
+	return &MonadIODef[T]{effect: func() (T, error) {
+		return Retry(monadIOSelf.doEffect, policy)
+	}}
+}
+
+// Protect Eval the effect through the CircuitBreaker(failing with ErrCircuitBreakerOpen if it's rejected)
+func (monadIOSelf *MonadIODef[T]) Protect(breaker *CircuitBreaker) *MonadIODef[T] {
+	return &MonadIODef[T]{effect: func() (T, error) {
+		return CircuitBreakerExecute(breaker, monadIOSelf.doEffect)
+	}}
+}
+
+// Timeout Fail with ErrMonadIOTimeout if the effect isn't done before the timeout
+//
+// NOTE: the effect runs on its own goroutine and it's not interrupted by the timeout.
+func (monadIOSelf *MonadIODef[T]) Timeout(timeout time.Duration) *MonadIODef[T] {
+	return &MonadIODef[T]{effect: func() (T, error) {
+		future := FutureFrom(monadIOSelf.doEffect)
+		result, err := future.GetWithTimeout(timeout)
+		if err == ErrFutureTimeout {
+			err = ErrMonadIOTimeout
+		}
+		return result, err
+	}}
+}
+
+// MonadIO Resource Safety
+
+// Ensure Call the finalizer after the effect, whether it succeeded, failed or panicked
+func (monadIOSelf *MonadIODef[T]) Ensure(finalizer func()) *MonadIODef[T] {
+	return &MonadIODef[T]{effect: func() (T, error) {
+		defer finalizer()
+		return monadIOSelf.doEffect()
+	}}
+}
+
+// Bracket Acquire the resource, use it and release it whether the use succeeded, failed or panicked
+//
+// NOTE: release isn't called if acquire failed.
+func Bracket[R any, T any](acquire *MonadIODef[R], use func(R) *MonadIODef[T], release func(R)) *MonadIODef[T] {
+	return &MonadIODef[T]{effect: func() (T, error) {
+		resource, err := acquire.doEffect()
+		if err != nil {
+			return *new(T), err
+		}
+		defer release(resource)
+
+		return use(resource).doEffect()
+	}}
+}
+
+// MonadIO Caching
+
+// Memoize Eval the effect once and cache the result(the error included) for the following Eval() calls(thread-safe)
+func (monadIOSelf *MonadIODef[T]) Memoize() *MonadIODef[T] {
+	return monadIOSelf.memoize(0, DefaultTimeScheduler)
+}
+
+// MemoizeFor Like Memoize() but the cached result expires after the ttl, then the effect is evaluated again
+func (monadIOSelf *MonadIODef[T]) MemoizeFor(ttl time.Duration) *MonadIODef[T] {
+	return monadIOSelf.memoize(ttl, DefaultTimeScheduler)
+}
+
+// memoize Cache the result by the clock of the TimeScheduler(never expires if ttl <= 0)
+func (monadIOSelf *MonadIODef[T]) memoize(ttl time.Duration, timeScheduler TimeScheduler) *MonadIODef[T] {
+	var lock sync.Mutex
+	var isCached bool
+	var cachedAt time.Time
+	var result T
+	var err error
+
+	return &MonadIODef[T]{effect: func() (T, error) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		if isCached && (ttl <= 0 || timeScheduler.Now().Sub(cachedAt) < ttl) {
+			return result, err
+		}
+		result, err = monadIOSelf.doEffect()
+		isCached, cachedAt = true, timeScheduler.Now()
+		return result, err
+	}}
+}
+
+// MonadIO Parallel
+
+// ParSequence Eval the MonadIOs concurrently on the Scheduler(e.g. worker.WorkerPool, GoroutineScheduler if nil) and join the results in order
+//
+// NOTE: it fails fast on the first error, the effects not started yet are cancelled.
+func ParSequence[T any](monadIOs []*MonadIODef[T], scheduler Scheduler) *MonadIODef[[]T] {
+	return &MonadIODef[[]T]{effect: func() ([]T, error) {
+		results := make([]T, len(monadIOs))
+		tasks := make([]func() error, len(monadIOs))
+		for i, monadIO := range monadIOs {
+			i, monadIO := i, monadIO
+			tasks[i] = func() (err error) {
+				results[i], err = monadIO.doEffect()
+				return err
+			}
+		}
+		if err := monadIOParRun(scheduler, tasks...); err != nil {
+			return nil, err
+		}
+		return results, nil
+	}}
+}
+
+// ParZip2 Eval 2 MonadIOs concurrently on the Scheduler and join the results as a Tuple2(fail fast like ParSequence)
+func ParZip2[A any, B any](monadIOA *MonadIODef[A], monadIOB *MonadIODef[B], scheduler Scheduler) *MonadIODef[Tuple2[A, B]] {
+	return &MonadIODef[Tuple2[A, B]]{effect: func() (Tuple2[A, B], error) {
+		var result Tuple2[A, B]
+		err := monadIOParRun(scheduler, func() (err error) {
+			result.V1, err = monadIOA.doEffect()
+			return err
+		}, func() (err error) {
+			result.V2, err = monadIOB.doEffect()
+			return err
+		})
+		if err != nil {
+			return Tuple2[A, B]{}, err
+		}
+		return result, nil
+	}}
+}
+
+// ParZip3 Eval 3 MonadIOs concurrently on the Scheduler and join the results as a Tuple3(fail fast like ParSequence)
+func ParZip3[A any, B any, C any](monadIOA *MonadIODef[A], monadIOB *MonadIODef[B], monadIOC *MonadIODef[C], scheduler Scheduler) *MonadIODef[Tuple3[A, B, C]] {
+	return &MonadIODef[Tuple3[A, B, C]]{effect: func() (Tuple3[A, B, C], error) {
+		var result Tuple3[A, B, C]
+		err := monadIOParRun(scheduler, func() (err error) {
+			result.V1, err = monadIOA.doEffect()
+			return err
+		}, func() (err error) {
+			result.V2, err = monadIOB.doEffect()
+			return err
+		}, func() (err error) {
+			result.V3, err = monadIOC.doEffect()
+			return err
+		})
+		if err != nil {
+			return Tuple3[A, B, C]{}, err
+		}
+		return result, nil
+	}}
+}
+
+// monadIOParRun Run the tasks concurrently on the Scheduler, returns the first error without waiting for the rest
+func monadIOParRun(scheduler Scheduler, tasks ...func() error) error {
+	if scheduler == nil {
+		scheduler = GoroutineScheduler
+	}
+
+	var isCancelled AtomBool
+	errCh := make(chan error, len(tasks))
+	for _, task := range tasks {
+		task := task
+		err := scheduler.Schedule(func() {
+			if isCancelled.Get() {
+				errCh <- nil
+				return
+			}
+			err := task()
+			if err != nil {
+				isCancelled.Set(true)
+			}
+			errCh <- err
+		})
+		if err != nil {
+			isCancelled.Set(true)
+			return err
+		}
+	}
+
+	for range tasks {
+		if err := <-errCh; err != nil {
+			return err
+		}
+	}
+	return nil
+}
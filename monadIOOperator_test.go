@@ -0,0 +1,174 @@
+package fpgo
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMonadIORetryTimeout(t *testing.T) {
+	attempts := 0
+	flaky := MonadIONewWithError(func() (int, error) {
+		attempts++
+		if attempts < 3 {
+			return 0, errors.New("flaky")
+		}
+		return attempts, nil
+	})
+	assert.Equal(t, 0, attempts)
+	result, err := flaky.Retry(FixedBackoff(2, 0)).EvalWithError()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, result)
+
+	attempts = 0
+	_, err = flaky.Retry(FixedBackoff(1, time.Millisecond)).EvalWithError()
+	assert.EqualError(t, err, "flaky")
+	assert.Equal(t, 2, attempts)
+
+	attempts = 0
+	_, err = flaky.Retry(RetryPolicy{MaxRetries: -1, ShouldRetry: func(error) bool {
+		return false
+	}}).EvalWithError()
+	assert.EqualError(t, err, "flaky")
+	assert.Equal(t, 1, attempts)
+
+	release := make(chan bool)
+	defer close(release)
+	_, err = MonadIONewGenerics(func() int {
+		<-release
+		return 1
+	}).Timeout(time.Millisecond).EvalWithError()
+	assert.Equal(t, ErrMonadIOTimeout, err)
+
+	result, err = MonadIOJustGenerics(2).Timeout(time.Second).EvalWithError()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, result)
+}
+
+func TestMonadIOParallel(t *testing.T) {
+	started := make(chan int, 3)
+	release := make(chan bool)
+	waiting := func(v int) *MonadIODef[int] {
+		return MonadIONewGenerics(func() int {
+			started <- v
+			<-release
+			return v
+		})
+	}
+
+	// Evaluated concurrently
+	monadIO := ParSequence([]*MonadIODef[int]{waiting(1), waiting(2), waiting(3)}, nil)
+	future := FutureFrom(monadIO.EvalWithError)
+	assert.ElementsMatch(t, []int{1, 2, 3}, []int{<-started, <-started, <-started})
+	close(release)
+	results, err := future.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, results)
+
+	// Fail fast & skip the rest
+	errFirst := errors.New("first")
+	evaluated := false
+	_, err = ParSequence([]*MonadIODef[int]{
+		MonadIOFail[int](errFirst),
+		MonadIONewGenerics(func() int {
+			evaluated = true
+			return 0
+		}),
+	}, ImmediateScheduler).EvalWithError()
+	assert.Equal(t, errFirst, err)
+	assert.Equal(t, false, evaluated)
+
+	pair, err := ParZip2(MonadIOJustGenerics(1), MonadIOJustGenerics("a"), GoroutineScheduler).EvalWithError()
+	assert.NoError(t, err)
+	assert.Equal(t, NewTuple2(1, "a"), pair)
+	triple, err := ParZip3(MonadIOJustGenerics(1), MonadIOJustGenerics("a"), MonadIOJustGenerics(true), nil).EvalWithError()
+	assert.NoError(t, err)
+	assert.Equal(t, NewTuple3(1, "a", true), triple)
+	_, err = ParZip2(MonadIOJustGenerics(1), MonadIOFail[string](errFirst), nil).EvalWithError()
+	assert.Equal(t, errFirst, err)
+}
+
+func TestMonadIOBracketEnsure(t *testing.T) {
+	var released []string
+	acquire := func(name string) *MonadIODef[string] {
+		return MonadIOJustGenerics(name)
+	}
+	release := func(name string) {
+		released = append(released, name)
+	}
+
+	result, err := Bracket(acquire("a"), func(name string) *MonadIODef[int] {
+		return MonadIOJustGenerics(len(name))
+	}, release).EvalWithError()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, result)
+	assert.Equal(t, []string{"a"}, released)
+
+	errUse := errors.New("use")
+	_, err = Bracket(acquire("b"), func(string) *MonadIODef[int] {
+		return MonadIOFail[int](errUse)
+	}, release).EvalWithError()
+	assert.Equal(t, errUse, err)
+	assert.Equal(t, []string{"a", "b"}, released)
+
+	assert.Panics(t, func() {
+		Bracket(acquire("c"), func(string) *MonadIODef[int] {
+			panic("use")
+		}, release).Eval()
+	})
+	assert.Equal(t, []string{"a", "b", "c"}, released)
+
+	// Not acquired
+	_, err = Bracket(MonadIOFail[string](errUse), func(string) *MonadIODef[int] {
+		return MonadIOJustGenerics(0)
+	}, release).EvalWithError()
+	assert.Equal(t, errUse, err)
+	assert.Equal(t, []string{"a", "b", "c"}, released)
+
+	finalized := 0
+	finalizer := func() {
+		finalized++
+	}
+	MonadIOJustGenerics(1).Ensure(finalizer).Eval()
+	MonadIOFail[int](errUse).Ensure(finalizer).Eval()
+	assert.Panics(t, func() {
+		MonadIONewGenerics(func() int {
+			panic("effect")
+		}).Ensure(finalizer).Eval()
+	})
+	assert.Equal(t, 3, finalized)
+}
+
+func TestMonadIOMemoize(t *testing.T) {
+	evaluated := 0
+	counter := MonadIONewGenerics(func() int {
+		evaluated++
+		return evaluated
+	})
+
+	memoized := counter.Memoize()
+	assert.Equal(t, 0, evaluated)
+	assert.Equal(t, 1, memoized.Eval())
+	assert.Equal(t, 1, memoized.Eval())
+	assert.Equal(t, 1, evaluated)
+
+	// Concurrent calls evaluate once
+	evaluated = 0
+	memoized = counter.Memoize()
+	results, _ := ParSequence([]*MonadIODef[int]{memoized, memoized, memoized}, nil).EvalWithError()
+	assert.Equal(t, []int{1, 1, 1}, results)
+
+	evaluated = 0
+	timeScheduler := NewVirtualTimeScheduler(time.Now())
+	memoized = counter.memoize(10*time.Millisecond, timeScheduler)
+	assert.Equal(t, 1, memoized.Eval())
+	timeScheduler.Advance(9 * time.Millisecond)
+	assert.Equal(t, 1, memoized.Eval())
+	timeScheduler.Advance(1 * time.Millisecond)
+	assert.Equal(t, 2, memoized.Eval())
+
+	evaluated = 0
+	assert.Equal(t, 1, counter.MemoizeFor(time.Hour).Eval())
+}
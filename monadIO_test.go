@@ -1,6 +1,9 @@
 package fpgo
 
 import (
+	"errors"
+	"fmt"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -40,3 +43,50 @@ func TestMonadIO(t *testing.T) {
 	m.Eval()
 	assert.Equal(t, 3, actualInt)
 }
+
+func TestMonadIOError(t *testing.T) {
+	errFirst := errors.New("first")
+	failed := MonadIOFail[int](errFirst)
+	result, err := failed.EvalWithError()
+	assert.Equal(t, 0, result)
+	assert.Equal(t, errFirst, err)
+
+	// FlatMap is skipped
+	called := false
+	_, err = failed.FlatMap(func(in int) *MonadIODef[int] {
+		called = true
+		return MonadIOJustGenerics(in)
+	}).EvalWithError()
+	assert.Equal(t, false, called)
+	assert.Equal(t, errFirst, err)
+
+	_, err = failed.MapErr(func(err error) error {
+		return fmt.Errorf("wrapped: %w", err)
+	}).EvalWithError()
+	assert.ErrorIs(t, err, errFirst)
+	assert.EqualError(t, err, "wrapped: first")
+
+	result, err = failed.Rescue(func(err error) *MonadIODef[int] {
+		return MonadIOJustGenerics(len(err.Error()))
+	}).EvalWithError()
+	assert.NoError(t, err)
+	assert.Equal(t, 5, result)
+
+	assert.Equal(t, 2, failed.OrElse(MonadIOJustGenerics(2)).Eval())
+	assert.Equal(t, 3, MonadIOJustGenerics(3).OrElse(MonadIOJustGenerics(2)).Eval())
+
+	parsed := MonadIONewWithError(func() (int, error) {
+		return strconv.Atoi("x")
+	})
+	var actualErr error
+	parsed.Subscribe(Subscription[int]{
+		OnNext: func(int) {
+			called = true
+		},
+		OnError: func(err error) {
+			actualErr = err
+		},
+	})
+	assert.Equal(t, false, called)
+	assert.ErrorIs(t, actualErr, strconv.ErrSyntax)
+}
@@ -0,0 +1,181 @@
+package fpgo
+
+// Monoid
+
+// Semigroup Types with an associative Combine, inspired by Haskell/Cats
+type Semigroup[T any] interface {
+	Combine(a T, b T) T
+}
+
+// Monoid Semigroup with an identity value for Combine(Empty)
+type Monoid[T any] interface {
+	Semigroup[T]
+	Empty() T
+}
+
+// semigroupDef Semigroup implemented by a function
+type semigroupDef[T any] func(T, T) T
+
+// NewSemigroup New Semigroup by the associative combine function
+func NewSemigroup[T any](combine func(T, T) T) Semigroup[T] {
+	return semigroupDef[T](combine)
+}
+
+// Combine Combine the 2 values
+func (semigroupSelf semigroupDef[T]) Combine(a T, b T) T {
+	return semigroupSelf(a, b)
+}
+
+// monoidDef Monoid implemented by functions
+type monoidDef[T any] struct {
+	empty   func() T
+	combine func(T, T) T
+}
+
+// NewMonoid New Monoid by the identity value factory & the associative combine function
+func NewMonoid[T any](empty func() T, combine func(T, T) T) Monoid[T] {
+	return monoidDef[T]{empty: empty, combine: combine}
+}
+
+// Combine Combine the 2 values
+func (monoidSelf monoidDef[T]) Combine(a T, b T) T {
+	return monoidSelf.combine(a, b)
+}
+
+// Empty Get the identity value
+func (monoidSelf monoidDef[T]) Empty() T {
+	return monoidSelf.empty()
+}
+
+// Instances
+
+// SemigroupFirst Semigroup keeping the first value
+func SemigroupFirst[T any]() Semigroup[T] {
+	return NewSemigroup(func(a T, _ T) T {
+		return a
+	})
+}
+
+// SemigroupLast Semigroup keeping the last value
+func SemigroupLast[T any]() Semigroup[T] {
+	return NewSemigroup(func(_ T, b T) T {
+		return b
+	})
+}
+
+// SemigroupMin Semigroup keeping the smaller value
+func SemigroupMin[T Ordered]() Semigroup[T] {
+	return NewSemigroup(func(a T, b T) T {
+		if b < a {
+			return b
+		}
+		return a
+	})
+}
+
+// SemigroupMax Semigroup keeping the larger value
+func SemigroupMax[T Ordered]() Semigroup[T] {
+	return NewSemigroup(func(a T, b T) T {
+		if b > a {
+			return b
+		}
+		return a
+	})
+}
+
+// MonoidMin Monoid keeping the smaller value(upperBound is the identity, e.g. math.MaxInt)
+func MonoidMin[T Ordered](upperBound T) Monoid[T] {
+	return NewMonoid(func() T {
+		return upperBound
+	}, SemigroupMin[T]().Combine)
+}
+
+// MonoidMax Monoid keeping the larger value(lowerBound is the identity, e.g. math.MinInt)
+func MonoidMax[T Ordered](lowerBound T) Monoid[T] {
+	return NewMonoid(func() T {
+		return lowerBound
+	}, SemigroupMax[T]().Combine)
+}
+
+// MonoidSum Monoid summing up values
+func MonoidSum[T Numeric]() Monoid[T] {
+	return NewMonoid(func() T {
+		return 0
+	}, func(a T, b T) T {
+		return a + b
+	})
+}
+
+// MonoidProduct Monoid multiplying values
+func MonoidProduct[T Numeric]() Monoid[T] {
+	return NewMonoid(func() T {
+		return 1
+	}, func(a T, b T) T {
+		return a * b
+	})
+}
+
+// MonoidString Monoid concatenating strings
+func MonoidString() Monoid[string] {
+	return NewMonoid(func() string {
+		return ""
+	}, func(a string, b string) string {
+		return a + b
+	})
+}
+
+// MonoidSlice Monoid appending slices(the inputs are not modified)
+func MonoidSlice[T any]() Monoid[[]T] {
+	return NewMonoid(func() []T {
+		return []T{}
+	}, func(a []T, b []T) []T {
+		result := make([]T, 0, len(a)+len(b))
+		result = append(result, a...)
+		return append(result, b...)
+	})
+}
+
+// MonoidMap Monoid merging maps, values of duplicated keys are combined by the Semigroup(the inputs are not modified)
+func MonoidMap[K comparable, V any](values Semigroup[V]) Monoid[map[K]V] {
+	return NewMonoid(func() map[K]V {
+		return map[K]V{}
+	}, func(a map[K]V, b map[K]V) map[K]V {
+		result := make(map[K]V, len(a)+len(b))
+		for k, v := range a {
+			result[k] = v
+		}
+		for k, v := range b {
+			if existing, ok := result[k]; ok {
+				v = values.Combine(existing, v)
+			}
+			result[k] = v
+		}
+		return result
+	})
+}
+
+// Fold
+
+// CombineAll Combine all items by the Monoid(Empty() if there's no item)
+func CombineAll[T any](monoid Monoid[T], list ...T) T {
+	result := monoid.Empty()
+	for _, item := range list {
+		result = monoid.Combine(result, item)
+	}
+	return result
+}
+
+// FoldMonoid Combine all items of the Stream by the Monoid(Empty() if there's no item)
+func FoldMonoid[T comparable](streamSelf *StreamDef[T], monoid Monoid[T]) T {
+	if streamSelf == nil {
+		return monoid.Empty()
+	}
+	return CombineAll(monoid, (*streamSelf)...)
+}
+
+// Combining Collector combining items by the Monoid
+func Combining[T any](monoid Monoid[T]) Collector[T, T, T] {
+	return NewCollector(monoid.Empty, monoid.Combine, func(result T) T {
+		return result
+	})
+}
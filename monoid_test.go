@@ -0,0 +1,47 @@
+package fpgo
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMonoid(t *testing.T) {
+	assert.Equal(t, 10, FoldMonoid(StreamFromArray([]int{1, 2, 3, 4}), MonoidSum[int]()))
+	assert.Equal(t, 0, FoldMonoid(StreamFromArray([]int{}), MonoidSum[int]()))
+	assert.Equal(t, 0, FoldMonoid(nil, MonoidSum[int]()))
+	assert.Equal(t, 24.0, FoldMonoid(StreamFromArray([]float64{1, 2, 3, 4}), MonoidProduct[float64]()))
+	assert.Equal(t, 1, CombineAll(MonoidProduct[int]()))
+	assert.Equal(t, -2, FoldMonoid(StreamFromArray([]int{3, -2, 5}), MonoidMin(math.MaxInt)))
+	assert.Equal(t, 5, FoldMonoid(StreamFromArray([]int{3, -2, 5}), MonoidMax(math.MinInt)))
+	assert.Equal(t, math.MaxInt, CombineAll(MonoidMin(math.MaxInt)))
+	assert.Equal(t, "abc", FoldMonoid(StreamFromArray([]string{"a", "b", "c"}), MonoidString()))
+	assert.Equal(t, "a", SemigroupMin[string]().Combine("b", "a"))
+	assert.Equal(t, "b", SemigroupMax[string]().Combine("b", "a"))
+	assert.Equal(t, 1, SemigroupFirst[int]().Combine(1, 2))
+	assert.Equal(t, 2, SemigroupLast[int]().Combine(1, 2))
+
+	// Slices
+	a := []int{1, 2}
+	assert.Equal(t, []int{1, 2, 3, 4}, CombineAll(MonoidSlice[int](), a, []int{3}, nil, []int{4}))
+	assert.Equal(t, []int{1, 2}, a)
+	assert.Equal(t, []int{}, CombineAll(MonoidSlice[int]()))
+
+	// Maps
+	m1 := map[string]int{"a": 1, "b": 2}
+	m2 := map[string]int{"b": 3, "c": 4}
+	assert.Equal(t, map[string]int{"a": 1, "b": 5, "c": 4}, CombineAll(MonoidMap[string, int](MonoidSum[int]()), m1, m2))
+	assert.Equal(t, map[string]int{"a": 1, "b": 3, "c": 4}, CombineAll(MonoidMap[string, int](SemigroupLast[int]()), m1, m2))
+	assert.Equal(t, map[string]int{"a": 1, "b": 2}, m1)
+
+	// Associativity
+	sum := MonoidSum[int]()
+	assert.Equal(t, sum.Combine(sum.Combine(1, 2), 3), sum.Combine(1, sum.Combine(2, 3)))
+	concat := MonoidString()
+	assert.Equal(t, concat.Combine(concat.Combine("x", "y"), "z"), concat.Combine("x", concat.Combine("y", "z")))
+
+	// Collector
+	assert.Equal(t, 6, Collect(Combining(MonoidSum[int]()), 1, 2, 3))
+	assert.Equal(t, "xy", StreamCollect(StreamFromArray([]string{"x", "y"}), Combining(MonoidString())))
+}
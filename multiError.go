@@ -0,0 +1,27 @@
+package fpgo
+
+import (
+	"strconv"
+	"strings"
+)
+
+// MultiError
+
+// MultiError The errors of multiple failed calls(e.g. FutureAny(), StreamDef.ForEachLimited()), in the order of the calls
+type MultiError struct {
+	Errors []error
+}
+
+// Error Get the joined error message
+func (errSelf *MultiError) Error() string {
+	messages := make([]string, len(errSelf.Errors))
+	for i, err := range errSelf.Errors {
+		messages[i] = err.Error()
+	}
+	return strconv.Itoa(len(errSelf.Errors)) + " error(s) occurred: [" + strings.Join(messages, "; ") + "]"
+}
+
+// Unwrap Get the errors(for errors.Is/errors.As of go1.20)
+func (errSelf *MultiError) Unwrap() []error {
+	return errSelf.Errors
+}
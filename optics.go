@@ -0,0 +1,191 @@
+package fpgo
+
+// Optics
+
+// Lens Focus on a part A of a whole S, inspired by Haskell/Monocle(S is treated as immutable)
+type Lens[S any, A any] struct {
+	get func(S) A
+	set func(S, A) S
+}
+
+// NewLens New Lens by the getter & the setter(returns a new S with the part replaced)
+func NewLens[S any, A any](get func(S) A, set func(S, A) S) Lens[S, A] {
+	return Lens[S, A]{get: get, set: set}
+}
+
+// Get Get the part of the whole
+func (lensSelf Lens[S, A]) Get(whole S) A {
+	return lensSelf.get(whole)
+}
+
+// Set Get a new whole with the part replaced
+func (lensSelf Lens[S, A]) Set(whole S, part A) S {
+	return lensSelf.set(whole, part)
+}
+
+// Modify Get a new whole with the part transformed by fn
+func (lensSelf Lens[S, A]) Modify(whole S, fn func(A) A) S {
+	return lensSelf.set(whole, fn(lensSelf.get(whole)))
+}
+
+// AsOptional Convert the Lens to an Optional which always has the part
+func (lensSelf Lens[S, A]) AsOptional() Optional[S, A] {
+	return NewOptional(func(whole S) (A, bool) {
+		return lensSelf.get(whole), true
+	}, lensSelf.set)
+}
+
+// LensCompose Compose Lenses to focus on the part B of the part A of S
+func LensCompose[S any, A any, B any](outer Lens[S, A], inner Lens[A, B]) Lens[S, B] {
+	return NewLens(func(whole S) B {
+		return inner.Get(outer.Get(whole))
+	}, func(whole S, part B) S {
+		return outer.Modify(whole, func(middle A) A {
+			return inner.Set(middle, part)
+		})
+	})
+}
+
+// Prism
+
+// Prism Focus on a case A of a sum type S(e.g. the value of a present Maybe), inspired by Haskell/Monocle
+type Prism[S any, A any] struct {
+	getOption  func(S) (A, bool)
+	reverseGet func(A) S
+}
+
+// NewPrism New Prism by the partial getter & the constructor of S from A
+func NewPrism[S any, A any](getOption func(S) (A, bool), reverseGet func(A) S) Prism[S, A] {
+	return Prism[S, A]{getOption: getOption, reverseGet: reverseGet}
+}
+
+// GetOption Get the case, false if the whole isn't the case
+func (prismSelf Prism[S, A]) GetOption(whole S) (A, bool) {
+	return prismSelf.getOption(whole)
+}
+
+// ReverseGet Build a whole of the case
+func (prismSelf Prism[S, A]) ReverseGet(part A) S {
+	return prismSelf.reverseGet(part)
+}
+
+// Set Get a new whole of the part if the whole is the case(unchanged otherwise)
+func (prismSelf Prism[S, A]) Set(whole S, part A) S {
+	if _, ok := prismSelf.getOption(whole); !ok {
+		return whole
+	}
+	return prismSelf.reverseGet(part)
+}
+
+// Modify Get a new whole with the case transformed by fn(unchanged if the whole isn't the case)
+func (prismSelf Prism[S, A]) Modify(whole S, fn func(A) A) S {
+	part, ok := prismSelf.getOption(whole)
+	if !ok {
+		return whole
+	}
+	return prismSelf.reverseGet(fn(part))
+}
+
+// AsOptional Convert the Prism to an Optional
+func (prismSelf Prism[S, A]) AsOptional() Optional[S, A] {
+	return NewOptional(prismSelf.getOption, prismSelf.Set)
+}
+
+// PrismCompose Compose Prisms to focus on the case B of the case A of S
+func PrismCompose[S any, A any, B any](outer Prism[S, A], inner Prism[A, B]) Prism[S, B] {
+	return NewPrism(func(whole S) (B, bool) {
+		middle, ok := outer.GetOption(whole)
+		if !ok {
+			var zero B
+			return zero, false
+		}
+		return inner.GetOption(middle)
+	}, func(part B) S {
+		return outer.ReverseGet(inner.ReverseGet(part))
+	})
+}
+
+// PrismJust Prism focusing on the value of a present MaybeDef
+func PrismJust[T any]() Prism[MaybeDef[T], T] {
+	return NewPrism(func(maybe MaybeDef[T]) (T, bool) {
+		if maybe == nil || !maybe.IsPresent() {
+			var zero T
+			return zero, false
+		}
+		return maybe.Unwrap(), true
+	}, JustGenerics[T])
+}
+
+// PrismOk Prism focusing on the value of a successful Result
+func PrismOk[T any]() Prism[Result[T], T] {
+	return NewPrism(func(result Result[T]) (T, bool) {
+		val, err := result.Get()
+		return val, err == nil
+	}, ResultOk[T])
+}
+
+// Optional
+
+// Optional Focus on a part A of S which may not exist(e.g. composed by Lens & Prism), inspired by Monocle
+type Optional[S any, A any] struct {
+	getOption func(S) (A, bool)
+	set       func(S, A) S
+}
+
+// NewOptional New Optional by the partial getter & the setter
+func NewOptional[S any, A any](getOption func(S) (A, bool), set func(S, A) S) Optional[S, A] {
+	return Optional[S, A]{getOption: getOption, set: set}
+}
+
+// GetOption Get the part, false if it doesn't exist
+func (optionalSelf Optional[S, A]) GetOption(whole S) (A, bool) {
+	return optionalSelf.getOption(whole)
+}
+
+// Set Get a new whole with the part replaced(unchanged if the part doesn't exist)
+func (optionalSelf Optional[S, A]) Set(whole S, part A) S {
+	if _, ok := optionalSelf.getOption(whole); !ok {
+		return whole
+	}
+	return optionalSelf.set(whole, part)
+}
+
+// Modify Get a new whole with the part transformed by fn(unchanged if the part doesn't exist)
+func (optionalSelf Optional[S, A]) Modify(whole S, fn func(A) A) S {
+	part, ok := optionalSelf.getOption(whole)
+	if !ok {
+		return whole
+	}
+	return optionalSelf.set(whole, fn(part))
+}
+
+// OptionalCompose Compose Optionals to focus on the part B of the part A of S
+func OptionalCompose[S any, A any, B any](outer Optional[S, A], inner Optional[A, B]) Optional[S, B] {
+	return NewOptional(func(whole S) (B, bool) {
+		middle, ok := outer.GetOption(whole)
+		if !ok {
+			var zero B
+			return zero, false
+		}
+		return inner.GetOption(middle)
+	}, func(whole S, part B) S {
+		return outer.Modify(whole, func(middle A) A {
+			return inner.Set(middle, part)
+		})
+	})
+}
+
+// OptionalIndex Optional focusing on the i-th item of a slice(Set copies the slice)
+func OptionalIndex[T any](i int) Optional[[]T, T] {
+	return NewOptional(func(list []T) (T, bool) {
+		if i < 0 || i >= len(list) {
+			var zero T
+			return zero, false
+		}
+		return list[i], true
+	}, func(list []T, val T) []T {
+		result := DuplicateSlice(list)
+		result[i] = val
+		return result
+	})
+}
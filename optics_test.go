@@ -0,0 +1,110 @@
+package fpgo
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type opticsTestAddress struct {
+	City string
+}
+
+type opticsTestUser struct {
+	Name    string
+	Address opticsTestAddress
+	Phone   MaybeDef[string]
+	Tags    []string
+}
+
+var opticsTestUserAddress = NewLens(func(user opticsTestUser) opticsTestAddress {
+	return user.Address
+}, func(user opticsTestUser, address opticsTestAddress) opticsTestUser {
+	user.Address = address
+	return user
+})
+
+var opticsTestAddressCity = NewLens(func(address opticsTestAddress) string {
+	return address.City
+}, func(address opticsTestAddress, city string) opticsTestAddress {
+	address.City = city
+	return address
+})
+
+var opticsTestUserPhone = NewLens(func(user opticsTestUser) MaybeDef[string] {
+	return user.Phone
+}, func(user opticsTestUser, phone MaybeDef[string]) opticsTestUser {
+	user.Phone = phone
+	return user
+})
+
+var opticsTestUserTags = NewLens(func(user opticsTestUser) []string {
+	return user.Tags
+}, func(user opticsTestUser, tags []string) opticsTestUser {
+	user.Tags = tags
+	return user
+})
+
+func TestLens(t *testing.T) {
+	user := opticsTestUser{Name: "a", Address: opticsTestAddress{City: "Taipei"}}
+	userCity := LensCompose(opticsTestUserAddress, opticsTestAddressCity)
+
+	assert.Equal(t, "Taipei", userCity.Get(user))
+	moved := userCity.Set(user, "Tokyo")
+	assert.Equal(t, "Tokyo", moved.Address.City)
+	assert.Equal(t, "Taipei", user.Address.City)
+	assert.Equal(t, "TAIPEI!", userCity.Modify(user, func(city string) string {
+		return "TAIPEI!"
+	}).Address.City)
+}
+
+func TestPrismOptional(t *testing.T) {
+	just := PrismJust[string]()
+	phone, ok := just.GetOption(JustGenerics("123"))
+	assert.Equal(t, true, ok)
+	assert.Equal(t, "123", phone)
+	_, ok = just.GetOption(nil)
+	assert.Equal(t, false, ok)
+	assert.Equal(t, "456", just.ReverseGet("456").Unwrap())
+
+	ok2 := PrismOk[int]()
+	assert.Equal(t, 2, ok2.Modify(ResultOk(1), func(v int) int {
+		return v + 1
+	}).Unwrap())
+	failed := ResultErr[int](errors.New("failed"))
+	assert.Equal(t, failed, ok2.Set(failed, 1))
+	assert.Equal(t, failed, ok2.Modify(failed, func(v int) int {
+		return v + 1
+	}))
+
+	// Prism of Prism
+	nested := PrismCompose(PrismOk[MaybeDef[int]](), PrismJust[int]())
+	val, ok := nested.GetOption(ResultOk(JustGenerics(3)))
+	assert.Equal(t, true, ok)
+	assert.Equal(t, 3, val)
+	_, ok = nested.GetOption(ResultErr[MaybeDef[int]](errors.New("failed")))
+	assert.Equal(t, false, ok)
+	assert.Equal(t, 4, nested.ReverseGet(4).Unwrap().Unwrap())
+
+	// Lens + Prism
+	userPhone := OptionalCompose(opticsTestUserPhone.AsOptional(), just.AsOptional())
+	user := opticsTestUser{Phone: JustGenerics("123")}
+	updated := userPhone.Modify(user, func(phone string) string {
+		return "+886" + phone
+	})
+	phone, _ = userPhone.GetOption(updated)
+	assert.Equal(t, "+886123", phone)
+	phone, _ = userPhone.GetOption(user)
+	assert.Equal(t, "123", phone)
+	noPhone := opticsTestUser{Name: "no phone"}
+	assert.Equal(t, noPhone, userPhone.Set(noPhone, "123"))
+
+	// Index
+	firstTag := OptionalCompose(opticsTestUserTags.AsOptional(), OptionalIndex[string](0))
+	user.Tags = []string{"a", "b"}
+	updated = firstTag.Set(user, "z")
+	assert.Equal(t, []string{"z", "b"}, updated.Tags)
+	assert.Equal(t, []string{"a", "b"}, user.Tags)
+	assert.Equal(t, noPhone, firstTag.Set(noPhone, "z"))
+}
@@ -0,0 +1,124 @@
+package fpgo
+
+// Eq
+
+// Eq Equality of T values
+type Eq[T any] func(a T, b T) bool
+
+// EqComparable Eq by ==
+func EqComparable[T comparable]() Eq[T] {
+	return func(a T, b T) bool {
+		return a == b
+	}
+}
+
+// EqByKey Eq comparing the keys(by keyFn) by ==
+func EqByKey[T any, K comparable](keyFn func(T) K) Eq[T] {
+	return func(a T, b T) bool {
+		return keyFn(a) == keyFn(b)
+	}
+}
+
+// Equals Check the 2 values are equal or not
+func (eqSelf Eq[T]) Equals(a T, b T) bool {
+	return eqSelf(a, b)
+}
+
+// And Equal only if both Eq are satisfied
+func (eqSelf Eq[T]) And(other Eq[T]) Eq[T] {
+	return func(a T, b T) bool {
+		return eqSelf(a, b) && other(a, b)
+	}
+}
+
+// Ord
+
+// Ord Total ordering of T values(negative if a < b, 0 if a == b, positive if a > b)
+type Ord[T any] func(a T, b T) int
+
+// OrdOrdered Ord by the natural order of Ordered
+func OrdOrdered[T Ordered]() Ord[T] {
+	return func(a T, b T) int {
+		// NOTE: CompareToOrdered is positive if a < b
+		return -CompareToOrdered(a, b)
+	}
+}
+
+// OrdFromComparator Ord by a less function
+func OrdFromComparator[T any](less Comparator[T]) Ord[T] {
+	return func(a T, b T) int {
+		if less(a, b) {
+			return -1
+		} else if less(b, a) {
+			return 1
+		}
+		return 0
+	}
+}
+
+// OrdByKey Ord comparing the Ordered keys(by keyFn)
+func OrdByKey[T any, K Ordered](keyFn func(T) K) Ord[T] {
+	return OrdBy(keyFn, OrdOrdered[K]())
+}
+
+// OrdBy Ord comparing the keys(by keyFn) by the Ord of keys
+func OrdBy[T any, K any](keyFn func(T) K, ord Ord[K]) Ord[T] {
+	return func(a T, b T) int {
+		return ord(keyFn(a), keyFn(b))
+	}
+}
+
+// Compare Compare the 2 values
+func (ordSelf Ord[T]) Compare(a T, b T) int {
+	return ordSelf(a, b)
+}
+
+// Less Check a < b or not
+func (ordSelf Ord[T]) Less(a T, b T) bool {
+	return ordSelf(a, b) < 0
+}
+
+// Comparator Convert to a less function(Comparator)
+func (ordSelf Ord[T]) Comparator() Comparator[T] {
+	return ordSelf.Less
+}
+
+// Eq Convert to an Eq(equal if Compare() is 0)
+func (ordSelf Ord[T]) Eq() Eq[T] {
+	return func(a T, b T) bool {
+		return ordSelf(a, b) == 0
+	}
+}
+
+// Reversed Ord in the reversed order
+func (ordSelf Ord[T]) Reversed() Ord[T] {
+	return func(a T, b T) int {
+		return ordSelf(b, a)
+	}
+}
+
+// ThenBy Ord comparing by the next Ord if the values are equal by this one
+func (ordSelf Ord[T]) ThenBy(next Ord[T]) Ord[T] {
+	return func(a T, b T) int {
+		if result := ordSelf(a, b); result != 0 {
+			return result
+		}
+		return next(a, b)
+	}
+}
+
+// Min Get the smaller one(a if they're equal)
+func (ordSelf Ord[T]) Min(a T, b T) T {
+	if ordSelf(b, a) < 0 {
+		return b
+	}
+	return a
+}
+
+// Max Get the larger one(a if they're equal)
+func (ordSelf Ord[T]) Max(a T, b T) T {
+	if ordSelf(b, a) > 0 {
+		return b
+	}
+	return a
+}
@@ -0,0 +1,77 @@
+package fpgo
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type ordPerson struct {
+	name string
+	age  int
+}
+
+func TestOrd(t *testing.T) {
+	ints := OrdOrdered[int]()
+	assert.Equal(t, -1, ints.Compare(1, 2))
+	assert.Equal(t, 0, ints.Compare(2, 2))
+	assert.Equal(t, 1, ints.Compare(3, 2))
+	assert.Equal(t, true, ints.Less(1, 2))
+	assert.Equal(t, false, ints.Reversed().Less(1, 2))
+	assert.Equal(t, 1, ints.Min(1, 2))
+	assert.Equal(t, 2, ints.Max(1, 2))
+	assert.Equal(t, true, ints.Eq().Equals(2, 2))
+	assert.Equal(t, 1, OrdFromComparator(func(a, b int) bool {
+		return a < b
+	}).Compare(3, 2))
+
+	people := StreamFrom(
+		ordPerson{"carol", 30},
+		ordPerson{"alice", 30},
+		ordPerson{"bob", 20},
+		ordPerson{"Alice", 25},
+	)
+	byAge := OrdByKey(func(p ordPerson) int {
+		return p.age
+	})
+	byName := OrdByKey(func(p ordPerson) string {
+		return p.name
+	})
+	assert.Equal(t, []ordPerson{{"bob", 20}, {"Alice", 25}, {"alice", 30}, {"carol", 30}}, people.SortByOrd(byAge.ThenBy(byName)).ToArray())
+	assert.Equal(t, []ordPerson{{"alice", 30}, {"carol", 30}, {"Alice", 25}, {"bob", 20}}, people.SortByOrd(byAge.Reversed().ThenBy(byName)).ToArray())
+	// Stable
+	assert.Equal(t, []ordPerson{{"bob", 20}, {"Alice", 25}, {"carol", 30}, {"alice", 30}}, people.SortByOrd(byAge).ToArray())
+	assert.Equal(t, []ordPerson{{"carol", 30}, {"alice", 30}, {"bob", 20}, {"Alice", 25}}, people.ToArray())
+
+	val, ok := people.MinByOrd(byAge)
+	assert.Equal(t, ordPerson{"bob", 20}, val)
+	assert.Equal(t, true, ok)
+	val, ok = people.MaxByOrd(byName)
+	assert.Equal(t, ordPerson{"carol", 30}, val)
+	assert.Equal(t, true, ok)
+	_, ok = StreamFrom[ordPerson]().MaxByOrd(byName)
+	assert.Equal(t, false, ok)
+
+	// Eq
+	ignoreCase := EqByKey(func(p ordPerson) string {
+		return strings.ToLower(p.name)
+	})
+	assert.Equal(t, []ordPerson{{"carol", 30}, {"alice", 30}, {"bob", 20}}, people.DistinctByEq(ignoreCase).ToArray())
+	assert.Equal(t, []ordPerson{{"carol", 30}, {"bob", 20}, {"Alice", 25}}, people.DistinctByEq(byAge.Eq()).ToArray())
+	assert.Equal(t, 4, people.DistinctByEq(ignoreCase.And(byAge.Eq())).Len())
+	assert.Equal(t, []int{1, 2}, StreamFrom(1, 2, 1).DistinctByEq(EqComparable[int]()).ToArray())
+
+	// PriorityChannelQueue
+	queue := NewPriorityChannelQueueByOrd(0, byAge.Reversed())
+	for _, p := range *people {
+		assert.NoError(t, queue.Offer(p))
+	}
+	first, err := queue.Poll()
+	assert.NoError(t, err)
+	assert.Equal(t, 30, first.age)
+	last, _ := queue.Poll()
+	assert.Equal(t, 30, last.age)
+	last, _ = queue.Poll()
+	assert.Equal(t, ordPerson{"Alice", 25}, last)
+}
@@ -0,0 +1,219 @@
+package fpgo
+
+import (
+	"bytes"
+	"container/list"
+	"encoding/json"
+	"fmt"
+)
+
+// OrderedMap
+
+// OrderedMap Map preserving the insertion order of keys(not concurrency-safe, encoded as a JSON object in order)
+type OrderedMap[K comparable, V any] struct {
+	entries map[K]*list.Element
+	order   *list.List
+}
+
+// NewOrderedMap New OrderedMap instance
+func NewOrderedMap[K comparable, V any]() *OrderedMap[K, V] {
+	return &OrderedMap[K, V]{
+		entries: map[K]*list.Element{},
+		order:   list.New(),
+	}
+}
+
+// NewOrderedMapFromTuples New OrderedMap instance with the key-value pairs in order
+func NewOrderedMapFromTuples[K comparable, V any](entries ...Tuple2[K, V]) *OrderedMap[K, V] {
+	result := NewOrderedMap[K, V]()
+	for _, entry := range entries {
+		result.Set(entry.V1, entry.V2)
+	}
+	return result
+}
+
+// lazyInit Make the zero value usable
+func (mapSelf *OrderedMap[K, V]) lazyInit() {
+	if mapSelf.order == nil {
+		mapSelf.entries = map[K]*list.Element{}
+		mapSelf.order = list.New()
+	}
+}
+
+// Get Get the value of the key, false if it doesn't exist
+func (mapSelf *OrderedMap[K, V]) Get(key K) (V, bool) {
+	if element, ok := mapSelf.entries[key]; ok {
+		return element.Value.(Tuple2[K, V]).V2, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Has Check the key exists or not
+func (mapSelf *OrderedMap[K, V]) Has(key K) bool {
+	_, ok := mapSelf.entries[key]
+	return ok
+}
+
+// Set Set the value of the key(an existing key keeps its position)
+func (mapSelf *OrderedMap[K, V]) Set(key K, val V) *OrderedMap[K, V] {
+	mapSelf.lazyInit()
+	if element, ok := mapSelf.entries[key]; ok {
+		element.Value = NewTuple2(key, val)
+		return mapSelf
+	}
+	mapSelf.entries[key] = mapSelf.order.PushBack(NewTuple2(key, val))
+	return mapSelf
+}
+
+// Delete Delete the key, false if it doesn't exist
+func (mapSelf *OrderedMap[K, V]) Delete(key K) bool {
+	element, ok := mapSelf.entries[key]
+	if !ok {
+		return false
+	}
+	delete(mapSelf.entries, key)
+	mapSelf.order.Remove(element)
+	return true
+}
+
+// Len Get the number of keys
+func (mapSelf *OrderedMap[K, V]) Len() int {
+	return len(mapSelf.entries)
+}
+
+// ForEach Call fn with each key-value pair in order until fn returns false
+func (mapSelf *OrderedMap[K, V]) ForEach(fn func(K, V) bool) {
+	if mapSelf.order == nil {
+		return
+	}
+	for element := mapSelf.order.Front(); element != nil; element = element.Next() {
+		entry := element.Value.(Tuple2[K, V])
+		if !fn(entry.V1, entry.V2) {
+			return
+		}
+	}
+}
+
+// Keys Get the keys in order
+func (mapSelf *OrderedMap[K, V]) Keys() []K {
+	result := make([]K, 0, mapSelf.Len())
+	mapSelf.ForEach(func(key K, _ V) bool {
+		result = append(result, key)
+		return true
+	})
+	return result
+}
+
+// Values Get the values in order
+func (mapSelf *OrderedMap[K, V]) Values() []V {
+	result := make([]V, 0, mapSelf.Len())
+	mapSelf.ForEach(func(_ K, val V) bool {
+		result = append(result, val)
+		return true
+	})
+	return result
+}
+
+// Entries Get the key-value pairs in order
+func (mapSelf *OrderedMap[K, V]) Entries() []Tuple2[K, V] {
+	result := make([]Tuple2[K, V], 0, mapSelf.Len())
+	mapSelf.ForEach(func(key K, val V) bool {
+		result = append(result, NewTuple2(key, val))
+		return true
+	})
+	return result
+}
+
+// KeyStream Convert the keys to Stream in order
+func (mapSelf *OrderedMap[K, V]) KeyStream() *StreamDef[K] {
+	return StreamFromArray(mapSelf.Keys())
+}
+
+// OrderedMapToStream Convert the key-value pairs of the OrderedMap to Stream in order
+func OrderedMapToStream[K comparable, V comparable](orderedMap *OrderedMap[K, V]) *StreamDef[Tuple2[K, V]] {
+	return StreamFromArray(orderedMap.Entries())
+}
+
+// MarshalJSON Encode the OrderedMap as a JSON object in order(keys are encoded like map keys of encoding/json)
+func (mapSelf *OrderedMap[K, V]) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	var err error
+	i := 0
+	mapSelf.ForEach(func(key K, val V) bool {
+		var keyData, valData []byte
+		if keyData, err = marshalOrderedMapKey(key); err != nil {
+			return false
+		}
+		if valData, err = json.Marshal(val); err != nil {
+			return false
+		}
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.Write(keyData)
+		buf.WriteByte(':')
+		buf.Write(valData)
+		i++
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON Decode the OrderedMap from a JSON object in order(replacing the existing entries)
+func (mapSelf *OrderedMap[K, V]) UnmarshalJSON(data []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '{' {
+		return fmt.Errorf("orderedMap: expected a JSON object but got %v", token)
+	}
+
+	mapSelf.entries = map[K]*list.Element{}
+	mapSelf.order = list.New()
+	for decoder.More() {
+		token, err = decoder.Token()
+		if err != nil {
+			return err
+		}
+		var key K
+		if err = unmarshalOrderedMapKey(token.(string), &key); err != nil {
+			return err
+		}
+		var val V
+		if err = decoder.Decode(&val); err != nil {
+			return err
+		}
+		mapSelf.Set(key, val)
+	}
+	_, err = decoder.Token()
+	return err
+}
+
+// marshalOrderedMapKey Encode the key as a JSON string(non-string keys like numbers are quoted)
+func marshalOrderedMapKey(key interface{}) ([]byte, error) {
+	data, err := json.Marshal(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > 0 && data[0] == '"' {
+		return data, nil
+	}
+	return json.Marshal(string(data))
+}
+
+// unmarshalOrderedMapKey Decode the JSON string key into the key pointer(the unquoted form is tried for non-string keys)
+func unmarshalOrderedMapKey(key string, keyPtr interface{}) error {
+	quoted, _ := json.Marshal(key)
+	if json.Unmarshal(quoted, keyPtr) == nil {
+		return nil
+	}
+	return json.Unmarshal([]byte(key), keyPtr)
+}
@@ -0,0 +1,14 @@
+//go:build go1.23
+
+package fpgo
+
+import "iter"
+
+// OrderedMap Iterators
+
+// Iter Iterate the key-value pairs of the OrderedMap in order
+func (mapSelf *OrderedMap[K, V]) Iter() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		mapSelf.ForEach(yield)
+	}
+}
@@ -0,0 +1,18 @@
+//go:build go1.23
+
+package fpgo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderedMapIter(t *testing.T) {
+	keys := []string{}
+	NewOrderedMap[string, int]().Set("b", 1).Set("a", 2).Set("c", 3).Iter()(func(key string, val int) bool {
+		keys = append(keys, key)
+		return val < 2
+	})
+	assert.Equal(t, []string{"b", "a"}, keys)
+}
@@ -0,0 +1,79 @@
+package fpgo
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderedMap(t *testing.T) {
+	orderedMap := NewOrderedMap[string, int]()
+	orderedMap.Set("c", 1).Set("a", 2).Set("b", 3)
+	assert.Equal(t, 3, orderedMap.Len())
+	assert.Equal(t, []string{"c", "a", "b"}, orderedMap.Keys())
+	assert.Equal(t, []int{1, 2, 3}, orderedMap.Values())
+
+	val, ok := orderedMap.Get("a")
+	assert.Equal(t, true, ok)
+	assert.Equal(t, 2, val)
+	_, ok = orderedMap.Get("z")
+	assert.Equal(t, false, ok)
+	assert.Equal(t, false, orderedMap.Has("z"))
+
+	// An existing key keeps its position
+	orderedMap.Set("c", 10)
+	assert.Equal(t, []Tuple2[string, int]{NewTuple2("c", 10), NewTuple2("a", 2), NewTuple2("b", 3)}, orderedMap.Entries())
+	assert.Equal(t, true, orderedMap.Delete("c"))
+	assert.Equal(t, false, orderedMap.Delete("c"))
+	orderedMap.Set("c", 1)
+	assert.Equal(t, []string{"a", "b", "c"}, orderedMap.Keys())
+
+	// Break the loop
+	visited := []string{}
+	orderedMap.ForEach(func(key string, val int) bool {
+		visited = append(visited, key)
+		return val < 3
+	})
+	assert.Equal(t, []string{"a", "b"}, visited)
+
+	// Stream
+	assert.Equal(t, []string{"a", "b", "c"}, orderedMap.KeyStream().ToArray())
+	assert.Equal(t, NewTuple2("a", 2), OrderedMapToStream(orderedMap).Get(0))
+	assert.Equal(t, []int{1, 2}, NewOrderedMapFromTuples(NewTuple2(1, "x"), NewTuple2(2, "y"), NewTuple2(1, "z")).Keys())
+
+	// The zero value is usable
+	var zero OrderedMap[int, int]
+	assert.Equal(t, []int{}, zero.Keys())
+	zero.Set(1, 1)
+	assert.Equal(t, []int{1}, zero.Keys())
+}
+
+func TestOrderedMapJSON(t *testing.T) {
+	orderedMap := NewOrderedMap[string, []int]().Set("z", []int{1}).Set("a", nil).Set("m", []int{})
+	data, err := json.Marshal(orderedMap)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"z":[1],"a":null,"m":[]}`, string(data))
+
+	decoded := NewOrderedMap[string, []int]()
+	assert.NoError(t, json.Unmarshal([]byte(`{"y":[2],"b":[3],"y":[4]}`), decoded))
+	assert.Equal(t, []Tuple2[string, []int]{NewTuple2("y", []int{4}), NewTuple2("b", []int{3})}, decoded.Entries())
+
+	// Non-string keys
+	intKeys := NewOrderedMapFromTuples(NewTuple2(3, "c"), NewTuple2(1, "a"))
+	data, err = json.Marshal(intKeys)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"3":"c","1":"a"}`, string(data))
+	decodedIntKeys := NewOrderedMap[int, string]()
+	assert.NoError(t, json.Unmarshal(data, decodedIntKeys))
+	assert.Equal(t, intKeys.Entries(), decodedIntKeys.Entries())
+
+	// Nested
+	nested := NewOrderedMap[string, *OrderedMap[string, int]]().Set("b", NewOrderedMap[string, int]().Set("y", 1).Set("x", 2))
+	data, err = json.Marshal(nested)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"b":{"y":1,"x":2}}`, string(data))
+
+	assert.Error(t, json.Unmarshal([]byte(`[1]`), decoded))
+	assert.Error(t, json.Unmarshal([]byte(`{"a":"b"}`), decodedIntKeys))
+}
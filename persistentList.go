@@ -0,0 +1,116 @@
+package fpgo
+
+// PersistentList
+
+// PersistentList Immutable singly linked(cons) list sharing the tails between versions(nil is the empty list)
+type PersistentList[T any] struct {
+	head T
+	tail *PersistentList[T]
+	size int
+}
+
+// PersistentListFrom New PersistentList instance with the values in order
+func PersistentListFrom[T any](values ...T) *PersistentList[T] {
+	var result *PersistentList[T]
+	for i := len(values) - 1; i >= 0; i-- {
+		result = result.Push(values[i])
+	}
+	return result
+}
+
+// Push New version with the value prepended(O(1))
+func (listSelf *PersistentList[T]) Push(val T) *PersistentList[T] {
+	return &PersistentList[T]{head: val, tail: listSelf, size: listSelf.Len() + 1}
+}
+
+// Pop New version without the first value(O(1), the empty list is still empty)
+func (listSelf *PersistentList[T]) Pop() *PersistentList[T] {
+	if listSelf == nil {
+		return nil
+	}
+	return listSelf.tail
+}
+
+// Head Get the first value, false if the list is empty
+func (listSelf *PersistentList[T]) Head() (T, bool) {
+	if listSelf == nil {
+		var zero T
+		return zero, false
+	}
+	return listSelf.head, true
+}
+
+// IsEmpty Check the list is empty or not
+func (listSelf *PersistentList[T]) IsEmpty() bool {
+	return listSelf == nil
+}
+
+// Len Get the number of values(O(1))
+func (listSelf *PersistentList[T]) Len() int {
+	if listSelf == nil {
+		return 0
+	}
+	return listSelf.size
+}
+
+// Get Get the i-th value(O(i), panic if it's out of range like slices)
+func (listSelf *PersistentList[T]) Get(i int) T {
+	return listSelf.nodeAt(i).head
+}
+
+// Set New version with the i-th value replaced(O(i), the values after i are shared)
+func (listSelf *PersistentList[T]) Set(i int, val T) *PersistentList[T] {
+	node := listSelf.nodeAt(i)
+	prefix := make([]T, 0, i)
+	for current := listSelf; current != node; current = current.tail {
+		prefix = append(prefix, current.head)
+	}
+
+	result := node.tail.Push(val)
+	for j := len(prefix) - 1; j >= 0; j-- {
+		result = result.Push(prefix[j])
+	}
+	return result
+}
+
+// nodeAt Get the node of the i-th value
+func (listSelf *PersistentList[T]) nodeAt(i int) *PersistentList[T] {
+	if i < 0 || i >= listSelf.Len() {
+		panic("persistentList: index out of range")
+	}
+	node := listSelf
+	for ; i > 0; i-- {
+		node = node.tail
+	}
+	return node
+}
+
+// Reverse New version with the values in reverse order(O(n))
+func (listSelf *PersistentList[T]) Reverse() *PersistentList[T] {
+	var result *PersistentList[T]
+	for current := listSelf; current != nil; current = current.tail {
+		result = result.Push(current.head)
+	}
+	return result
+}
+
+// ForEach Call fn with each value in order
+func (listSelf *PersistentList[T]) ForEach(fn func(T)) {
+	for current := listSelf; current != nil; current = current.tail {
+		fn(current.head)
+	}
+}
+
+// ToSlice Convert the list to a new slice
+func (listSelf *PersistentList[T]) ToSlice() []T {
+	result := make([]T, 0, listSelf.Len())
+	listSelf.ForEach(func(val T) {
+		result = append(result, val)
+	})
+	return result
+}
+
+// PersistentListToStream Convert the PersistentList to Stream
+func PersistentListToStream[T comparable](list *PersistentList[T]) *StreamDef[T] {
+	return StreamFromArray(list.ToSlice())
+}
@@ -0,0 +1,45 @@
+package fpgo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPersistentList(t *testing.T) {
+	var empty *PersistentList[int]
+	assert.Equal(t, true, empty.IsEmpty())
+	assert.Equal(t, 0, empty.Len())
+	assert.Equal(t, []int{}, empty.ToSlice())
+	_, ok := empty.Head()
+	assert.Equal(t, false, ok)
+	assert.Nil(t, empty.Pop())
+
+	list := PersistentListFrom(1, 2, 3)
+	assert.Equal(t, 3, list.Len())
+	assert.Equal(t, []int{1, 2, 3}, list.ToSlice())
+	head, ok := list.Head()
+	assert.Equal(t, true, ok)
+	assert.Equal(t, 1, head)
+	assert.Equal(t, 2, list.Get(1))
+
+	// Versions share the tails
+	pushed := list.Push(0)
+	popped := list.Pop()
+	set := list.Set(1, 20)
+	assert.Equal(t, []int{0, 1, 2, 3}, pushed.ToSlice())
+	assert.Equal(t, []int{2, 3}, popped.ToSlice())
+	assert.Equal(t, []int{1, 20, 3}, set.ToSlice())
+	assert.Equal(t, []int{1, 2, 3}, list.ToSlice())
+	assert.Same(t, list, pushed.Pop())
+	assert.Same(t, list.Pop().Pop(), set.Pop().Pop())
+
+	assert.Equal(t, []int{3, 2, 1}, list.Reverse().ToSlice())
+	assert.Equal(t, []int{1, 2, 3}, PersistentListToStream(list).ToArray())
+	assert.Panics(t, func() {
+		list.Get(3)
+	})
+	assert.Panics(t, func() {
+		list.Set(-1, 0)
+	})
+}
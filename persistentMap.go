@@ -0,0 +1,529 @@
+package fpgo
+
+import (
+	"hash/fnv"
+	"math"
+	"math/bits"
+	"reflect"
+)
+
+// PersistentMap
+
+const (
+	persistentMapBits = 5
+	persistentMapMask = 1<<persistentMapBits - 1
+)
+
+// persistentMapOwner Identity of a TransientMap, nodes owned by it could be edited in place
+type persistentMapOwner struct {
+	// Non-zero size, pointers to zero-size values may be equal
+	_ int
+}
+
+// persistentMapEntry A key-value pair(leaf) or a sub-trie(child, the hash is kept for a collision node)
+type persistentMapEntry[K comparable, V any] struct {
+	hash  uint64
+	key   K
+	val   V
+	child *persistentMapNode[K, V]
+}
+
+// persistentMapNode Bitmap indexed node of the trie(or a collision node of leaves with the same hash)
+type persistentMapNode[K comparable, V any] struct {
+	owner     *persistentMapOwner
+	bitmap    uint32
+	collision bool
+	entries   []persistentMapEntry[K, V]
+}
+
+// PersistentMap Immutable hash map by a hash array mapped trie(HAMT), versions share the unchanged nodes
+//
+// NOTE: the iteration order is unspecified.
+type PersistentMap[K comparable, V any] struct {
+	root   *persistentMapNode[K, V]
+	size   int
+	hasher func(K) uint64
+}
+
+// NewPersistentMap New empty PersistentMap instance with the default hasher
+func NewPersistentMap[K comparable, V any]() *PersistentMap[K, V] {
+	return NewPersistentMapWithHasher[K, V](nil)
+}
+
+// NewPersistentMapWithHasher New empty PersistentMap instance with the hasher(keys equal by == must have the same hash)
+func NewPersistentMapWithHasher[K comparable, V any](hasher func(K) uint64) *PersistentMap[K, V] {
+	if hasher == nil {
+		hasher = defaultPersistentMapHash[K]
+	}
+	return &PersistentMap[K, V]{hasher: hasher}
+}
+
+// PersistentMapFrom New PersistentMap instance with the entries of the map
+func PersistentMapFrom[K comparable, V any](theMap map[K]V) *PersistentMap[K, V] {
+	transient := NewPersistentMap[K, V]().AsTransient()
+	for k, v := range theMap {
+		transient.Set(k, v)
+	}
+	return transient.Persistent()
+}
+
+// Len Get the number of keys
+func (mapSelf *PersistentMap[K, V]) Len() int {
+	return mapSelf.size
+}
+
+// Get Get the value of the key, false if it doesn't exist
+func (mapSelf *PersistentMap[K, V]) Get(key K) (V, bool) {
+	if mapSelf.root == nil {
+		var zero V
+		return zero, false
+	}
+	return mapSelf.root.get(mapSelf.hasher(key), 0, key)
+}
+
+// Has Check the key exists or not
+func (mapSelf *PersistentMap[K, V]) Has(key K) bool {
+	_, ok := mapSelf.Get(key)
+	return ok
+}
+
+// Set New version with the value of the key
+func (mapSelf *PersistentMap[K, V]) Set(key K, val V) *PersistentMap[K, V] {
+	root, added := persistentMapAssoc(mapSelf.root, nil, mapSelf.hasher(key), 0, key, val)
+	result := &PersistentMap[K, V]{root: root, size: mapSelf.size, hasher: mapSelf.hasher}
+	if added {
+		result.size++
+	}
+	return result
+}
+
+// Delete New version without the key(the same one if the key doesn't exist)
+func (mapSelf *PersistentMap[K, V]) Delete(key K) *PersistentMap[K, V] {
+	if mapSelf.root == nil {
+		return mapSelf
+	}
+	root, removed := mapSelf.root.dissoc(nil, mapSelf.hasher(key), 0, key)
+	if !removed {
+		return mapSelf
+	}
+	return &PersistentMap[K, V]{root: root, size: mapSelf.size - 1, hasher: mapSelf.hasher}
+}
+
+// ForEach Call fn with each key-value pair until fn returns false
+func (mapSelf *PersistentMap[K, V]) ForEach(fn func(K, V) bool) {
+	if mapSelf.root != nil {
+		mapSelf.root.forEach(fn)
+	}
+}
+
+// Keys Get the keys(unordered)
+func (mapSelf *PersistentMap[K, V]) Keys() []K {
+	result := make([]K, 0, mapSelf.size)
+	mapSelf.ForEach(func(key K, _ V) bool {
+		result = append(result, key)
+		return true
+	})
+	return result
+}
+
+// ToMap Convert the PersistentMap to a new map
+func (mapSelf *PersistentMap[K, V]) ToMap() map[K]V {
+	result := make(map[K]V, mapSelf.size)
+	mapSelf.ForEach(func(key K, val V) bool {
+		result[key] = val
+		return true
+	})
+	return result
+}
+
+// AsTransient New TransientMap from this version for bulk updates(this version is unchanged)
+func (mapSelf *PersistentMap[K, V]) AsTransient() *TransientMap[K, V] {
+	return &TransientMap[K, V]{
+		owner:  &persistentMapOwner{},
+		root:   mapSelf.root,
+		size:   mapSelf.size,
+		hasher: mapSelf.hasher,
+	}
+}
+
+// TransientMap
+
+// TransientMap Mutable builder of PersistentMap editing its own nodes in place(not concurrency-safe)
+type TransientMap[K comparable, V any] struct {
+	owner  *persistentMapOwner
+	root   *persistentMapNode[K, V]
+	size   int
+	hasher func(K) uint64
+}
+
+// ensureEditable Panic if Persistent() has been called
+func (transientSelf *TransientMap[K, V]) ensureEditable() {
+	if transientSelf.owner == nil {
+		panic("transientMap: used after Persistent()")
+	}
+}
+
+// Len Get the number of keys
+func (transientSelf *TransientMap[K, V]) Len() int {
+	return transientSelf.size
+}
+
+// Get Get the value of the key, false if it doesn't exist
+func (transientSelf *TransientMap[K, V]) Get(key K) (V, bool) {
+	if transientSelf.root == nil {
+		var zero V
+		return zero, false
+	}
+	return transientSelf.root.get(transientSelf.hasher(key), 0, key)
+}
+
+// Set Set the value of the key in place
+func (transientSelf *TransientMap[K, V]) Set(key K, val V) *TransientMap[K, V] {
+	transientSelf.ensureEditable()
+	root, added := persistentMapAssoc(transientSelf.root, transientSelf.owner, transientSelf.hasher(key), 0, key, val)
+	transientSelf.root = root
+	if added {
+		transientSelf.size++
+	}
+	return transientSelf
+}
+
+// Delete Delete the key in place
+func (transientSelf *TransientMap[K, V]) Delete(key K) *TransientMap[K, V] {
+	transientSelf.ensureEditable()
+	if transientSelf.root == nil {
+		return transientSelf
+	}
+	root, removed := transientSelf.root.dissoc(transientSelf.owner, transientSelf.hasher(key), 0, key)
+	transientSelf.root = root
+	if removed {
+		transientSelf.size--
+	}
+	return transientSelf
+}
+
+// Persistent Get the PersistentMap of the result, the TransientMap can't be used anymore
+func (transientSelf *TransientMap[K, V]) Persistent() *PersistentMap[K, V] {
+	transientSelf.ensureEditable()
+	transientSelf.owner = nil
+	return &PersistentMap[K, V]{root: transientSelf.root, size: transientSelf.size, hasher: transientSelf.hasher}
+}
+
+// Trie Nodes
+
+// editable Get the node itself if it's owned by the owner, or a copy owned by the owner
+func (nodeSelf *persistentMapNode[K, V]) editable(owner *persistentMapOwner) *persistentMapNode[K, V] {
+	if owner != nil && nodeSelf.owner == owner {
+		return nodeSelf
+	}
+	entries := make([]persistentMapEntry[K, V], len(nodeSelf.entries), len(nodeSelf.entries)+1)
+	copy(entries, nodeSelf.entries)
+	return &persistentMapNode[K, V]{
+		owner:     owner,
+		bitmap:    nodeSelf.bitmap,
+		collision: nodeSelf.collision,
+		entries:   entries,
+	}
+}
+
+// index Get the bit & the entry index of the hash at the shift
+func (nodeSelf *persistentMapNode[K, V]) index(hash uint64, shift uint) (uint32, int) {
+	bit := uint32(1) << ((hash >> shift) & persistentMapMask)
+	return bit, bits.OnesCount32(nodeSelf.bitmap & (bit - 1))
+}
+
+// collisionIndex Get the entry index of the key in the collision node, -1 if it doesn't exist
+func (nodeSelf *persistentMapNode[K, V]) collisionIndex(key K) int {
+	for i, entry := range nodeSelf.entries {
+		if entry.key == key {
+			return i
+		}
+	}
+	return -1
+}
+
+func (nodeSelf *persistentMapNode[K, V]) get(hash uint64, shift uint, key K) (V, bool) {
+	var zero V
+	for node := nodeSelf; ; shift += persistentMapBits {
+		if node.collision {
+			if i := node.collisionIndex(key); i >= 0 {
+				return node.entries[i].val, true
+			}
+			return zero, false
+		}
+
+		bit, i := node.index(hash, shift)
+		if node.bitmap&bit == 0 {
+			return zero, false
+		}
+		entry := node.entries[i]
+		if entry.child == nil {
+			if entry.key == key {
+				return entry.val, true
+			}
+			return zero, false
+		}
+		node = entry.child
+	}
+}
+
+// persistentMapAssoc Set the key-value pair into the node(nil for an empty node), true if the key is added
+func persistentMapAssoc[K comparable, V any](node *persistentMapNode[K, V], owner *persistentMapOwner, hash uint64, shift uint, key K, val V) (*persistentMapNode[K, V], bool) {
+	if node == nil {
+		return &persistentMapNode[K, V]{
+			owner:   owner,
+			bitmap:  uint32(1) << ((hash >> shift) & persistentMapMask),
+			entries: []persistentMapEntry[K, V]{{hash: hash, key: key, val: val}},
+		}, true
+	}
+
+	if node.collision {
+		if collisionHash := node.entries[0].hash; hash != collisionHash {
+			// Not colliding with them, branch out
+			return newPersistentMapBranch(owner, shift, persistentMapEntry[K, V]{hash: collisionHash, child: node}, persistentMapEntry[K, V]{hash: hash, key: key, val: val}), true
+		}
+
+		result := node.editable(owner)
+		if i := node.collisionIndex(key); i >= 0 {
+			result.entries[i].val = val
+			return result, false
+		}
+		result.entries = append(result.entries, persistentMapEntry[K, V]{hash: hash, key: key, val: val})
+		return result, true
+	}
+
+	bit, i := node.index(hash, shift)
+	if node.bitmap&bit == 0 {
+		result := node.editable(owner)
+		result.bitmap |= bit
+		result.entries = append(result.entries, persistentMapEntry[K, V]{})
+		copy(result.entries[i+1:], result.entries[i:])
+		result.entries[i] = persistentMapEntry[K, V]{hash: hash, key: key, val: val}
+		return result, true
+	}
+
+	entry := node.entries[i]
+	if entry.child != nil {
+		child, added := persistentMapAssoc(entry.child, owner, hash, shift+persistentMapBits, key, val)
+		if child == entry.child {
+			return node, added
+		}
+		result := node.editable(owner)
+		result.entries[i].child = child
+		return result, added
+	}
+
+	result := node.editable(owner)
+	if entry.key == key {
+		result.entries[i].val = val
+		return result, false
+	}
+	result.entries[i] = persistentMapEntry[K, V]{child: newPersistentMapBranch(owner, shift+persistentMapBits, entry, persistentMapEntry[K, V]{hash: hash, key: key, val: val})}
+	return result, true
+}
+
+// newPersistentMapBranch New node containing the 2 leaves of different keys
+func newPersistentMapBranch[K comparable, V any](owner *persistentMapOwner, shift uint, entry1 persistentMapEntry[K, V], entry2 persistentMapEntry[K, V]) *persistentMapNode[K, V] {
+	if shift >= 64 || entry1.hash == entry2.hash {
+		return &persistentMapNode[K, V]{owner: owner, collision: true, entries: []persistentMapEntry[K, V]{entry1, entry2}}
+	}
+
+	index1 := (entry1.hash >> shift) & persistentMapMask
+	index2 := (entry2.hash >> shift) & persistentMapMask
+	if index1 == index2 {
+		return &persistentMapNode[K, V]{
+			owner:   owner,
+			bitmap:  uint32(1) << index1,
+			entries: []persistentMapEntry[K, V]{{child: newPersistentMapBranch(owner, shift+persistentMapBits, entry1, entry2)}},
+		}
+	}
+	if index1 > index2 {
+		entry1, entry2 = entry2, entry1
+	}
+	return &persistentMapNode[K, V]{
+		owner:   owner,
+		bitmap:  uint32(1)<<index1 | uint32(1)<<index2,
+		entries: []persistentMapEntry[K, V]{entry1, entry2},
+	}
+}
+
+// dissoc Remove the key from the node(nil if it becomes empty), true if the key is removed
+func (nodeSelf *persistentMapNode[K, V]) dissoc(owner *persistentMapOwner, hash uint64, shift uint, key K) (*persistentMapNode[K, V], bool) {
+	if nodeSelf.collision {
+		i := nodeSelf.collisionIndex(key)
+		if i < 0 {
+			return nodeSelf, false
+		}
+		if len(nodeSelf.entries) == 1 {
+			return nil, true
+		}
+		result := nodeSelf.editable(owner)
+		result.entries = append(result.entries[:i], result.entries[i+1:]...)
+		return result, true
+	}
+
+	bit, i := nodeSelf.index(hash, shift)
+	if nodeSelf.bitmap&bit == 0 {
+		return nodeSelf, false
+	}
+
+	entry := nodeSelf.entries[i]
+	if entry.child != nil {
+		child, removed := entry.child.dissoc(owner, hash, shift+persistentMapBits, key)
+		if !removed {
+			return nodeSelf, false
+		}
+		if child != nil {
+			result := nodeSelf.editable(owner)
+			// Pull the only leaf up
+			if len(child.entries) == 1 && child.entries[0].child == nil {
+				result.entries[i] = child.entries[0]
+			} else {
+				result.entries[i].child = child
+			}
+			return result, true
+		}
+	} else if entry.key != key {
+		return nodeSelf, false
+	}
+
+	if len(nodeSelf.entries) == 1 {
+		return nil, true
+	}
+	result := nodeSelf.editable(owner)
+	result.bitmap &^= bit
+	result.entries = append(result.entries[:i], result.entries[i+1:]...)
+	return result, true
+}
+
+// forEach Call fn with each key-value pair until fn returns false
+func (nodeSelf *persistentMapNode[K, V]) forEach(fn func(K, V) bool) bool {
+	for _, entry := range nodeSelf.entries {
+		if entry.child != nil {
+			if !entry.child.forEach(fn) {
+				return false
+			}
+		} else if !fn(entry.key, entry.val) {
+			return false
+		}
+	}
+	return true
+}
+
+// Hashing
+
+// defaultPersistentMapHash Hash the key by its type(consistent with ==, see hashPersistentMapValue() for other types)
+func defaultPersistentMapHash[K comparable](key K) uint64 {
+	switch k := interface{}(key).(type) {
+	case string:
+		return hashPersistentMapString(k)
+	case int:
+		return mixPersistentMapHash(uint64(k))
+	case int8:
+		return mixPersistentMapHash(uint64(k))
+	case int16:
+		return mixPersistentMapHash(uint64(k))
+	case int32:
+		return mixPersistentMapHash(uint64(k))
+	case int64:
+		return mixPersistentMapHash(uint64(k))
+	case uint:
+		return mixPersistentMapHash(uint64(k))
+	case uint8:
+		return mixPersistentMapHash(uint64(k))
+	case uint16:
+		return mixPersistentMapHash(uint64(k))
+	case uint32:
+		return mixPersistentMapHash(uint64(k))
+	case uint64:
+		return mixPersistentMapHash(k)
+	case uintptr:
+		return mixPersistentMapHash(uint64(k))
+	case float32:
+		return hashPersistentMapFloat(float64(k))
+	case float64:
+		return hashPersistentMapFloat(k)
+	case bool:
+		if k {
+			return mixPersistentMapHash(1)
+		}
+		return mixPersistentMapHash(0)
+	}
+	return hashPersistentMapValue(reflect.ValueOf(key))
+}
+
+// hashPersistentMapValue Hash the value consistently with ==(pointers & channels by addresses, structs & arrays field by field)
+func hashPersistentMapValue(value reflect.Value) uint64 {
+	// nil interface
+	if !value.IsValid() {
+		return mixPersistentMapHash(0)
+	}
+
+	switch value.Kind() {
+	case reflect.String:
+		return hashPersistentMapString(value.String())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return mixPersistentMapHash(uint64(value.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return mixPersistentMapHash(value.Uint())
+	case reflect.Float32, reflect.Float64:
+		return hashPersistentMapFloat(value.Float())
+	case reflect.Complex64, reflect.Complex128:
+		c := value.Complex()
+		return combinePersistentMapHash(hashPersistentMapFloat(real(c)), hashPersistentMapFloat(imag(c)))
+	case reflect.Bool:
+		if value.Bool() {
+			return mixPersistentMapHash(1)
+		}
+		return mixPersistentMapHash(0)
+	case reflect.Ptr, reflect.Chan, reflect.UnsafePointer:
+		// Equal by the identity, not by the pointed contents
+		return mixPersistentMapHash(uint64(value.Pointer()))
+	case reflect.Interface:
+		return hashPersistentMapValue(value.Elem())
+	case reflect.Struct:
+		hash := mixPersistentMapHash(uint64(value.NumField()))
+		for i := 0; i < value.NumField(); i++ {
+			hash = combinePersistentMapHash(hash, hashPersistentMapValue(value.Field(i)))
+		}
+		return hash
+	case reflect.Array:
+		hash := mixPersistentMapHash(uint64(value.Len()))
+		for i := 0; i < value.Len(); i++ {
+			hash = combinePersistentMapHash(hash, hashPersistentMapValue(value.Index(i)))
+		}
+		return hash
+	}
+
+	// Not comparable(func/map/slice), unreachable for comparable keys
+	return mixPersistentMapHash(0)
+}
+
+// combinePersistentMapHash Combine the hash of the next field into the hash
+func combinePersistentMapHash(hash uint64, next uint64) uint64 {
+	return mixPersistentMapHash(hash*31 + next)
+}
+
+func hashPersistentMapString(key string) uint64 {
+	hasher := fnv.New64a()
+	hasher.Write([]byte(key))
+	return mixPersistentMapHash(hasher.Sum64())
+}
+
+func hashPersistentMapFloat(key float64) uint64 {
+	// -0 == +0
+	if key == 0 {
+		key = 0
+	}
+	return mixPersistentMapHash(math.Float64bits(key))
+}
+
+// mixPersistentMapHash The finalizer of SplitMix64 spreading the bits
+func mixPersistentMapHash(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
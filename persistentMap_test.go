@@ -0,0 +1,174 @@
+package fpgo
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPersistentMap(t *testing.T) {
+	empty := NewPersistentMap[string, int]()
+	assert.Equal(t, 0, empty.Len())
+	_, ok := empty.Get("a")
+	assert.Equal(t, false, ok)
+	assert.Same(t, empty, empty.Delete("a"))
+
+	m1 := empty.Set("a", 1).Set("b", 2)
+	m2 := m1.Set("a", 10).Set("c", 3)
+	m3 := m2.Delete("b")
+	assert.Equal(t, map[string]int{"a": 1, "b": 2}, m1.ToMap())
+	assert.Equal(t, map[string]int{"a": 10, "b": 2, "c": 3}, m2.ToMap())
+	assert.Equal(t, map[string]int{"a": 10, "c": 3}, m3.ToMap())
+	assert.Equal(t, 2, m3.Len())
+	assert.Equal(t, true, m3.Has("c"))
+	assert.Equal(t, false, m3.Has("b"))
+	assert.Same(t, m3, m3.Delete("z"))
+	assert.Equal(t, []string{"a", "c"}, SortOrderedAscending(m3.Keys()...))
+
+	// Break the loop
+	visited := 0
+	m2.ForEach(func(string, int) bool {
+		visited++
+		return false
+	})
+	assert.Equal(t, 1, visited)
+
+	// Other key types
+	assert.Equal(t, 1, PersistentMapFrom(map[float64]int{0: 1}).ToMap()[0])
+	type point struct{ X, Y int }
+	points := NewPersistentMap[point, string]().Set(point{1, 2}, "a").Set(point{2, 1}, "b")
+	val, _ := points.Get(point{1, 2})
+	assert.Equal(t, "a", val)
+
+	// Structs/arrays by fields, -0 == +0
+	type vector struct {
+		X, Y float64
+		Tag  string
+	}
+	negativeZero := math.Copysign(0, -1)
+	vectors := NewPersistentMap[vector, int]().Set(vector{X: negativeZero, Tag: "a"}, 1)
+	val2, ok := vectors.Get(vector{X: 0, Tag: "a"})
+	assert.Equal(t, true, ok)
+	assert.Equal(t, 1, val2)
+	assert.Equal(t, 1, vectors.Set(vector{Tag: "a"}, 2).Len())
+	arrays := NewPersistentMap[[2]float64, int]().Set([2]float64{negativeZero, 1}, 1)
+	assert.Equal(t, true, arrays.Has([2]float64{0, 1}))
+}
+
+func TestPersistentMapPointerKey(t *testing.T) {
+	type item struct{ Name string }
+	p1, p2 := &item{"a"}, &item{"a"}
+	m := NewPersistentMap[*item, int]().Set(p1, 1).Set(p2, 2)
+	// By the identity
+	assert.Equal(t, 2, m.Len())
+
+	// Mutating the pointed value doesn't change the key
+	p1.Name = "changed"
+	val, ok := m.Get(p1)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, 1, val)
+	m = m.Set(p1, 10)
+	assert.Equal(t, 2, m.Len())
+	val, _ = m.Get(p1)
+	assert.Equal(t, 10, val)
+	m = m.Delete(p1)
+	assert.Equal(t, false, m.Has(p1))
+	assert.Equal(t, true, m.Has(p2))
+
+	// Channels & pointers inside structs
+	type handle struct {
+		ch  chan int
+		ptr *item
+	}
+	ch := make(chan int)
+	handles := NewPersistentMap[handle, int]().Set(handle{ch, p2}, 1)
+	p2.Name = "changed"
+	assert.Equal(t, true, handles.Has(handle{ch, p2}))
+	assert.Equal(t, false, handles.Has(handle{make(chan int), p2}))
+}
+
+func TestPersistentMapLarge(t *testing.T) {
+	for _, hasher := range []func(int) uint64{
+		nil,
+		// Shared prefixes
+		func(key int) uint64 {
+			return uint64(key % 64)
+		},
+		// All collided
+		func(key int) uint64 {
+			return 1
+		},
+	} {
+		const size = 2000
+		expected := map[int]int{}
+		m := NewPersistentMapWithHasher[int, int](hasher)
+		versions := []*PersistentMap[int, int]{}
+		for i := 0; i < size; i++ {
+			m = m.Set(i, i*10)
+			expected[i] = i * 10
+			if i%500 == 0 {
+				versions = append(versions, m)
+			}
+		}
+		assert.Equal(t, size, m.Len())
+		assert.Equal(t, expected, m.ToMap())
+		assert.Equal(t, 1, versions[0].Len())
+		assert.Equal(t, 501, versions[1].Len())
+
+		for i := 0; i < size; i += 2 {
+			m = m.Delete(i)
+			delete(expected, i)
+		}
+		assert.Equal(t, size/2, m.Len())
+		assert.Equal(t, expected, m.ToMap())
+		for i := 0; i < size; i++ {
+			_, ok := m.Get(i)
+			if ok != (i%2 == 1) {
+				assert.Fail(t, "unexpected Get()", "key %d", i)
+				break
+			}
+		}
+		assert.Equal(t, 501, versions[1].Len())
+		assert.Equal(t, 5000, versions[1].ToMap()[500])
+
+		for i := 1; i < size; i += 2 {
+			m = m.Delete(i)
+		}
+		assert.Equal(t, 0, m.Len())
+		assert.Nil(t, m.root)
+	}
+}
+
+func TestTransientMap(t *testing.T) {
+	base := NewPersistentMap[string, int]().Set("a", 1)
+	transient := base.AsTransient()
+	for i := 0; i < 1000; i++ {
+		transient.Set(strconv.Itoa(i), i)
+	}
+	transient.Set("a", 2).Delete("999").Delete("none")
+	val, ok := transient.Get("a")
+	assert.Equal(t, true, ok)
+	assert.Equal(t, 2, val)
+	assert.Equal(t, 1000, transient.Len())
+
+	result := transient.Persistent()
+	assert.Equal(t, 1000, result.Len())
+	val, _ = result.Get("998")
+	assert.Equal(t, 998, val)
+	assert.Equal(t, false, result.Has("999"))
+	// The base version is unchanged
+	assert.Equal(t, map[string]int{"a": 1}, base.ToMap())
+	assert.Panics(t, func() {
+		transient.Set("b", 1)
+	})
+
+	// Changes of another transient don't leak into the result
+	transient = result.AsTransient()
+	transient.Set("0", -1).Delete("1")
+	val, _ = result.Get("0")
+	assert.Equal(t, 0, val)
+	assert.Equal(t, true, result.Has("1"))
+	assert.Equal(t, 999, transient.Persistent().Len())
+}
@@ -0,0 +1,269 @@
+package fpgo
+
+// PersistentVector
+
+const (
+	persistentVectorBits  = 5
+	persistentVectorWidth = 1 << persistentVectorBits
+	persistentVectorMask  = persistentVectorWidth - 1
+)
+
+// persistentVectorNode Branch(children) or leaf(values) node of the PersistentVector trie
+type persistentVectorNode[T any] struct {
+	children []*persistentVectorNode[T]
+	values   []T
+}
+
+// PersistentVector Immutable indexed vector by a 32-way bit-partitioned trie with a tail(inspired by Clojure),
+// versions share the unchanged nodes
+type PersistentVector[T any] struct {
+	size  int
+	shift uint
+	root  *persistentVectorNode[T]
+	tail  []T
+}
+
+// NewPersistentVector New empty PersistentVector instance
+func NewPersistentVector[T any]() *PersistentVector[T] {
+	return &PersistentVector[T]{
+		shift: persistentVectorBits,
+		root:  &persistentVectorNode[T]{},
+	}
+}
+
+// PersistentVectorFrom New PersistentVector instance with the values in order
+func PersistentVectorFrom[T any](values ...T) *PersistentVector[T] {
+	result := NewPersistentVector[T]()
+	for _, val := range values {
+		result = result.Push(val)
+	}
+	return result
+}
+
+// Len Get the number of values
+func (vectorSelf *PersistentVector[T]) Len() int {
+	return vectorSelf.size
+}
+
+// tailOffset Get the index of the first value in the tail
+func (vectorSelf *PersistentVector[T]) tailOffset() int {
+	if vectorSelf.size < persistentVectorWidth {
+		return 0
+	}
+	return ((vectorSelf.size - 1) >> persistentVectorBits) << persistentVectorBits
+}
+
+// leafFor Get the values of the leaf containing the i-th value
+func (vectorSelf *PersistentVector[T]) leafFor(i int) []T {
+	if i < 0 || i >= vectorSelf.size {
+		panic("persistentVector: index out of range")
+	}
+	if i >= vectorSelf.tailOffset() {
+		return vectorSelf.tail
+	}
+	node := vectorSelf.root
+	for level := vectorSelf.shift; level > 0; level -= persistentVectorBits {
+		node = node.children[(i>>level)&persistentVectorMask]
+	}
+	return node.values
+}
+
+// Get Get the i-th value(O(log32 n), panic if it's out of range like slices)
+func (vectorSelf *PersistentVector[T]) Get(i int) T {
+	return vectorSelf.leafFor(i)[i&persistentVectorMask]
+}
+
+// Last Get the last value, false if the vector is empty
+func (vectorSelf *PersistentVector[T]) Last() (T, bool) {
+	if vectorSelf.size == 0 {
+		var zero T
+		return zero, false
+	}
+	return vectorSelf.Get(vectorSelf.size - 1), true
+}
+
+// Push New version with the value appended
+func (vectorSelf *PersistentVector[T]) Push(val T) *PersistentVector[T] {
+	// Room in the tail
+	if vectorSelf.size-vectorSelf.tailOffset() < persistentVectorWidth {
+		newTail := make([]T, len(vectorSelf.tail), len(vectorSelf.tail)+1)
+		copy(newTail, vectorSelf.tail)
+		return &PersistentVector[T]{
+			size:  vectorSelf.size + 1,
+			shift: vectorSelf.shift,
+			root:  vectorSelf.root,
+			tail:  append(newTail, val),
+		}
+	}
+
+	// Move the full tail into the trie
+	tailNode := &persistentVectorNode[T]{values: vectorSelf.tail}
+	shift := vectorSelf.shift
+	var newRoot *persistentVectorNode[T]
+	if (vectorSelf.size >> persistentVectorBits) > (1 << shift) {
+		// Root overflow
+		newRoot = &persistentVectorNode[T]{children: []*persistentVectorNode[T]{
+			vectorSelf.root,
+			newPersistentVectorPath(shift, tailNode),
+		}}
+		shift += persistentVectorBits
+	} else {
+		newRoot = vectorSelf.pushTail(shift, vectorSelf.root, tailNode)
+	}
+	return &PersistentVector[T]{
+		size:  vectorSelf.size + 1,
+		shift: shift,
+		root:  newRoot,
+		tail:  []T{val},
+	}
+}
+
+// newPersistentVectorPath Wrap the node by branches down from the level
+func newPersistentVectorPath[T any](level uint, node *persistentVectorNode[T]) *persistentVectorNode[T] {
+	if level == 0 {
+		return node
+	}
+	return &persistentVectorNode[T]{children: []*persistentVectorNode[T]{newPersistentVectorPath(level-persistentVectorBits, node)}}
+}
+
+func (vectorSelf *PersistentVector[T]) pushTail(level uint, parent *persistentVectorNode[T], tailNode *persistentVectorNode[T]) *persistentVectorNode[T] {
+	subIndex := ((vectorSelf.size - 1) >> level) & persistentVectorMask
+	result := parent.cloneBranch()
+
+	var nodeToInsert *persistentVectorNode[T]
+	if level == persistentVectorBits {
+		nodeToInsert = tailNode
+	} else if subIndex < len(parent.children) {
+		nodeToInsert = vectorSelf.pushTail(level-persistentVectorBits, parent.children[subIndex], tailNode)
+	} else {
+		nodeToInsert = newPersistentVectorPath(level-persistentVectorBits, tailNode)
+	}
+
+	if subIndex < len(result.children) {
+		result.children[subIndex] = nodeToInsert
+	} else {
+		result.children = append(result.children, nodeToInsert)
+	}
+	return result
+}
+
+// Set New version with the i-th value replaced(i == Len() appends it)
+func (vectorSelf *PersistentVector[T]) Set(i int, val T) *PersistentVector[T] {
+	if i == vectorSelf.size {
+		return vectorSelf.Push(val)
+	}
+	if i < 0 || i > vectorSelf.size {
+		panic("persistentVector: index out of range")
+	}
+
+	result := *vectorSelf
+	if i >= vectorSelf.tailOffset() {
+		result.tail = DuplicateSlice(vectorSelf.tail)
+		result.tail[i&persistentVectorMask] = val
+	} else {
+		result.root = vectorSelf.doSet(vectorSelf.shift, vectorSelf.root, i, val)
+	}
+	return &result
+}
+
+func (vectorSelf *PersistentVector[T]) doSet(level uint, node *persistentVectorNode[T], i int, val T) *persistentVectorNode[T] {
+	if level == 0 {
+		result := &persistentVectorNode[T]{values: DuplicateSlice(node.values)}
+		result.values[i&persistentVectorMask] = val
+		return result
+	}
+
+	result := node.cloneBranch()
+	subIndex := (i >> level) & persistentVectorMask
+	result.children[subIndex] = vectorSelf.doSet(level-persistentVectorBits, node.children[subIndex], i, val)
+	return result
+}
+
+// Pop New version without the last value(the empty vector is still empty)
+func (vectorSelf *PersistentVector[T]) Pop() *PersistentVector[T] {
+	switch {
+	case vectorSelf.size == 0:
+		return vectorSelf
+	case vectorSelf.size == 1:
+		return NewPersistentVector[T]()
+	case vectorSelf.size-vectorSelf.tailOffset() > 1:
+		tailLen := len(vectorSelf.tail) - 1
+		return &PersistentVector[T]{
+			size:  vectorSelf.size - 1,
+			shift: vectorSelf.shift,
+			root:  vectorSelf.root,
+			tail:  vectorSelf.tail[:tailLen:tailLen],
+		}
+	}
+
+	// Take the last leaf of the trie as the tail
+	newTail := vectorSelf.leafFor(vectorSelf.size - 2)
+	newRoot := vectorSelf.popTail(vectorSelf.shift, vectorSelf.root)
+	shift := vectorSelf.shift
+	if newRoot == nil {
+		newRoot = &persistentVectorNode[T]{}
+	}
+	if shift > persistentVectorBits && len(newRoot.children) == 1 {
+		newRoot = newRoot.children[0]
+		shift -= persistentVectorBits
+	}
+	return &PersistentVector[T]{
+		size:  vectorSelf.size - 1,
+		shift: shift,
+		root:  newRoot,
+		tail:  newTail,
+	}
+}
+
+func (vectorSelf *PersistentVector[T]) popTail(level uint, node *persistentVectorNode[T]) *persistentVectorNode[T] {
+	subIndex := ((vectorSelf.size - 2) >> level) & persistentVectorMask
+	if level > persistentVectorBits {
+		newChild := vectorSelf.popTail(level-persistentVectorBits, node.children[subIndex])
+		if newChild == nil && subIndex == 0 {
+			return nil
+		}
+		result := node.cloneBranch()
+		if newChild == nil {
+			result.children = result.children[:subIndex]
+		} else {
+			result.children[subIndex] = newChild
+		}
+		return result
+	}
+	if subIndex == 0 {
+		return nil
+	}
+	result := node.cloneBranch()
+	result.children = result.children[:subIndex]
+	return result
+}
+
+// cloneBranch Copy the children of the branch node
+func (nodeSelf *persistentVectorNode[T]) cloneBranch() *persistentVectorNode[T] {
+	children := make([]*persistentVectorNode[T], len(nodeSelf.children), len(nodeSelf.children)+1)
+	copy(children, nodeSelf.children)
+	return &persistentVectorNode[T]{children: children}
+}
+
+// ForEach Call fn with each value in order
+func (vectorSelf *PersistentVector[T]) ForEach(fn func(T)) {
+	for i := 0; i < vectorSelf.size; i += persistentVectorWidth {
+		for _, val := range vectorSelf.leafFor(i) {
+			fn(val)
+		}
+	}
+}
+
+// ToSlice Convert the vector to a new slice
+func (vectorSelf *PersistentVector[T]) ToSlice() []T {
+	result := make([]T, 0, vectorSelf.size)
+	vectorSelf.ForEach(func(val T) {
+		result = append(result, val)
+	})
+	return result
+}
+
+// PersistentVectorToStream Convert the PersistentVector to Stream
+func PersistentVectorToStream[T comparable](vector *PersistentVector[T]) *StreamDef[T] {
+	return StreamFromArray(vector.ToSlice())
+}
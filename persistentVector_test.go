@@ -0,0 +1,71 @@
+package fpgo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPersistentVector(t *testing.T) {
+	empty := NewPersistentVector[int]()
+	assert.Equal(t, 0, empty.Len())
+	assert.Equal(t, []int{}, empty.ToSlice())
+	_, ok := empty.Last()
+	assert.Equal(t, false, ok)
+	assert.Same(t, empty, empty.Pop())
+
+	// Deep enough for the root to overflow twice(32 * 32 * 32 + tail)
+	const size = 32*32*32 + 40
+	versions := make([]*PersistentVector[int], 0, size+1)
+	vector := empty
+	versions = append(versions, vector)
+	for i := 0; i < size; i++ {
+		vector = vector.Push(i)
+		versions = append(versions, vector)
+	}
+	assert.Equal(t, size, vector.Len())
+	for i := 0; i < size; i++ {
+		if vector.Get(i) != i {
+			assert.Fail(t, "unexpected value", "index %d", i)
+			break
+		}
+	}
+	last, ok := vector.Last()
+	assert.Equal(t, true, ok)
+	assert.Equal(t, size-1, last)
+	// Older versions are unchanged
+	assert.Equal(t, []int{0, 1, 2}, versions[3].ToSlice())
+	assert.Equal(t, 1000, versions[1000].Len())
+
+	// Set in the trie & in the tail
+	set := vector.Set(5, -5).Set(size-1, -1).Set(size, size)
+	assert.Equal(t, -5, set.Get(5))
+	assert.Equal(t, -1, set.Get(size-1))
+	assert.Equal(t, size, set.Get(size))
+	assert.Equal(t, 5, vector.Get(5))
+	assert.Equal(t, size-1, vector.Get(size-1))
+	assert.Equal(t, size, vector.Len())
+
+	// Pop back to empty
+	popped := vector
+	for i := size; i > 0; i-- {
+		if popped.Len() != i || popped.Get(i-1) != i-1 {
+			assert.Fail(t, "unexpected pop", "size %d", i)
+			break
+		}
+		popped = popped.Pop()
+		if i == 1000 {
+			assert.Equal(t, versions[999].ToSlice(), popped.ToSlice())
+		}
+	}
+	assert.Equal(t, 0, popped.Len())
+	assert.Equal(t, []int{0, 1}, popped.Push(0).Push(1).ToSlice())
+
+	assert.Equal(t, []int{1, 2, 3}, PersistentVectorToStream(PersistentVectorFrom(1, 2, 3)).ToArray())
+	assert.Panics(t, func() {
+		vector.Get(size)
+	})
+	assert.Panics(t, func() {
+		vector.Set(size+1, 0)
+	})
+}
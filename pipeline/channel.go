@@ -0,0 +1,171 @@
+package pipeline
+
+import (
+	"context"
+	"sync"
+)
+
+// Channel combinators
+
+// FanOutPolicy How FanOut() distributes the values to the outputs
+type FanOutPolicy int
+
+const (
+	// FanOutRoundRobin Send every value to one output after another
+	FanOutRoundRobin FanOutPolicy = iota
+	// FanOutBroadcast Send every value to all the outputs
+	FanOutBroadcast
+)
+
+// FanIn Merge the channels into one, it's closed once all the channels are closed or the ctx is done
+//
+// NOTE: the order of the values from different channels is not guaranteed
+func FanIn[T any](ctx context.Context, chs ...<-chan T) <-chan T {
+	out := make(chan T)
+	var wg sync.WaitGroup
+	wg.Add(len(chs))
+	for _, ch := range chs {
+		go func(ch <-chan T) {
+			defer wg.Done()
+			for {
+				select {
+				case val, ok := <-ch:
+					if !ok {
+						return
+					}
+					select {
+					case out <- val:
+					case <-ctx.Done():
+						return
+					}
+				case <-ctx.Done():
+					return
+				}
+			}
+		}(ch)
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// FanOut Split the channel into n outputs(1 if <= 0) by the policy,
+// all the outputs are closed once the channel is closed or the ctx is done
+//
+// NOTE: a slow output blocks the others(every output should be read until closed)
+func FanOut[T any](ctx context.Context, ch <-chan T, n int, policy FanOutPolicy) []<-chan T {
+	if n <= 0 {
+		n = 1
+	}
+	outs := make([]chan T, n)
+	result := make([]<-chan T, n)
+	for i := range outs {
+		outs[i] = make(chan T)
+		result[i] = outs[i]
+	}
+
+	go func() {
+		defer func() {
+			for _, out := range outs {
+				close(out)
+			}
+		}()
+
+		next := 0
+		for {
+			select {
+			case val, ok := <-ch:
+				if !ok {
+					return
+				}
+				targets := outs[next : next+1]
+				if policy == FanOutBroadcast {
+					targets = outs
+				} else {
+					next = (next + 1) % n
+				}
+				for _, out := range targets {
+					select {
+					case out <- val:
+					case <-ctx.Done():
+						return
+					}
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return result
+}
+
+// OrDone Wrap the channel as a channel closed once the channel is closed or the ctx is done
+func OrDone[T any](ctx context.Context, ch <-chan T) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		for {
+			select {
+			case val, ok := <-ch:
+				if !ok {
+					return
+				}
+				select {
+				case out <- val:
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// Tee Duplicate the channel into two outputs, every value is sent to both of them before the next one is read
+//
+// NOTE: both of the outputs should be read until closed(or cancel the ctx)
+func Tee[T any](ctx context.Context, ch <-chan T) (<-chan T, <-chan T) {
+	out1 := make(chan T)
+	out2 := make(chan T)
+	go func() {
+		defer close(out1)
+		defer close(out2)
+		for val := range OrDone(ctx, ch) {
+			// Disable the sent one by nil until both are sent
+			out1, out2 := out1, out2
+			for i := 0; i < 2; i++ {
+				select {
+				case out1 <- val:
+					out1 = nil
+				case out2 <- val:
+					out2 = nil
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return out1, out2
+}
+
+// Bridge Flatten the channel of channels, the values of each channel are sent in order(one channel after another)
+func Bridge[T any](ctx context.Context, chs <-chan <-chan T) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		for ch := range OrDone(ctx, chs) {
+			for val := range OrDone(ctx, ch) {
+				select {
+				case out <- val:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return out
+}
@@ -0,0 +1,111 @@
+package pipeline
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func sendAll[T any](values ...T) <-chan T {
+	ch := make(chan T)
+	go func() {
+		defer close(ch)
+		for _, val := range values {
+			ch <- val
+		}
+	}()
+	return ch
+}
+
+func readAll[T any](chs ...<-chan T) [][]T {
+	results := make([][]T, len(chs))
+	var wg sync.WaitGroup
+	for i, ch := range chs {
+		wg.Add(1)
+		go func(i int, ch <-chan T) {
+			defer wg.Done()
+			results[i] = []T{}
+			for val := range ch {
+				results[i] = append(results[i], val)
+			}
+		}(i, ch)
+	}
+	wg.Wait()
+	return results
+}
+
+func TestFanIn(t *testing.T) {
+	merged := readAll(FanIn(context.Background(), sendAll(1, 2, 3), sendAll(4, 5), sendAll[int]()))[0]
+	sort.Ints(merged)
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, merged)
+	assert.Equal(t, [][]int{{}}, readAll(FanIn[int](context.Background())))
+
+	// Closed by the ctx
+	ctx, cancel := context.WithCancel(context.Background())
+	never := make(chan int)
+	out := FanIn(ctx, never)
+	cancel()
+	_, ok := <-out
+	assert.False(t, ok)
+}
+
+func TestFanOut(t *testing.T) {
+	assert.Equal(t, [][]int{{1, 4}, {2, 5}, {3}}, readAll(FanOut(context.Background(), sendAll(1, 2, 3, 4, 5), 3, FanOutRoundRobin)...))
+	assert.Equal(t, [][]int{{1, 2}, {1, 2}}, readAll(FanOut(context.Background(), sendAll(1, 2), 2, FanOutBroadcast)...))
+	assert.Equal(t, [][]int{{1, 2}}, readAll(FanOut(context.Background(), sendAll(1, 2), 0, FanOutRoundRobin)...))
+
+	// Fan-out then fan-in
+	merged := readAll(FanIn(context.Background(), FanOut(context.Background(), sendAll(1, 2, 3, 4), 2, FanOutRoundRobin)...))[0]
+	sort.Ints(merged)
+	assert.Equal(t, []int{1, 2, 3, 4}, merged)
+
+	// Closed by the ctx
+	ctx, cancel := context.WithCancel(context.Background())
+	never := make(chan int)
+	outs := FanOut(ctx, never, 2, FanOutBroadcast)
+	cancel()
+	assert.Equal(t, [][]int{{}, {}}, readAll(outs...))
+}
+
+func TestOrDone(t *testing.T) {
+	assert.Equal(t, [][]int{{1, 2}}, readAll(OrDone(context.Background(), sendAll(1, 2))))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	never := make(chan int)
+	out := OrDone(ctx, never)
+	cancel()
+	_, ok := <-out
+	assert.False(t, ok)
+}
+
+func TestTee(t *testing.T) {
+	out1, out2 := Tee(context.Background(), sendAll(1, 2, 3))
+	assert.Equal(t, [][]int{{1, 2, 3}, {1, 2, 3}}, readAll(out1, out2))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	out1, out2 = Tee(ctx, sendAll(1, 2, 3))
+	assert.Equal(t, 1, <-out1)
+	cancel()
+	readAll(out1, out2)
+}
+
+func TestBridge(t *testing.T) {
+	chs := make(chan (<-chan int))
+	go func() {
+		defer close(chs)
+		chs <- sendAll(1, 2)
+		chs <- sendAll[int]()
+		chs <- sendAll(3)
+	}()
+	assert.Equal(t, [][]int{{1, 2, 3}}, readAll(Bridge(context.Background(), chs)))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	never := make(chan (<-chan int))
+	out := Bridge(ctx, never)
+	cancel()
+	_, ok := <-out
+	assert.False(t, ok)
+}
@@ -0,0 +1,171 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Pipeline
+
+// StageFunc Process an input of a Stage, it should stop early when the ctx is done
+type StageFunc[I any, O any] func(ctx context.Context, in I) (O, error)
+
+// StageDef A typed stage of a pipeline, composed by Then() and started by Start()/Run()
+type StageDef[I any, O any] struct {
+	start func(ctx context.Context, in <-chan I, fail func(error)) <-chan O
+}
+
+// Stage New a StageDef running fn on parallelism goroutines(1 if <= 0)
+//
+// NOTE: the outputs keep the order of the inputs only if parallelism is 1
+func Stage[I any, O any](parallelism int, fn StageFunc[I, O]) *StageDef[I, O] {
+	if parallelism <= 0 {
+		parallelism = 1
+	}
+	return &StageDef[I, O]{
+		start: func(ctx context.Context, in <-chan I, fail func(error)) <-chan O {
+			out := make(chan O, parallelism)
+			var wg sync.WaitGroup
+			wg.Add(parallelism)
+			for i := 0; i < parallelism; i++ {
+				go func() {
+					defer wg.Done()
+					// Drain the inputs until closed, so the upstream never blocks after a failure
+					for input := range in {
+						if ctx.Err() != nil {
+							continue
+						}
+						output, err := stageCall(ctx, fn, input)
+						if err != nil {
+							fail(err)
+							continue
+						}
+						select {
+						case out <- output:
+						case <-ctx.Done():
+						}
+					}
+				}()
+			}
+			go func() {
+				wg.Wait()
+				close(out)
+			}()
+			return out
+		},
+	}
+}
+
+// Then Compose the stages, the outputs of the first are the inputs of the second
+func Then[I any, M any, O any](first *StageDef[I, M], second *StageDef[M, O]) *StageDef[I, O] {
+	return &StageDef[I, O]{
+		start: func(ctx context.Context, in <-chan I, fail func(error)) <-chan O {
+			return second.start(ctx, first.start(ctx, in, fail), fail)
+		},
+	}
+}
+
+// Execution A started pipeline
+type Execution[O any] struct {
+	out  chan O
+	done chan struct{}
+	err  error
+}
+
+// Start Start the pipeline reading the inputs from in, the outputs are sent to Out()
+//
+// NOTE: in should be closed by the caller once all the inputs are sent,
+// it's drained after the pipeline failed or the ctx is done(so the senders never block)
+func (stageSelf *StageDef[I, O]) Start(ctx context.Context, in <-chan I) *Execution[O] {
+	ctx, cancel := context.WithCancel(ctx)
+	execution := &Execution[O]{
+		out:  make(chan O),
+		done: make(chan struct{}),
+	}
+
+	var lock sync.Mutex
+	var firstErr error
+	fail := func(err error) {
+		lock.Lock()
+		defer lock.Unlock()
+		if firstErr == nil {
+			firstErr = err
+			cancel()
+		}
+	}
+
+	out := stageSelf.start(ctx, in, fail)
+	go func() {
+		defer cancel()
+		for output := range out {
+			select {
+			case execution.out <- output:
+			case <-ctx.Done():
+			}
+		}
+
+		lock.Lock()
+		execution.err = firstErr
+		lock.Unlock()
+		if execution.err == nil {
+			// Canceled by the parent ctx
+			execution.err = ctx.Err()
+		}
+		close(execution.out)
+		close(execution.done)
+	}()
+	return execution
+}
+
+// Run Run the pipeline with the inputs, get all the outputs or the first error
+func (stageSelf *StageDef[I, O]) Run(ctx context.Context, inputs ...I) ([]O, error) {
+	in := make(chan I)
+	go func() {
+		defer close(in)
+		for _, input := range inputs {
+			select {
+			case in <- input:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	execution := stageSelf.Start(ctx, in)
+	outputs := make([]O, 0, len(inputs))
+	for output := range execution.Out() {
+		outputs = append(outputs, output)
+	}
+	if err := execution.Wait(); err != nil {
+		return nil, err
+	}
+	return outputs, nil
+}
+
+// Out Get the outputs, it's closed once the pipeline is done
+//
+// NOTE: it should be read until closed(or cancel the ctx) to release the pipeline
+func (executionSelf *Execution[O]) Out() <-chan O {
+	return executionSelf.out
+}
+
+// Done Get the channel closed once the pipeline is done
+func (executionSelf *Execution[O]) Done() <-chan struct{} {
+	return executionSelf.done
+}
+
+// Wait Wait until the pipeline is done, get the first error of the stages(or the error of the canceled ctx)
+func (executionSelf *Execution[O]) Wait() error {
+	<-executionSelf.done
+	return executionSelf.err
+}
+
+func stageCall[I any, O any](ctx context.Context, fn StageFunc[I, O], input I) (output O, err error) {
+	defer func() {
+		if panic := recover(); panic != nil {
+			err = fmt.Errorf("panic from pipeline Stage: %v", panic)
+		}
+	}()
+	return fn(ctx, input)
+}
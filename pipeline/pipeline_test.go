@@ -0,0 +1,130 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPipeline(t *testing.T) {
+	double := Stage(1, func(ctx context.Context, in int) (int, error) {
+		return in * 2, nil
+	})
+	format := Stage(1, func(ctx context.Context, in int) (string, error) {
+		return "#" + strconv.Itoa(in), nil
+	})
+
+	actual, err := Then(double, format).Run(context.Background(), 1, 2, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"#2", "#4", "#6"}, actual)
+
+	actual, err = Then(double, format).Run(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, actual)
+
+	// Parallelism
+	var lock sync.Mutex
+	current, peak := 0, 0
+	slow := Stage(4, func(ctx context.Context, in int) (int, error) {
+		lock.Lock()
+		current++
+		if current > peak {
+			peak = current
+		}
+		lock.Unlock()
+		time.Sleep(5 * time.Millisecond)
+		lock.Lock()
+		current--
+		lock.Unlock()
+		return in, nil
+	})
+	inputs := make([]int, 20)
+	for i := range inputs {
+		inputs[i] = i
+	}
+	numbers, err := Then(slow, double).Run(context.Background(), inputs...)
+	assert.NoError(t, err)
+	sort.Ints(numbers)
+	assert.Equal(t, 20, len(numbers))
+	assert.Equal(t, 38, numbers[19])
+	assert.True(t, peak > 1 && peak <= 4)
+}
+
+func TestPipelineError(t *testing.T) {
+	errOdd := errors.New("odd")
+	processed := 0
+	failing := Stage(1, func(ctx context.Context, in int) (int, error) {
+		processed++
+		if in == 3 {
+			return 0, errOdd
+		}
+		return in, nil
+	})
+	after := Stage(1, func(ctx context.Context, in int) (int, error) {
+		return in, nil
+	})
+
+	// The senders never block after the failure
+	in := make(chan int)
+	execution := Then(failing, after).Start(context.Background(), in)
+	go func() {
+		for output := range execution.Out() {
+			assert.True(t, output < 3)
+		}
+	}()
+	for i := 1; i <= 100; i++ {
+		in <- i
+	}
+	close(in)
+	assert.Equal(t, errOdd, execution.Wait())
+	assert.Equal(t, 3, processed)
+
+	actual, err := failing.Run(context.Background(), 1, 3, 5)
+	assert.Equal(t, errOdd, err)
+	assert.Nil(t, actual)
+
+	// Panics
+	_, err = Stage(2, func(ctx context.Context, in int) (int, error) {
+		panic("boom")
+	}).Run(context.Background(), 1)
+	assert.EqualError(t, err, "panic from pipeline Stage: boom")
+}
+
+func TestPipelineCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	started := make(chan bool, 1)
+	blocking := Stage(1, func(ctx context.Context, in int) (int, error) {
+		started <- true
+		<-ctx.Done()
+		return 0, ctx.Err()
+	})
+
+	done := make(chan error)
+	go func() {
+		_, err := blocking.Run(ctx, 1, 2, 3)
+		done <- err
+	}()
+	<-started
+	cancel()
+	assert.Equal(t, context.Canceled, <-done)
+
+	// Canceled without any failure
+	ctx, cancel = context.WithCancel(context.Background())
+	in := make(chan int)
+	execution := Stage(1, func(ctx context.Context, in int) (int, error) {
+		return in, nil
+	}).Start(ctx, in)
+	in <- 1
+	cancel()
+	close(in)
+	for range execution.Out() {
+	}
+	<-execution.Done()
+	assert.Equal(t, context.Canceled, execution.Wait())
+}
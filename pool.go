@@ -0,0 +1,187 @@
+package fpgo
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// Pool
+
+// ErrPoolIsClosed The Pool is closed
+var ErrPoolIsClosed = errors.New("pool is closed")
+
+// PoolOption Options of Pool
+type PoolOption[T any] struct {
+	// MaxIdle The maximum number of idle values kept, the others are destroyed when they're put back(unlimited if <= 0)
+	MaxIdle int
+	// MaxLifetime Values older than it are destroyed instead of being reused(never if <= 0)
+	MaxLifetime time.Duration
+	// HealthCheck Check an idle value before reusing it, it's destroyed if the check fails(no check if nil)
+	HealthCheck func(T) bool
+	// TimeScheduler The clock of the MaxLifetime(DefaultTimeScheduler if nil)
+	TimeScheduler TimeScheduler
+}
+
+// PoolItem A value borrowed from the Pool, put it back by Pool.Put()
+type PoolItem[T any] struct {
+	Value T
+
+	createdAt time.Time
+	isIdle    bool
+}
+
+// Pool Object pool reusing expensive values(e.g. buffers/connections) with lifecycle hooks(concurrency-safe)
+type Pool[T any] struct {
+	lock     sync.Mutex
+	isClosed bool
+	option   PoolOption[T]
+
+	factory func() (T, error)
+	reset   func(T)
+	destroy func(T)
+
+	idle []*PoolItem[T]
+}
+
+// NewPool New Pool making values by the factory, reset is called before a value is put back & destroy is called when a value is discarded(both could be nil)
+func NewPool[T any](factory func() (T, error), reset func(T), destroy func(T), opts ...PoolOption[T]) *Pool[T] {
+	var option PoolOption[T]
+	if len(opts) > 0 {
+		option = opts[0]
+	}
+	if option.TimeScheduler == nil {
+		option.TimeScheduler = DefaultTimeScheduler
+	}
+
+	return &Pool[T]{
+		option:  option,
+		factory: factory,
+		reset:   reset,
+		destroy: destroy,
+	}
+}
+
+// Get Borrow an idle value(the latest put back first) or make a new one by the factory
+func (poolSelf *Pool[T]) Get() (*PoolItem[T], error) {
+	for {
+		poolSelf.lock.Lock()
+		if poolSelf.isClosed {
+			poolSelf.lock.Unlock()
+			return nil, ErrPoolIsClosed
+		}
+		if len(poolSelf.idle) == 0 {
+			poolSelf.lock.Unlock()
+			break
+		}
+		last := len(poolSelf.idle) - 1
+		item := poolSelf.idle[last]
+		poolSelf.idle[last] = nil
+		poolSelf.idle = poolSelf.idle[:last]
+		item.isIdle = false
+		poolSelf.lock.Unlock()
+
+		if poolSelf.isExpired(item) || (poolSelf.option.HealthCheck != nil && !poolSelf.option.HealthCheck(item.Value)) {
+			poolSelf.doDestroy(item)
+			continue
+		}
+		return item, nil
+	}
+
+	val, err := poolSelf.factory()
+	if err != nil {
+		return nil, err
+	}
+	return &PoolItem[T]{Value: val, createdAt: poolSelf.option.TimeScheduler.Now()}, nil
+}
+
+// Put Put the borrowed value back for reusing(destroyed if the Pool is closed/full or the value is expired)
+func (poolSelf *Pool[T]) Put(item *PoolItem[T]) {
+	if item == nil {
+		return
+	}
+	if poolSelf.isExpired(item) {
+		poolSelf.Discard(item)
+		return
+	}
+	if poolSelf.reset != nil {
+		poolSelf.reset(item.Value)
+	}
+
+	poolSelf.lock.Lock()
+	if item.isIdle {
+		// Put back already
+		poolSelf.lock.Unlock()
+		return
+	}
+	if poolSelf.isClosed || (poolSelf.option.MaxIdle > 0 && len(poolSelf.idle) >= poolSelf.option.MaxIdle) {
+		poolSelf.lock.Unlock()
+		poolSelf.doDestroy(item)
+		return
+	}
+	item.isIdle = true
+	poolSelf.idle = append(poolSelf.idle, item)
+	poolSelf.lock.Unlock()
+}
+
+// Discard Destroy the borrowed value instead of putting it back(e.g. a broken connection)
+func (poolSelf *Pool[T]) Discard(item *PoolItem[T]) {
+	if item == nil {
+		return
+	}
+	poolSelf.lock.Lock()
+	isIdle := item.isIdle
+	poolSelf.lock.Unlock()
+	if !isIdle {
+		poolSelf.doDestroy(item)
+	}
+}
+
+// With Call fn with a borrowed value and put it back after that(discarded if fn returns an error)
+func (poolSelf *Pool[T]) With(fn func(T) error) error {
+	item, err := poolSelf.Get()
+	if err != nil {
+		return err
+	}
+
+	err = fn(item.Value)
+	if err != nil {
+		poolSelf.Discard(item)
+	} else {
+		poolSelf.Put(item)
+	}
+	return err
+}
+
+// Idle Get the number of idle values
+func (poolSelf *Pool[T]) Idle() int {
+	poolSelf.lock.Lock()
+	defer poolSelf.lock.Unlock()
+
+	return len(poolSelf.idle)
+}
+
+// Close Close the Pool and destroy the idle values(borrowed values are destroyed when they're put back)
+func (poolSelf *Pool[T]) Close() {
+	poolSelf.lock.Lock()
+	idle := poolSelf.idle
+	poolSelf.idle = nil
+	poolSelf.isClosed = true
+	poolSelf.lock.Unlock()
+
+	for _, item := range idle {
+		item.isIdle = false
+		poolSelf.doDestroy(item)
+	}
+}
+
+func (poolSelf *Pool[T]) isExpired(item *PoolItem[T]) bool {
+	return poolSelf.option.MaxLifetime > 0 &&
+		poolSelf.option.TimeScheduler.Now().Sub(item.createdAt) >= poolSelf.option.MaxLifetime
+}
+
+func (poolSelf *Pool[T]) doDestroy(item *PoolItem[T]) {
+	if poolSelf.destroy != nil {
+		poolSelf.destroy(item.Value)
+	}
+}
@@ -0,0 +1,130 @@
+package fpgo
+
+import (
+	"bytes"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type poolConn struct {
+	id        int
+	isHealthy bool
+}
+
+func TestPool(t *testing.T) {
+	timeScheduler := NewVirtualTimeScheduler(time.Now())
+	created := 0
+	var destroyed []int
+	errFactory := errors.New("factory failed")
+	factoryErr := error(nil)
+	pool := NewPool(func() (*poolConn, error) {
+		if factoryErr != nil {
+			return nil, factoryErr
+		}
+		created++
+		return &poolConn{id: created, isHealthy: true}, nil
+	}, nil, func(conn *poolConn) {
+		destroyed = append(destroyed, conn.id)
+	}, PoolOption[*poolConn]{
+		MaxIdle:     2,
+		MaxLifetime: time.Minute,
+		HealthCheck: func(conn *poolConn) bool {
+			return conn.isHealthy
+		},
+		TimeScheduler: timeScheduler,
+	})
+
+	item1, err := pool.Get()
+	assert.NoError(t, err)
+	item2, _ := pool.Get()
+	item3, _ := pool.Get()
+	assert.Equal(t, []int{1, 2, 3}, []int{item1.Value.id, item2.Value.id, item3.Value.id})
+
+	// MaxIdle
+	pool.Put(item1)
+	pool.Put(item2)
+	pool.Put(item3)
+	pool.Put(item2)
+	assert.Equal(t, 2, pool.Idle())
+	assert.Equal(t, []int{3}, destroyed)
+
+	// LIFO & reused
+	item, _ := pool.Get()
+	assert.Equal(t, 2, item.Value.id)
+	pool.Put(item)
+
+	// HealthCheck
+	item.Value.isHealthy = false
+	item, _ = pool.Get()
+	assert.Equal(t, 1, item.Value.id)
+	assert.Equal(t, []int{3, 2}, destroyed)
+
+	// MaxLifetime
+	pool.Put(item)
+	timeScheduler.Advance(time.Minute)
+	item, _ = pool.Get()
+	assert.Equal(t, 4, item.Value.id)
+	assert.Equal(t, []int{3, 2, 1}, destroyed)
+	timeScheduler.Advance(time.Minute)
+	pool.Put(item)
+	assert.Equal(t, []int{3, 2, 1, 4}, destroyed)
+	assert.Equal(t, 0, pool.Idle())
+
+	// Factory errors
+	factoryErr = errFactory
+	_, err = pool.Get()
+	assert.Equal(t, errFactory, err)
+	factoryErr = nil
+
+	// With
+	assert.NoError(t, pool.With(func(conn *poolConn) error {
+		assert.Equal(t, 5, conn.id)
+		return nil
+	}))
+	assert.Equal(t, errFactory, pool.With(func(conn *poolConn) error {
+		return errFactory
+	}))
+	assert.Equal(t, []int{3, 2, 1, 4, 5}, destroyed)
+
+	// Close
+	item, _ = pool.Get()
+	pool.Put(item)
+	borrowed, _ := pool.Get()
+	pool.Put(borrowed)
+	borrowed, _ = pool.Get()
+	idle, _ := pool.Get()
+	pool.Put(idle)
+	pool.Close()
+	assert.Equal(t, []int{3, 2, 1, 4, 5, idle.Value.id}, destroyed)
+	pool.Put(borrowed)
+	assert.Equal(t, borrowed.Value.id, destroyed[len(destroyed)-1])
+	_, err = pool.Get()
+	assert.Equal(t, ErrPoolIsClosed, err)
+}
+
+func TestPoolBuffers(t *testing.T) {
+	pool := NewPool(func() (*bytes.Buffer, error) {
+		return &bytes.Buffer{}, nil
+	}, func(buffer *bytes.Buffer) {
+		buffer.Reset()
+	}, nil)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			pool.With(func(buffer *bytes.Buffer) error {
+				assert.Equal(t, 0, buffer.Len())
+				buffer.WriteString("data")
+				return nil
+			})
+		}()
+	}
+	wg.Wait()
+	assert.True(t, pool.Idle() > 0)
+}
@@ -0,0 +1,198 @@
+package fpgo
+
+import (
+	"container/heap"
+	"context"
+	"sync"
+	"time"
+)
+
+// PriorityChannelQueue
+
+// PriorityChannelQueue BlockingQueue ordered by a Comparator(the least one first) with the same interface as ChannelQueue
+type PriorityChannelQueue[T any] struct {
+	lock     sync.Mutex
+	isClosed bool
+	capacity int
+	heap     *comparatorHeap[T]
+
+	notEmptyCh chan struct{}
+	notFullCh  chan struct{}
+	closedCh   chan struct{}
+}
+
+// NewPriorityChannelQueue New PriorityChannelQueue instance with capacity(unbounded if <= 0), less decides the priority
+func NewPriorityChannelQueue[T any](capacity int, less Comparator[T]) *PriorityChannelQueue[T] {
+	return &PriorityChannelQueue[T]{
+		capacity: capacity,
+		heap:     &comparatorHeap[T]{less: less},
+
+		notEmptyCh: make(chan struct{}, 1),
+		notFullCh:  make(chan struct{}, 1),
+		closedCh:   make(chan struct{}),
+	}
+}
+
+// NewPriorityChannelQueueByOrd New PriorityChannelQueue instance with capacity(unbounded if <= 0), the least one by Ord first
+func NewPriorityChannelQueueByOrd[T any](capacity int, ord Ord[T]) *PriorityChannelQueue[T] {
+	return NewPriorityChannelQueue(capacity, ord.Comparator())
+}
+
+// Put Put the T val(blocking)
+func (q *PriorityChannelQueue[T]) Put(val T) error {
+	return q.put(context.Background(), val, nil)
+}
+
+// PutWithTimeout Put the T val(blocking), with timeout
+func (q *PriorityChannelQueue[T]) PutWithTimeout(val T, timeout time.Duration) error {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	return q.put(context.Background(), val, timer.C)
+}
+
+func (q *PriorityChannelQueue[T]) put(ctx context.Context, val T, timeoutCh <-chan time.Time) error {
+	for {
+		err := q.Offer(val)
+		if err != ErrQueueIsFull {
+			return err
+		}
+
+		select {
+		case <-q.notFullCh:
+		case <-q.closedCh:
+		case <-timeoutCh:
+			return ErrQueuePutTimeout
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// OfferWithContext Put the T val, blocking until there's space or the context is done(returning ctx.Err())
+func (q *PriorityChannelQueue[T]) OfferWithContext(ctx context.Context, val T) error {
+	return q.put(ctx, val, nil)
+}
+
+// Take Take the T val with the highest priority(blocking)
+func (q *PriorityChannelQueue[T]) Take() (T, error) {
+	return q.take(context.Background(), nil)
+}
+
+// TakeWithTimeout Take the T val with the highest priority(blocking), with timeout
+func (q *PriorityChannelQueue[T]) TakeWithTimeout(timeout time.Duration) (T, error) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	return q.take(context.Background(), timer.C)
+}
+
+func (q *PriorityChannelQueue[T]) take(ctx context.Context, timeoutCh <-chan time.Time) (T, error) {
+	for {
+		val, err := q.Poll()
+		if err != ErrQueueIsEmpty {
+			return val, err
+		}
+
+		select {
+		case <-q.notEmptyCh:
+		case <-q.closedCh:
+		case <-timeoutCh:
+			return *new(T), ErrQueueTakeTimeout
+		case <-ctx.Done():
+			return *new(T), ctx.Err()
+		}
+	}
+}
+
+// TakeWithContext Take the T val with the highest priority, blocking until there's one or the context is done(returning ctx.Err())
+func (q *PriorityChannelQueue[T]) TakeWithContext(ctx context.Context) (T, error) {
+	return q.take(ctx, nil)
+}
+
+// Offer Offer the T val(non-blocking)
+func (q *PriorityChannelQueue[T]) Offer(val T) error {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if q.isClosed {
+		return ErrQueueIsClosed
+	}
+	if q.capacity > 0 && q.heap.Len() >= q.capacity {
+		return ErrQueueIsFull
+	}
+
+	heap.Push(q.heap, val)
+	queueSignal(q.notEmptyCh)
+	return nil
+}
+
+// Poll Poll the T val with the highest priority(non-blocking)
+//
+// NOTE: the remaining items could still be taken after closed, ErrQueueIsClosed is returned once it's empty.
+func (q *PriorityChannelQueue[T]) Poll() (T, error) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if q.heap.Len() == 0 {
+		if q.isClosed {
+			return *new(T), ErrQueueIsClosed
+		}
+		return *new(T), ErrQueueIsEmpty
+	}
+
+	val := heap.Pop(q.heap).(T)
+	// Wake up the next waiting ones
+	if q.heap.Len() > 0 {
+		queueSignal(q.notEmptyCh)
+	}
+	queueSignal(q.notFullCh)
+	return val, nil
+}
+
+// Peek Peek the T val with the highest priority without removing it(non-blocking)
+func (q *PriorityChannelQueue[T]) Peek() (T, error) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if q.heap.Len() == 0 {
+		return *new(T), ErrQueueIsEmpty
+	}
+	return q.heap.list[0], nil
+}
+
+// Count Count items
+func (q *PriorityChannelQueue[T]) Count() int {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	return q.heap.Len()
+}
+
+// IsClosed Is the PriorityChannelQueue closed
+func (q *PriorityChannelQueue[T]) IsClosed() bool {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	return q.isClosed
+}
+
+// Close Close the PriorityChannelQueue, the blocking Put()/Take() calls return ErrQueueIsClosed
+func (q *PriorityChannelQueue[T]) Close() {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if q.isClosed {
+		return
+	}
+	q.isClosed = true
+	close(q.closedCh)
+}
+
+// queueSignal Notify a waiting one by the signal channel(capacity 1) without blocking
+func queueSignal(signalCh chan struct{}) {
+	select {
+	case signalCh <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,68 @@
+package fpgo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPriorityChannelQueue(t *testing.T) {
+	var queue Queue[int]
+	priorityQueue := NewPriorityChannelQueue(3, func(a, b int) bool {
+		return a < b
+	})
+	queue = priorityQueue
+
+	assert.NoError(t, queue.Offer(3))
+	assert.NoError(t, queue.Offer(1))
+	assert.NoError(t, queue.Offer(2))
+	assert.Equal(t, ErrQueueIsFull, queue.Offer(4))
+	assert.Equal(t, ErrQueuePutTimeout, priorityQueue.PutWithTimeout(4, time.Millisecond))
+	val, _ := priorityQueue.Peek()
+	assert.Equal(t, 1, val)
+	assert.Equal(t, 3, priorityQueue.Count())
+
+	for _, expected := range []int{1, 2, 3} {
+		val, err := queue.Poll()
+		assert.NoError(t, err)
+		assert.Equal(t, expected, val)
+	}
+	_, err := queue.Poll()
+	assert.Equal(t, ErrQueueIsEmpty, err)
+	_, err = priorityQueue.TakeWithTimeout(time.Millisecond)
+	assert.Equal(t, ErrQueueTakeTimeout, err)
+
+	// Blocking
+	go func() {
+		time.Sleep(time.Millisecond)
+		priorityQueue.Put(5)
+	}()
+	val, err = queue.Take()
+	assert.NoError(t, err)
+	assert.Equal(t, 5, val)
+
+	for i := 1; i <= 3; i++ {
+		priorityQueue.Put(i)
+	}
+	putDone := make(chan error)
+	go func() {
+		putDone <- queue.Put(0)
+	}()
+	val, _ = queue.Take()
+	assert.Equal(t, 1, val)
+	assert.NoError(t, <-putDone)
+	val, _ = queue.Take()
+	assert.Equal(t, 0, val)
+
+	// Closed
+	priorityQueue.Close()
+	assert.Equal(t, true, priorityQueue.IsClosed())
+	assert.Equal(t, ErrQueueIsClosed, queue.Offer(1))
+	val, _ = queue.Take()
+	assert.Equal(t, 2, val)
+	val, _ = queue.Take()
+	assert.Equal(t, 3, val)
+	_, err = queue.Take()
+	assert.Equal(t, ErrQueueIsClosed, err)
+}
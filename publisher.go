@@ -6,9 +6,22 @@ import "sync"
 type PublisherDef[T any] struct {
 	subscribers []*Subscription[T]
 	subscribeM  sync.Mutex
-	subOn       *HandlerDef
+	subOn       Scheduler
 
-	origin *PublisherDef[T]
+	origin              *PublisherDef[T]
+	unsubscribeUpstream func()
+
+	// replayBufferSize The number of the latest values replayed to new subscribers(no replay if it's 0)
+	replayBufferSize int
+	replayBuffer     []T
+
+	isTerminated bool
+	terminateErr error
+
+	// onFirstSubscribe Called when the first subscriber subscribes(e.g. starting a source lazily)
+	onFirstSubscribe func()
+	// onLastUnsubscribe Called when the last subscriber unsubscribes(e.g. stopping a source)
+	onLastUnsubscribe func()
 }
 
 // New New a Publisher
@@ -23,53 +36,230 @@ func PublisherNewGenerics[T any]() *PublisherDef[T] {
 	return p
 }
 
+// ReplayPublisher New a Publisher replaying the latest bufferSize values to new subscribers
+func ReplayPublisher[T any](bufferSize int) *PublisherDef[T] {
+	p := PublisherNewGenerics[T]()
+	p.replayBufferSize = bufferSize
+
+	return p
+}
+
+// BehaviorPublisher New a Publisher always delivering its current value(initial at first) to new subscribers
+func BehaviorPublisher[T any](initial T) *PublisherDef[T] {
+	p := ReplayPublisher[T](1)
+	p.replayBuffer = []T{initial}
+
+	return p
+}
+
 // Map Map the Publisher in order to make a broadcasting chain
 func (publisherSelf *PublisherDef[T]) Map(fn func(T) T) *PublisherDef[T] {
-	next := PublisherNewGenerics[T]()
+	next := PublisherMap(publisherSelf, fn)
 	next.origin = publisherSelf
-	publisherSelf.Subscribe(Subscription[T]{
-		OnNext: func(in T) {
-			next.Publish(fn(in))
-		},
-	})
 
 	return next
 }
 
 // Subscribe Subscribe the Publisher by Subscription[T]
 func (publisherSelf *PublisherDef[T]) Subscribe(sub Subscription[T]) *Subscription[T] {
-	s := &sub
+	return publisherSelf.subscribe(&sub)
+}
+
+func (publisherSelf *PublisherDef[T]) subscribe(s *Subscription[T]) *Subscription[T] {
+	s.dispose = func() {
+		publisherSelf.Unsubscribe(s)
+	}
 
+	var replayed []T
+	isTerminated := false
+	var terminateErr error
+	var onFirstSubscribe func()
 	publisherSelf.doSubscribeSafe(func() {
-		publisherSelf.subscribers = append(publisherSelf.subscribers, s)
+		replayed = publisherSelf.replayBuffer
+		isTerminated, terminateErr = publisherSelf.isTerminated, publisherSelf.terminateErr
+		if !isTerminated {
+			// Items published during the replay are delivered after the replayed ones
+			if len(replayed) > 0 {
+				s.replay = &publisherReplay{isReplaying: true}
+			}
+			if len(publisherSelf.subscribers) == 0 {
+				onFirstSubscribe = publisherSelf.onFirstSubscribe
+			}
+			publisherSelf.subscribers = append(publisherSelf.subscribers, s)
+		}
 	})
+
+	if onFirstSubscribe != nil {
+		onFirstSubscribe()
+	}
+
+	for _, result := range replayed {
+		publisherSelf.doPublishTo(s, result)
+	}
+	if s.replay != nil {
+		s.replay.finish()
+	}
+	// Late subscribers get the terminal event immediately
+	if isTerminated {
+		publisherSelf.terminateTo(s, terminateErr)
+	}
 	return s
 }
 
-// SubscribeOn Subscribe the Publisher on the specific Handler
-func (publisherSelf *PublisherDef[T]) SubscribeOn(h *HandlerDef) *PublisherDef[T] {
-	publisherSelf.subOn = h
+// publisherReplay The delivery to a subscriber being replayed, the other deliveries are queued behind the replayed items
+type publisherReplay struct {
+	lock        sync.Mutex
+	isReplaying bool
+	pending     []func()
+}
+
+// queue Queue the delivery if it's still replaying(false if it should be delivered right away)
+func (replaySelf *publisherReplay) queue(deliver func()) bool {
+	replaySelf.lock.Lock()
+	defer replaySelf.lock.Unlock()
+
+	if replaySelf.isReplaying {
+		replaySelf.pending = append(replaySelf.pending, deliver)
+	}
+	return replaySelf.isReplaying
+}
+
+// finish Deliver the queued ones in order, then stop queueing
+func (replaySelf *publisherReplay) finish() {
+	for {
+		replaySelf.lock.Lock()
+		pending := replaySelf.pending
+		replaySelf.pending = nil
+		if len(pending) == 0 {
+			replaySelf.isReplaying = false
+		}
+		replaySelf.lock.Unlock()
+
+		if len(pending) == 0 {
+			return
+		}
+		for _, deliver := range pending {
+			deliver()
+		}
+	}
+}
+
+// SubscribeOn Deliver items of the Publisher to its subscribers on the specific Scheduler(e.g. Handler/WorkerPool)
+func (publisherSelf *PublisherDef[T]) SubscribeOn(scheduler Scheduler) *PublisherDef[T] {
+	// A nil *HandlerDef means no Scheduler
+	if h, ok := scheduler.(*HandlerDef); ok && h == nil {
+		scheduler = nil
+	}
+
+	publisherSelf.subOn = scheduler
 	return publisherSelf
 }
 
+// ObserveOn Make a chained Publisher publishing items & terminal events of the Publisher on the specific Scheduler
+//
+// NOTE: the order is kept only if the Scheduler runs functions in order(e.g. Handler/ImmediateScheduler).
+func (publisherSelf *PublisherDef[T]) ObserveOn(scheduler Scheduler) *PublisherDef[T] {
+	next := PublisherNewGenerics[T]()
+	schedule := func(fn func()) {
+		err := scheduler.Schedule(fn)
+		if err != nil {
+			next.Error(err)
+		}
+	}
+
+	s := publisherSelf.Subscribe(Subscription[T]{
+		OnNext: func(in T) {
+			schedule(func() {
+				next.Publish(in)
+			})
+		},
+		OnError: func(err error) {
+			schedule(func() {
+				next.Error(err)
+			})
+		},
+		OnComplete: func() {
+			schedule(next.Complete)
+		},
+	})
+	publisherLinkUpstream(next, func() {
+		publisherSelf.Unsubscribe(s)
+	})
+
+	return next
+}
+
+// ToChannel Subscribe the Publisher into a new channel with the buffer size,
+// the channel is closed when the Publisher terminates or the returned Subscription is unsubscribed
+//
+// NOTE: publishing blocks while the channel is full
+func (publisherSelf *PublisherDef[T]) ToChannel(bufferSize int) (<-chan T, *Subscription[T]) {
+	ch := make(chan T, bufferSize)
+	doneCh := make(chan bool)
+	var lock sync.RWMutex
+	isClosed := false
+	var once sync.Once
+	closeCh := func() {
+		once.Do(func() {
+			// Unblock the sending goroutine first
+			close(doneCh)
+			lock.Lock()
+			isClosed = true
+			close(ch)
+			lock.Unlock()
+		})
+	}
+
+	s := publisherSelf.subscribe(&Subscription[T]{
+		OnNext: func(in T) {
+			lock.RLock()
+			defer lock.RUnlock()
+			if isClosed {
+				return
+			}
+
+			select {
+			case ch <- in:
+			case <-doneCh:
+			}
+		},
+		OnError: func(error) {
+			closeCh()
+		},
+		OnComplete:    closeCh,
+		onUnsubscribe: closeCh,
+	})
+	return ch, s
+}
+
 // Unsubscribe Unsubscribe the publisher by the Subscription[T]
 func (publisherSelf *PublisherDef[T]) Unsubscribe(s *Subscription[T]) {
 	isAnyMatching := false
+	var onLastUnsubscribe func()
 
 	publisherSelf.doSubscribeSafe(func() {
 		subscribers := publisherSelf.subscribers
 		for i, v := range subscribers {
 			if v == s {
 				isAnyMatching = true
-				subscribers = append(subscribers[:i], subscribers[i+1:]...)
-				publisherSelf.subscribers = subscribers
+				// Make a new slice, Publish() may be iterating the old one
+				publisherSelf.subscribers = Concat(subscribers[:i:i], subscribers[i+1:])
+				if len(publisherSelf.subscribers) == 0 {
+					onLastUnsubscribe = publisherSelf.onLastUnsubscribe
+				}
 				break
 			}
 		}
 	})
 
+	if onLastUnsubscribe != nil {
+		onLastUnsubscribe()
+	}
 	// Delete subscriptions recursively
 	if isAnyMatching {
+		if s.onUnsubscribe != nil {
+			s.onUnsubscribe()
+		}
 		publisherSelf.Unsubscribe(s)
 	}
 }
@@ -78,20 +268,119 @@ func (publisherSelf *PublisherDef[T]) Unsubscribe(s *Subscription[T]) {
 func (publisherSelf *PublisherDef[T]) Publish(result T) {
 	var subscribers []*Subscription[T]
 	publisherSelf.doSubscribeSafe(func() {
+		if publisherSelf.isTerminated {
+			return
+		}
 		subscribers = publisherSelf.subscribers
+		if publisherSelf.replayBufferSize > 0 {
+			// Make a new slice, Subscribe() may be replaying the old one
+			replayBuffer := append(publisherSelf.replayBuffer[:len(publisherSelf.replayBuffer):len(publisherSelf.replayBuffer)], result)
+			if len(replayBuffer) > publisherSelf.replayBufferSize {
+				replayBuffer = replayBuffer[len(replayBuffer)-publisherSelf.replayBufferSize:]
+			}
+			publisherSelf.replayBuffer = replayBuffer
+		}
 	})
 
 	for _, s := range subscribers {
-		if s.OnNext != nil {
+		publisherSelf.publishTo(s, result)
+	}
+}
 
-			doSub := func() {
-				s.OnNext(result)
-			}
-			if publisherSelf.subOn != nil {
-				publisherSelf.subOn.Post(doSub)
-			} else {
-				doSub()
-			}
+// Error Terminate the Publisher with the error(OnError of subscribers will be called), and unsubscribe its upstream
+func (publisherSelf *PublisherDef[T]) Error(err error) {
+	publisherSelf.terminate(err)
+}
+
+// Complete Terminate the Publisher normally(OnComplete of subscribers will be called), and unsubscribe its upstream
+func (publisherSelf *PublisherDef[T]) Complete() {
+	publisherSelf.terminate(nil)
+}
+
+// IsTerminated Is the Publisher terminated by Error()/Complete()
+func (publisherSelf *PublisherDef[T]) IsTerminated() bool {
+	isTerminated := false
+	publisherSelf.doSubscribeSafe(func() {
+		isTerminated = publisherSelf.isTerminated
+	})
+	return isTerminated
+}
+
+func (publisherSelf *PublisherDef[T]) terminate(err error) {
+	var subscribers []*Subscription[T]
+	var unsubscribeUpstream func()
+	isTerminatedAlready := false
+	publisherSelf.doSubscribeSafe(func() {
+		if publisherSelf.isTerminated {
+			isTerminatedAlready = true
+			return
+		}
+		publisherSelf.isTerminated = true
+		publisherSelf.terminateErr = err
+		subscribers = publisherSelf.subscribers
+		publisherSelf.subscribers = nil
+		unsubscribeUpstream = publisherSelf.unsubscribeUpstream
+	})
+	if isTerminatedAlready {
+		return
+	}
+
+	for _, s := range subscribers {
+		publisherSelf.terminateTo(s, err)
+	}
+	if unsubscribeUpstream != nil {
+		unsubscribeUpstream()
+	}
+}
+
+func (publisherSelf *PublisherDef[T]) terminateTo(s *Subscription[T], err error) {
+	if s.replay != nil && s.replay.queue(func() {
+		publisherSelf.doTerminateTo(s, err)
+	}) {
+		return
+	}
+	publisherSelf.doTerminateTo(s, err)
+}
+
+func (publisherSelf *PublisherDef[T]) doTerminateTo(s *Subscription[T], err error) {
+	var doSub func()
+	if err != nil && s.OnError != nil {
+		doSub = func() {
+			s.OnError(err)
+		}
+	} else if err == nil && s.OnComplete != nil {
+		doSub = s.OnComplete
+	}
+	if doSub == nil {
+		return
+	}
+
+	if publisherSelf.subOn != nil {
+		publisherSelf.subOn.Schedule(doSub)
+	} else {
+		doSub()
+	}
+}
+
+func (publisherSelf *PublisherDef[T]) publishTo(s *Subscription[T], result T) {
+	if s.replay != nil && s.replay.queue(func() {
+		publisherSelf.doPublishTo(s, result)
+	}) {
+		return
+	}
+	publisherSelf.doPublishTo(s, result)
+}
+
+func (publisherSelf *PublisherDef[T]) doPublishTo(s *Subscription[T], result T) {
+	if s.OnNext != nil {
+
+		doSub := func() {
+			s.OnNext(result)
+		}
+		if publisherSelf.subOn != nil {
+			publisherSelf.subOn.Schedule(doSub)
+		} else {
+			doSub()
 		}
 	}
 }
@@ -0,0 +1,180 @@
+package fpgo
+
+import (
+	"errors"
+	"sync"
+)
+
+// Publisher Backpressure
+
+// ErrBackpressureOverflow The buffer of a BackpressureBuffer subscription is full
+var ErrBackpressureOverflow = errors.New("backpressure buffer overflow")
+
+// BackpressureStrategy How a subscription handles items published faster than it consumes
+type BackpressureStrategy int
+
+const (
+	// BackpressureBuffer Buffer up to BufferSize items, terminate the subscription with ErrBackpressureOverflow when it's full
+	BackpressureBuffer BackpressureStrategy = iota
+	// BackpressureDropOldest Drop the oldest buffered item when the buffer is full
+	BackpressureDropOldest
+	// BackpressureDropLatest Drop the incoming item when the buffer is full
+	BackpressureDropLatest
+	// BackpressureBlock Block the publishing goroutine until the buffer has space
+	BackpressureBlock
+	// BackpressureLatest Keep only the latest undelivered item
+	BackpressureLatest
+)
+
+// SubscribeOption Options of SubscribeWithOptions()
+type SubscribeOption struct {
+	// Backpressure The BackpressureStrategy
+	Backpressure BackpressureStrategy
+	// BufferSize The capacity of the buffer(1 if <= 0, ignored by BackpressureLatest)
+	BufferSize int
+}
+
+// SubscribeWithOptions Subscribe the Publisher by Subscription[T] delivered on its own goroutine with the backpressure option,
+// so a slow subscriber doesn't stall the Publisher
+func (publisherSelf *PublisherDef[T]) SubscribeWithOptions(sub Subscription[T], option SubscribeOption) *Subscription[T] {
+	mailbox := newBackpressureMailbox(sub, option)
+	var s *Subscription[T]
+	s = &Subscription[T]{
+		OnNext: func(in T) {
+			if mailbox.offer(in) == ErrBackpressureOverflow {
+				publisherSelf.Unsubscribe(s)
+			}
+		},
+		OnError: func(err error) {
+			mailbox.terminate(err)
+		},
+		OnComplete: func() {
+			mailbox.terminate(nil)
+		},
+		onUnsubscribe: mailbox.close,
+	}
+	go mailbox.run()
+
+	return publisherSelf.subscribe(s)
+}
+
+type backpressureMailbox[T any] struct {
+	lock   sync.Mutex
+	cond   *sync.Cond
+	sub    Subscription[T]
+	option SubscribeOption
+
+	items        []T
+	isTerminated bool
+	terminateErr error
+	isClosed     bool
+}
+
+func newBackpressureMailbox[T any](sub Subscription[T], option SubscribeOption) *backpressureMailbox[T] {
+	if option.BufferSize <= 0 {
+		option.BufferSize = 1
+	}
+	mailbox := &backpressureMailbox[T]{sub: sub, option: option}
+	mailbox.cond = sync.NewCond(&mailbox.lock)
+	return mailbox
+}
+
+func (mailboxSelf *backpressureMailbox[T]) offer(in T) error {
+	mailboxSelf.lock.Lock()
+	defer mailboxSelf.lock.Unlock()
+
+	if mailboxSelf.isClosed || mailboxSelf.isTerminated {
+		return nil
+	}
+
+	isFull := len(mailboxSelf.items) >= mailboxSelf.option.BufferSize
+	switch mailboxSelf.option.Backpressure {
+	case BackpressureDropOldest:
+		if isFull {
+			mailboxSelf.items = mailboxSelf.items[1:]
+		}
+	case BackpressureDropLatest:
+		if isFull {
+			return nil
+		}
+	case BackpressureBlock:
+		for len(mailboxSelf.items) >= mailboxSelf.option.BufferSize && !mailboxSelf.isClosed {
+			mailboxSelf.cond.Wait()
+		}
+		if mailboxSelf.isClosed {
+			return nil
+		}
+	case BackpressureLatest:
+		mailboxSelf.items = nil
+	default:
+		if isFull {
+			mailboxSelf.isTerminated = true
+			mailboxSelf.terminateErr = ErrBackpressureOverflow
+			mailboxSelf.cond.Broadcast()
+			return ErrBackpressureOverflow
+		}
+	}
+
+	mailboxSelf.items = append(mailboxSelf.items, in)
+	mailboxSelf.cond.Broadcast()
+	return nil
+}
+
+func (mailboxSelf *backpressureMailbox[T]) terminate(err error) {
+	mailboxSelf.lock.Lock()
+	defer mailboxSelf.lock.Unlock()
+
+	if mailboxSelf.isTerminated {
+		return
+	}
+	mailboxSelf.isTerminated = true
+	mailboxSelf.terminateErr = err
+	mailboxSelf.cond.Broadcast()
+}
+
+// close Stop delivering(unless a terminal event is pending, then the buffered items are delivered before it)
+func (mailboxSelf *backpressureMailbox[T]) close() {
+	mailboxSelf.lock.Lock()
+	defer mailboxSelf.lock.Unlock()
+
+	if mailboxSelf.isTerminated {
+		return
+	}
+	mailboxSelf.isClosed = true
+	mailboxSelf.items = nil
+	mailboxSelf.cond.Broadcast()
+}
+
+func (mailboxSelf *backpressureMailbox[T]) run() {
+	for {
+		mailboxSelf.lock.Lock()
+		for len(mailboxSelf.items) == 0 && !mailboxSelf.isTerminated && !mailboxSelf.isClosed {
+			mailboxSelf.cond.Wait()
+		}
+		if mailboxSelf.isClosed {
+			mailboxSelf.lock.Unlock()
+			return
+		}
+		if len(mailboxSelf.items) > 0 {
+			in := mailboxSelf.items[0]
+			mailboxSelf.items = mailboxSelf.items[1:]
+			// Wake up the blocked publishing goroutine
+			mailboxSelf.cond.Broadcast()
+			mailboxSelf.lock.Unlock()
+
+			if mailboxSelf.sub.OnNext != nil {
+				mailboxSelf.sub.OnNext(in)
+			}
+			continue
+		}
+		err := mailboxSelf.terminateErr
+		mailboxSelf.lock.Unlock()
+
+		if err != nil && mailboxSelf.sub.OnError != nil {
+			mailboxSelf.sub.OnError(err)
+		} else if err == nil && mailboxSelf.sub.OnComplete != nil {
+			mailboxSelf.sub.OnComplete()
+		}
+		return
+	}
+}
@@ -0,0 +1,82 @@
+package fpgo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func runSlowSubscriber(option SubscribeOption) ([]int, error) {
+	p := PublisherNewGenerics[int]()
+	started := make(chan bool)
+	release := make(chan bool)
+	done := make(chan bool)
+	var actual []int
+	var actualErr error
+	p.SubscribeWithOptions(Subscription[int]{
+		OnNext: func(in int) {
+			if in == 1 {
+				started <- true
+				<-release
+			}
+			actual = append(actual, in)
+		},
+		OnError: func(err error) {
+			actualErr = err
+			close(done)
+		},
+		OnComplete: func() {
+			close(done)
+		},
+	}, option)
+
+	p.Publish(1)
+	<-started
+	published := make(chan bool)
+	go func() {
+		for i := 2; i <= 4; i++ {
+			p.Publish(i)
+		}
+		close(published)
+	}()
+	if option.Backpressure != BackpressureBlock {
+		<-published
+	}
+	close(release)
+	<-published
+	p.Complete()
+	<-done
+
+	return actual, actualErr
+}
+
+func TestPublisherBackpressure(t *testing.T) {
+	var actual []int
+	var err error
+
+	actual, err = runSlowSubscriber(SubscribeOption{Backpressure: BackpressureDropLatest, BufferSize: 2})
+	assert.Equal(t, []int{1, 2, 3}, actual)
+	assert.Equal(t, nil, err)
+
+	actual, err = runSlowSubscriber(SubscribeOption{Backpressure: BackpressureDropOldest, BufferSize: 2})
+	assert.Equal(t, []int{1, 3, 4}, actual)
+	assert.Equal(t, nil, err)
+
+	actual, err = runSlowSubscriber(SubscribeOption{Backpressure: BackpressureLatest})
+	assert.Equal(t, []int{1, 4}, actual)
+	assert.Equal(t, nil, err)
+
+	actual, err = runSlowSubscriber(SubscribeOption{Backpressure: BackpressureBlock})
+	assert.Equal(t, []int{1, 2, 3, 4}, actual)
+	assert.Equal(t, nil, err)
+
+	actual, err = runSlowSubscriber(SubscribeOption{Backpressure: BackpressureBuffer, BufferSize: 2})
+	assert.Equal(t, []int{1, 2, 3}, actual)
+	assert.Equal(t, ErrBackpressureOverflow, err)
+
+	// Unsubscribe stops the delivering goroutine
+	p := PublisherNewGenerics[int]()
+	s := p.SubscribeWithOptions(Subscription[int]{}, SubscribeOption{Backpressure: BackpressureBlock})
+	p.Unsubscribe(s)
+	assert.Equal(t, 0, len(p.subscribers))
+}
@@ -0,0 +1,317 @@
+package fpgo
+
+import "sync"
+
+// Publisher Combinators
+
+// publisherSubscribeAll Subscribe the sources by Subscription(s) made by the index, returns the function to unsubscribe all of them
+func publisherSubscribeAll[T any](sources []*PublisherDef[T], subscriptionAt func(index int) Subscription[T]) func() {
+	subscriptions := make([]*Subscription[T], len(sources))
+	for i, source := range sources {
+		subscriptions[i] = source.Subscribe(subscriptionAt(i))
+	}
+
+	return func() {
+		for i, source := range sources {
+			source.Unsubscribe(subscriptions[i])
+		}
+	}
+}
+
+// publisherCompleteCounter Call onAllCompleted once after it's been called `total` times
+func publisherCompleteCounter(total int, onAllCompleted func()) func() {
+	var lock sync.Mutex
+	completed := 0
+	return func() {
+		lock.Lock()
+		completed++
+		isAllCompleted := completed == total
+		lock.Unlock()
+
+		if isAllCompleted {
+			onAllCompleted()
+		}
+	}
+}
+
+// MergePublishers Publish items from all the sources into one Publisher as they arrive
+//
+// It completes after all the sources complete, and it fails as soon as any of them fails.
+func MergePublishers[T any](sources ...*PublisherDef[T]) *PublisherDef[T] {
+	next := PublisherNewGenerics[T]()
+	if len(sources) == 0 {
+		next.Complete()
+		return next
+	}
+
+	onComplete := publisherCompleteCounter(len(sources), next.Complete)
+	publisherLinkUpstream(next, publisherSubscribeAll(sources, func(_ int) Subscription[T] {
+		return Subscription[T]{
+			OnNext:     next.Publish,
+			OnError:    next.Error,
+			OnComplete: onComplete,
+		}
+	}))
+
+	return next
+}
+
+// ConcatPublishers Publish items from the sources one after another, the next source is subscribed after the current one completes
+//
+// NOTE: Publishers are hot, items published by a source before it's subscribed are not delivered(except replayed ones).
+func ConcatPublishers[T any](sources ...*PublisherDef[T]) *PublisherDef[T] {
+	next := PublisherNewGenerics[T]()
+
+	var lock sync.Mutex
+	current := 0
+	var unsubscribeCurrent func()
+	var subscribeAt func(index int)
+	subscribeAt = func(index int) {
+		if next.IsTerminated() {
+			return
+		}
+		if index >= len(sources) {
+			next.Complete()
+			return
+		}
+
+		lock.Lock()
+		current = index
+		lock.Unlock()
+
+		source := sources[index]
+		s := source.Subscribe(Subscription[T]{
+			OnNext:  next.Publish,
+			OnError: next.Error,
+			OnComplete: func() {
+				subscribeAt(index + 1)
+			},
+		})
+
+		lock.Lock()
+		// Not switched to the next source during Subscribe()
+		if current == index {
+			unsubscribeCurrent = func() {
+				source.Unsubscribe(s)
+			}
+		}
+		lock.Unlock()
+	}
+
+	subscribeAt(0)
+	publisherLinkUpstream(next, func() {
+		lock.Lock()
+		fn := unsubscribeCurrent
+		lock.Unlock()
+
+		if fn != nil {
+			fn()
+		}
+	})
+
+	return next
+}
+
+// ZipPublishers Pair items of two Publishers by their order(items are queued until the other side arrives)
+//
+// It completes once either of them has completed and all of its items are paired(no more pairs could be made).
+func ZipPublishers[A any, B any](publisherA *PublisherDef[A], publisherB *PublisherDef[B]) *PublisherDef[Tuple2[A, B]] {
+	var lock sync.Mutex
+	var queueA []A
+	var queueB []B
+	isCompletedA, isCompletedB := false, false
+	next := PublisherNewGenerics[Tuple2[A, B]]()
+	// popPairs should be called with the lock, isDone means no more pairs could be made
+	popPairs := func() (pairs []Tuple2[A, B], isDone bool) {
+		for len(queueA) > 0 && len(queueB) > 0 {
+			pairs = append(pairs, NewTuple2(queueA[0], queueB[0]))
+			queueA, queueB = queueA[1:], queueB[1:]
+		}
+		return pairs, (isCompletedA && len(queueA) == 0) || (isCompletedB && len(queueB) == 0)
+	}
+	update := func(fn func()) {
+		lock.Lock()
+		fn()
+		pairs, isDone := popPairs()
+		lock.Unlock()
+
+		for _, pair := range pairs {
+			next.Publish(pair)
+		}
+		if isDone {
+			next.Complete()
+		}
+	}
+
+	unsubscribeA := publisherSubscribeAll([]*PublisherDef[A]{publisherA}, func(_ int) Subscription[A] {
+		return Subscription[A]{
+			OnNext: func(in A) {
+				update(func() {
+					queueA = append(queueA, in)
+				})
+			},
+			OnError: next.Error,
+			OnComplete: func() {
+				update(func() {
+					isCompletedA = true
+				})
+			},
+		}
+	})
+	unsubscribeB := publisherSubscribeAll([]*PublisherDef[B]{publisherB}, func(_ int) Subscription[B] {
+		return Subscription[B]{
+			OnNext: func(in B) {
+				update(func() {
+					queueB = append(queueB, in)
+				})
+			},
+			OnError: next.Error,
+			OnComplete: func() {
+				update(func() {
+					isCompletedB = true
+				})
+			},
+		}
+	})
+	publisherLinkUpstream(next, func() {
+		unsubscribeA()
+		unsubscribeB()
+	})
+
+	return next
+}
+
+// CombineLatest Publish the latest items of both Publishers whenever either of them publishes(after both have published)
+//
+// It completes after both of them complete.
+func CombineLatest[A any, B any](publisherA *PublisherDef[A], publisherB *PublisherDef[B]) *PublisherDef[Tuple2[A, B]] {
+	var lock sync.Mutex
+	var latest Tuple2[A, B]
+	hasA, hasB := false, false
+	next := PublisherNewGenerics[Tuple2[A, B]]()
+	onComplete := publisherCompleteCounter(2, next.Complete)
+
+	unsubscribeA := publisherSubscribeAll([]*PublisherDef[A]{publisherA}, func(_ int) Subscription[A] {
+		return Subscription[A]{
+			OnNext: func(in A) {
+				lock.Lock()
+				latest.V1, hasA = in, true
+				result, isReady := latest, hasB
+				lock.Unlock()
+
+				if isReady {
+					next.Publish(result)
+				}
+			},
+			OnError:    next.Error,
+			OnComplete: onComplete,
+		}
+	})
+	unsubscribeB := publisherSubscribeAll([]*PublisherDef[B]{publisherB}, func(_ int) Subscription[B] {
+		return Subscription[B]{
+			OnNext: func(in B) {
+				lock.Lock()
+				latest.V2, hasB = in, true
+				result, isReady := latest, hasA
+				lock.Unlock()
+
+				if isReady {
+					next.Publish(result)
+				}
+			},
+			OnError:    next.Error,
+			OnComplete: onComplete,
+		}
+	})
+	publisherLinkUpstream(next, func() {
+		unsubscribeA()
+		unsubscribeB()
+	})
+
+	return next
+}
+
+// PublisherSample Publish the latest item of the Publisher whenever the trigger publishes(only if there's a new item since the last sampling)
+//
+// It completes when the Publisher completes.
+func PublisherSample[T any, U any](publisherSelf *PublisherDef[T], trigger *PublisherDef[U]) *PublisherDef[T] {
+	var lock sync.Mutex
+	var latest T
+	hasLatest := false
+	next := PublisherNewGenerics[T]()
+
+	unsubscribeSource := publisherSubscribeAll([]*PublisherDef[T]{publisherSelf}, func(_ int) Subscription[T] {
+		return Subscription[T]{
+			OnNext: func(in T) {
+				lock.Lock()
+				latest, hasLatest = in, true
+				lock.Unlock()
+			},
+			OnError:    next.Error,
+			OnComplete: next.Complete,
+		}
+	})
+	unsubscribeTrigger := publisherSubscribeAll([]*PublisherDef[U]{trigger}, func(_ int) Subscription[U] {
+		return Subscription[U]{
+			OnNext: func(U) {
+				lock.Lock()
+				result, isSampled := latest, hasLatest
+				hasLatest = false
+				lock.Unlock()
+
+				if isSampled {
+					next.Publish(result)
+				}
+			},
+			OnError: next.Error,
+		}
+	})
+	publisherLinkUpstream(next, func() {
+		unsubscribeSource()
+		unsubscribeTrigger()
+	})
+
+	return next
+}
+
+// PublisherWithLatestFrom Pair each item of the Publisher with the latest item of the other one(items are dropped until the other one has published)
+//
+// It completes when the Publisher completes.
+func PublisherWithLatestFrom[T any, U any](publisherSelf *PublisherDef[T], other *PublisherDef[U]) *PublisherDef[Tuple2[T, U]] {
+	var lock sync.Mutex
+	var latest U
+	hasLatest := false
+	next := PublisherNewGenerics[Tuple2[T, U]]()
+
+	unsubscribeOther := publisherSubscribeAll([]*PublisherDef[U]{other}, func(_ int) Subscription[U] {
+		return Subscription[U]{
+			OnNext: func(in U) {
+				lock.Lock()
+				latest, hasLatest = in, true
+				lock.Unlock()
+			},
+			OnError: next.Error,
+		}
+	})
+	unsubscribeSource := publisherSubscribeAll([]*PublisherDef[T]{publisherSelf}, func(_ int) Subscription[T] {
+		return Subscription[T]{
+			OnNext: func(in T) {
+				lock.Lock()
+				result, isReady := NewTuple2(in, latest), hasLatest
+				lock.Unlock()
+
+				if isReady {
+					next.Publish(result)
+				}
+			},
+			OnError:    next.Error,
+			OnComplete: next.Complete,
+		}
+	})
+	publisherLinkUpstream(next, func() {
+		unsubscribeSource()
+		unsubscribeOther()
+	})
+
+	return next
+}
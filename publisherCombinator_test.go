@@ -0,0 +1,181 @@
+package fpgo
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPublisherCombinators(t *testing.T) {
+	p1 := PublisherNewGenerics[int]()
+	p2 := PublisherNewGenerics[int]()
+	merged := MergePublishers(p1, p2)
+	mergedActual := collectPublisher(merged)
+	p1.Publish(1)
+	p2.Publish(2)
+	p1.Publish(3)
+	assert.Equal(t, []int{1, 2, 3}, *mergedActual)
+	merged.Complete()
+	assert.Equal(t, 0, len(p1.subscribers))
+	assert.Equal(t, 0, len(p2.subscribers))
+
+	pa := PublisherNewGenerics[int]()
+	pb := PublisherNewGenerics[string]()
+	zipped := collectPublisher(ZipPublishers(pa, pb))
+	combined := collectPublisher(CombineLatest(pa, pb))
+	pa.Publish(1)
+	pa.Publish(2)
+	assert.Equal(t, []Tuple2[int, string]{}, *zipped)
+	assert.Equal(t, []Tuple2[int, string]{}, *combined)
+	pb.Publish("a")
+	pb.Publish("b")
+	pb.Publish("c")
+	pa.Publish(3)
+	assert.Equal(t, []Tuple2[int, string]{
+		NewTuple2(1, "a"),
+		NewTuple2(2, "b"),
+		NewTuple2(3, "c"),
+	}, *zipped)
+	assert.Equal(t, []Tuple2[int, string]{
+		NewTuple2(2, "a"),
+		NewTuple2(2, "b"),
+		NewTuple2(2, "c"),
+		NewTuple2(3, "c"),
+	}, *combined)
+}
+
+func TestConcatPublishers(t *testing.T) {
+	p1 := PublisherNewGenerics[int]()
+	p2 := ReplayPublisher[int](1)
+	concatenated := ConcatPublishers(p1, p2)
+	concatenatedActual := collectPublisher(concatenated)
+	p1.Publish(1)
+	p2.Publish(10)
+	p2.Publish(20)
+	p1.Publish(2)
+	// Only the current source is subscribed
+	assert.Equal(t, 0, len(p2.subscribers))
+	p1.Complete()
+	p2.Publish(30)
+	assert.Equal(t, false, concatenated.IsTerminated())
+	p2.Complete()
+	assert.Equal(t, []int{1, 2, 20, 30}, *concatenatedActual)
+	assert.Equal(t, true, concatenated.IsTerminated())
+
+	// Completed sources are skipped, no sources complete immediately
+	completed := PublisherNewGenerics[int]()
+	completed.Complete()
+	p3 := PublisherNewGenerics[int]()
+	concatenated = ConcatPublishers(completed, p3)
+	concatenatedActual = collectPublisher(concatenated)
+	p3.Publish(1)
+	assert.Equal(t, []int{1}, *concatenatedActual)
+	assert.Equal(t, true, ConcatPublishers[int]().IsTerminated())
+
+	// A failed source fails it, the later ones are never subscribed
+	p1 = PublisherNewGenerics[int]()
+	p2 = PublisherNewGenerics[int]()
+	var actualErr error
+	ConcatPublishers(p1, p2).Subscribe(Subscription[int]{
+		OnError: func(err error) {
+			actualErr = err
+		},
+	})
+	p1.Error(errors.New("failed"))
+	assert.EqualError(t, actualErr, "failed")
+	assert.Equal(t, 0, len(p2.subscribers))
+
+	// Completing the result unsubscribes the current source
+	p1 = PublisherNewGenerics[int]()
+	p2 = PublisherNewGenerics[int]()
+	concatenated = ConcatPublishers(p1, p2)
+	p1.Complete()
+	assert.Equal(t, 1, len(p2.subscribers))
+	concatenated.Complete()
+	assert.Equal(t, 0, len(p2.subscribers))
+}
+
+func TestPublisherCombinatorsTermination(t *testing.T) {
+	p1 := PublisherNewGenerics[int]()
+	p2 := PublisherNewGenerics[int]()
+	merged := MergePublishers(p1, p2)
+	p1.Complete()
+	assert.Equal(t, false, merged.IsTerminated())
+	p2.Complete()
+	assert.Equal(t, true, merged.IsTerminated())
+	assert.Equal(t, true, MergePublishers[int]().IsTerminated())
+
+	p1 = PublisherNewGenerics[int]()
+	p2 = PublisherNewGenerics[int]()
+	var actualErr error
+	MergePublishers(p1, p2).Subscribe(Subscription[int]{
+		OnError: func(err error) {
+			actualErr = err
+		},
+	})
+	p2.Error(errors.New("failed"))
+	assert.EqualError(t, actualErr, "failed")
+	// Unsubscribed the other source
+	assert.Equal(t, 0, len(p1.subscribers))
+
+	// Zip completes as soon as either completes(with nothing queued), CombineLatest after both
+	pa := PublisherNewGenerics[int]()
+	pb := PublisherNewGenerics[string]()
+	zipped := ZipPublishers(pa, pb)
+	combined := CombineLatest(pa, pb)
+	pa.Complete()
+	assert.Equal(t, true, zipped.IsTerminated())
+	assert.Equal(t, false, combined.IsTerminated())
+	pb.Complete()
+	assert.Equal(t, true, combined.IsTerminated())
+
+	// Zip completes after the queued items of the completed side are paired
+	pa = PublisherNewGenerics[int]()
+	pb = PublisherNewGenerics[string]()
+	zipped = ZipPublishers(pa, pb)
+	pairs := collectPublisher(zipped)
+	pa.Publish(1)
+	pa.Publish(2)
+	pa.Complete()
+	assert.Equal(t, false, zipped.IsTerminated())
+	pb.Publish("a")
+	assert.Equal(t, false, zipped.IsTerminated())
+	pb.Publish("b")
+	assert.Equal(t, true, zipped.IsTerminated())
+	assert.Equal(t, []Tuple2[int, string]{NewTuple2(1, "a"), NewTuple2(2, "b")}, *pairs)
+	// Unsubscribed the sources
+	assert.Equal(t, 0, len(pb.subscribers))
+}
+
+func TestPublisherSampleWithLatestFrom(t *testing.T) {
+	p := PublisherNewGenerics[int]()
+	trigger := PublisherNewGenerics[bool]()
+	sampled := PublisherSample(p, trigger)
+	actual := collectPublisher(sampled)
+	trigger.Publish(true)
+	p.Publish(1)
+	p.Publish(2)
+	trigger.Publish(true)
+	trigger.Publish(true)
+	p.Publish(3)
+	trigger.Publish(true)
+	assert.Equal(t, []int{2, 3}, *actual)
+	p.Complete()
+	assert.Equal(t, true, sampled.IsTerminated())
+	assert.Equal(t, 0, len(trigger.subscribers))
+
+	p = PublisherNewGenerics[int]()
+	other := PublisherNewGenerics[string]()
+	enriched := collectPublisher(PublisherWithLatestFrom(p, other))
+	p.Publish(1)
+	other.Publish("a")
+	other.Publish("b")
+	p.Publish(2)
+	p.Publish(3)
+	other.Publish("c")
+	assert.Equal(t, []Tuple2[int, string]{
+		NewTuple2(2, "b"),
+		NewTuple2(3, "b"),
+	}, *enriched)
+}
@@ -0,0 +1,155 @@
+package fpgo
+
+import "sync"
+
+// Publisher Flattening Operators
+
+type publisherFlattenMode int
+
+const (
+	publisherFlattenConcat publisherFlattenMode = iota
+	publisherFlattenSwitch
+	publisherFlattenExhaust
+)
+
+// PublisherConcatMap Map each item to an inner Publisher and publish their items one inner Publisher after another
+//
+// Items arriving while an inner Publisher is active are queued, the next inner Publisher is subscribed after the current one completes.
+func PublisherConcatMap[T any, R any](publisherSelf *PublisherDef[T], fn func(T) *PublisherDef[R]) *PublisherDef[R] {
+	return publisherFlatten(publisherSelf, fn, publisherFlattenConcat)
+}
+
+// PublisherSwitchMap Map each item to an inner Publisher and publish items of the latest one only(the previous one is unsubscribed)
+func PublisherSwitchMap[T any, R any](publisherSelf *PublisherDef[T], fn func(T) *PublisherDef[R]) *PublisherDef[R] {
+	return publisherFlatten(publisherSelf, fn, publisherFlattenSwitch)
+}
+
+// PublisherExhaustMap Map each item to an inner Publisher and publish its items, items arriving while an inner Publisher is active are dropped
+func PublisherExhaustMap[T any, R any](publisherSelf *PublisherDef[T], fn func(T) *PublisherDef[R]) *PublisherDef[R] {
+	return publisherFlatten(publisherSelf, fn, publisherFlattenExhaust)
+}
+
+func publisherFlatten[T any, R any](upstream *PublisherDef[T], fn func(T) *PublisherDef[R], mode publisherFlattenMode) *PublisherDef[R] {
+	next := PublisherNewGenerics[R]()
+
+	var lock sync.Mutex
+	var queue []T
+	isActive := false
+	isUpstreamCompleted := false
+	generation := 0
+	var unsubscribeInner func()
+
+	// subscribeInner should be called after isActive is set
+	var subscribeInner func(in T)
+	subscribeInner = func(in T) {
+		lock.Lock()
+		generation++
+		current := generation
+		lock.Unlock()
+		isCurrent := func() bool {
+			lock.Lock()
+			defer lock.Unlock()
+			return generation == current
+		}
+
+		inner := fn(in)
+		s := inner.Subscribe(Subscription[R]{
+			OnNext: func(result R) {
+				if isCurrent() {
+					next.Publish(result)
+				}
+			},
+			OnError: func(err error) {
+				if isCurrent() {
+					next.Error(err)
+				}
+			},
+			OnComplete: func() {
+				lock.Lock()
+				if generation != current {
+					lock.Unlock()
+					return
+				}
+				unsubscribeInner = nil
+				var nextIn T
+				hasNext := len(queue) > 0
+				if hasNext {
+					nextIn = queue[0]
+					queue = queue[1:]
+				}
+				isActive = hasNext
+				isDone := !hasNext && isUpstreamCompleted
+				lock.Unlock()
+
+				if hasNext {
+					subscribeInner(nextIn)
+				} else if isDone {
+					next.Complete()
+				}
+			},
+		})
+
+		lock.Lock()
+		// Still active(not completed during Subscribe())
+		if generation == current && isActive {
+			unsubscribeInner = func() {
+				inner.Unsubscribe(s)
+			}
+		}
+		lock.Unlock()
+	}
+
+	s := upstream.Subscribe(Subscription[T]{
+		OnNext: func(in T) {
+			lock.Lock()
+			if isActive {
+				switch mode {
+				case publisherFlattenConcat:
+					queue = append(queue, in)
+					lock.Unlock()
+					return
+				case publisherFlattenExhaust:
+					lock.Unlock()
+					return
+				}
+			}
+			isActive = true
+			unsubscribePrevious := unsubscribeInner
+			unsubscribeInner = nil
+			// Outdate the previous inner Publisher
+			generation++
+			lock.Unlock()
+
+			if unsubscribePrevious != nil {
+				unsubscribePrevious()
+			}
+			subscribeInner(in)
+		},
+		OnError: next.Error,
+		OnComplete: func() {
+			lock.Lock()
+			isUpstreamCompleted = true
+			isDone := !isActive && len(queue) == 0
+			lock.Unlock()
+
+			if isDone {
+				next.Complete()
+			}
+		},
+	})
+	publisherLinkUpstream(next, func() {
+		upstream.Unsubscribe(s)
+
+		lock.Lock()
+		unsubscribeCurrent := unsubscribeInner
+		unsubscribeInner = nil
+		generation++
+		lock.Unlock()
+
+		if unsubscribeCurrent != nil {
+			unsubscribeCurrent()
+		}
+	})
+
+	return next
+}
@@ -0,0 +1,75 @@
+package fpgo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPublisherFlatten(t *testing.T) {
+	var inners map[int]*PublisherDef[string]
+	fn := func(in int) *PublisherDef[string] {
+		inner := PublisherNewGenerics[string]()
+		inners[in] = inner
+		return inner
+	}
+
+	// ConcatMap
+	inners = map[int]*PublisherDef[string]{}
+	p := PublisherNewGenerics[int]()
+	concatenated := PublisherConcatMap(p, fn)
+	actual := collectPublisher(concatenated)
+	p.Publish(1)
+	p.Publish(2)
+	assert.Equal(t, 1, len(inners))
+	inners[1].Publish("1a")
+	inners[1].Complete()
+	assert.Equal(t, 2, len(inners))
+	inners[2].Publish("2a")
+	p.Complete()
+	assert.Equal(t, false, concatenated.IsTerminated())
+	inners[2].Complete()
+	assert.Equal(t, []string{"1a", "2a"}, *actual)
+	assert.Equal(t, true, concatenated.IsTerminated())
+
+	// SwitchMap
+	inners = map[int]*PublisherDef[string]{}
+	p = PublisherNewGenerics[int]()
+	switched := PublisherSwitchMap(p, fn)
+	actual = collectPublisher(switched)
+	p.Publish(1)
+	inners[1].Publish("1a")
+	p.Publish(2)
+	inners[1].Publish("1b")
+	inners[2].Publish("2a")
+	assert.Equal(t, 0, len(inners[1].subscribers))
+	assert.Equal(t, []string{"1a", "2a"}, *actual)
+	switched.Complete()
+	assert.Equal(t, 0, len(inners[2].subscribers))
+	assert.Equal(t, 0, len(p.subscribers))
+
+	// ExhaustMap
+	inners = map[int]*PublisherDef[string]{}
+	p = PublisherNewGenerics[int]()
+	actual = collectPublisher(PublisherExhaustMap(p, fn))
+	p.Publish(1)
+	p.Publish(2)
+	inners[1].Publish("1a")
+	inners[1].Complete()
+	p.Publish(3)
+	inners[3].Publish("3a")
+	assert.Equal(t, 2, len(inners))
+	assert.Equal(t, []string{"1a", "3a"}, *actual)
+
+	// Completed inner Publishers
+	p = PublisherNewGenerics[int]()
+	actual = collectPublisher(PublisherConcatMap(p, func(in int) *PublisherDef[string] {
+		inner := ReplayPublisher[string](1)
+		inner.Publish(string(rune('a' + in)))
+		inner.Complete()
+		return inner
+	}))
+	p.Publish(0)
+	p.Publish(1)
+	assert.Equal(t, []string{"a", "b"}, *actual)
+}
@@ -0,0 +1,488 @@
+package fpgo
+
+import (
+	"sync"
+	"time"
+)
+
+// Publisher Operators
+
+// TimingOption Options for time-based Publisher operators(Debounce/Throttle)
+type TimingOption struct {
+	// Leading Emit the first item of a burst immediately
+	Leading bool
+	// Trailing Emit the latest item at the end of the duration
+	Trailing bool
+	// TimeScheduler Schedule the timers(DefaultTimeScheduler if nil)
+	TimeScheduler TimeScheduler
+}
+
+func (option TimingOption) getTimeScheduler() TimeScheduler {
+	if option.TimeScheduler == nil {
+		return DefaultTimeScheduler
+	}
+	return option.TimeScheduler
+}
+
+// publisherChain New a Publisher chained from the upstream one, onNext decides what to publish into the next one
+//
+// Terminal events of the upstream are propagated to the next one.
+func publisherChain[T any, R any](upstream *PublisherDef[T], onNext func(next *PublisherDef[R], in T)) *PublisherDef[R] {
+	return publisherChainWithComplete(upstream, onNext, func(next *PublisherDef[R]) {
+		next.Complete()
+	})
+}
+
+// publisherChainWithComplete publisherChain with onComplete(it should call next.Complete()) for flushing states before completion
+func publisherChainWithComplete[T any, R any](upstream *PublisherDef[T], onNext func(next *PublisherDef[R], in T), onComplete func(next *PublisherDef[R])) *PublisherDef[R] {
+	next := PublisherNewGenerics[R]()
+
+	s := upstream.Subscribe(Subscription[T]{
+		OnNext: func(in T) {
+			onNext(next, in)
+		},
+		OnError: next.Error,
+		OnComplete: func() {
+			onComplete(next)
+		},
+	})
+	publisherLinkUpstream(next, func() {
+		upstream.Unsubscribe(s)
+	})
+
+	return next
+}
+
+// publisherLinkUpstream Set the function unsubscribing the upstream(s) of next(called right away if next has been terminated)
+func publisherLinkUpstream[R any](next *PublisherDef[R], unsubscribeUpstream func()) {
+	isTerminated := false
+	next.doSubscribeSafe(func() {
+		next.unsubscribeUpstream = unsubscribeUpstream
+		isTerminated = next.isTerminated
+	})
+
+	// Terminated during Subscribe()(e.g. Take() completed by replayed items)
+	if isTerminated {
+		unsubscribeUpstream()
+	}
+}
+
+// PublisherMap Map the Publisher in order to make a broadcasting chain(the result type could be different)
+func PublisherMap[T any, R any](publisherSelf *PublisherDef[T], fn func(T) R) *PublisherDef[R] {
+	return publisherChain(publisherSelf, func(next *PublisherDef[R], in T) {
+		next.Publish(fn(in))
+	})
+}
+
+// PublisherScan Accumulate items by fn from the memo and publish every intermediate result(the result type could be different)
+func PublisherScan[T any, R any](publisherSelf *PublisherDef[T], fn ReducerFunctor[T, R], memo R) *PublisherDef[R] {
+	var lock sync.Mutex
+	return publisherChain(publisherSelf, func(next *PublisherDef[R], in T) {
+		lock.Lock()
+		memo = fn(memo, in)
+		result := memo
+		lock.Unlock()
+
+		next.Publish(result)
+	})
+}
+
+// Filter Filter items of the Publisher by the predicate in order to make a broadcasting chain
+func (publisherSelf *PublisherDef[T]) Filter(fn Predicate[T]) *PublisherDef[T] {
+	return publisherChain(publisherSelf, func(next *PublisherDef[T], in T) {
+		if fn(in) {
+			next.Publish(in)
+		}
+	})
+}
+
+// Scan Accumulate items by fn from the memo and publish every intermediate result
+func (publisherSelf *PublisherDef[T]) Scan(fn ReducerFunctor[T, T], memo T) *PublisherDef[T] {
+	return PublisherScan(publisherSelf, fn, memo)
+}
+
+// Take Publish only the first n items, then complete and unsubscribe the upstream
+func (publisherSelf *PublisherDef[T]) Take(n int) *PublisherDef[T] {
+	var lock sync.Mutex
+	count := 0
+	return publisherChain(publisherSelf, func(next *PublisherDef[T], in T) {
+		lock.Lock()
+		if count >= n {
+			lock.Unlock()
+			return
+		}
+		count++
+		isLast := count >= n
+		lock.Unlock()
+
+		next.Publish(in)
+		if isLast {
+			next.Complete()
+		}
+	})
+}
+
+// Skip Skip the first n items and publish the rest
+func (publisherSelf *PublisherDef[T]) Skip(n int) *PublisherDef[T] {
+	var lock sync.Mutex
+	count := 0
+	return publisherChain(publisherSelf, func(next *PublisherDef[T], in T) {
+		lock.Lock()
+		if count < n {
+			count++
+			lock.Unlock()
+			return
+		}
+		lock.Unlock()
+
+		next.Publish(in)
+	})
+}
+
+// DistinctUntilChanged Publish only the items different from the previous one by eq(the first item is always published)
+func (publisherSelf *PublisherDef[T]) DistinctUntilChanged(eq Eq[T]) *PublisherDef[T] {
+	var lock sync.Mutex
+	var previous T
+	hasPrevious := false
+	return publisherChain(publisherSelf, func(next *PublisherDef[T], in T) {
+		lock.Lock()
+		isChanged := !hasPrevious || !eq(previous, in)
+		previous, hasPrevious = in, true
+		lock.Unlock()
+
+		if isChanged {
+			next.Publish(in)
+		}
+	})
+}
+
+// PublisherPairwise Publish each item paired with the previous one as Tuple2(previous, current), starting from the second item
+func PublisherPairwise[T any](publisherSelf *PublisherDef[T]) *PublisherDef[Tuple2[T, T]] {
+	var lock sync.Mutex
+	var previous T
+	hasPrevious := false
+	return publisherChain(publisherSelf, func(next *PublisherDef[Tuple2[T, T]], in T) {
+		lock.Lock()
+		pair := NewTuple2(previous, in)
+		isPaired := hasPrevious
+		previous, hasPrevious = in, true
+		lock.Unlock()
+
+		if isPaired {
+			next.Publish(pair)
+		}
+	})
+}
+
+// Debounce Publish the latest item only after there's no new item for the duration(trailing)
+func (publisherSelf *PublisherDef[T]) Debounce(duration time.Duration) *PublisherDef[T] {
+	return publisherSelf.DebounceWithOption(duration, TimingOption{Trailing: true})
+}
+
+// DebounceWithOption Debounce with leading/trailing emission options & the TimeScheduler
+func (publisherSelf *PublisherDef[T]) DebounceWithOption(duration time.Duration, option TimingOption) *PublisherDef[T] {
+	timeScheduler := option.getTimeScheduler()
+
+	var lock sync.Mutex
+	var timer TimerHandle
+	var pending T
+	hasPending := false
+	generation := 0
+	return publisherChainWithComplete(publisherSelf, func(next *PublisherDef[T], in T) {
+		lock.Lock()
+		isBurstStart := timer == nil
+		if timer != nil {
+			timer.Stop()
+		}
+		generation++
+		currentGeneration := generation
+		isLeadingEmitted := isBurstStart && option.Leading
+		pending, hasPending = in, !isLeadingEmitted
+		timer = timeScheduler.AfterFunc(duration, func() {
+			lock.Lock()
+			// Outdated timer
+			if generation != currentGeneration {
+				lock.Unlock()
+				return
+			}
+			timer = nil
+			val, isTrailingEmitted := pending, hasPending && option.Trailing
+			hasPending = false
+			lock.Unlock()
+
+			if isTrailingEmitted {
+				next.Publish(val)
+			}
+		})
+		lock.Unlock()
+
+		if isLeadingEmitted {
+			next.Publish(in)
+		}
+	}, func(next *PublisherDef[T]) {
+		lock.Lock()
+		if timer != nil {
+			timer.Stop()
+			timer = nil
+		}
+		generation++
+		val, isTrailingEmitted := pending, hasPending && option.Trailing
+		hasPending = false
+		lock.Unlock()
+
+		// Flush the pending item before completion
+		if isTrailingEmitted {
+			next.Publish(val)
+		}
+		next.Complete()
+	})
+}
+
+// Throttle Publish the first item and then ignore items for the duration(leading)
+func (publisherSelf *PublisherDef[T]) Throttle(duration time.Duration) *PublisherDef[T] {
+	return publisherSelf.ThrottleWithOption(duration, TimingOption{Leading: true})
+}
+
+// ThrottleWithOption Throttle with leading/trailing emission options & the TimeScheduler
+//
+// NOTE: the pending trailing item is flushed before completion.
+func (publisherSelf *PublisherDef[T]) ThrottleWithOption(duration time.Duration, option TimingOption) *PublisherDef[T] {
+	timeScheduler := option.getTimeScheduler()
+
+	var lock sync.Mutex
+	var pending T
+	hasPending := false
+	// The timer of the current window(nil if it's not in a window)
+	var timer TimerHandle
+	isCompleted := false
+	var next *PublisherDef[T]
+	// startWindow should be called with the lock
+	var startWindow func()
+	startWindow = func() {
+		timer = timeScheduler.AfterFunc(duration, func() {
+			lock.Lock()
+			// Stopped by the termination
+			if isCompleted || next.IsTerminated() {
+				timer = nil
+				lock.Unlock()
+				return
+			}
+			if option.Trailing && hasPending {
+				val := pending
+				hasPending = false
+				// The trailing emission starts a new window
+				startWindow()
+				lock.Unlock()
+
+				next.Publish(val)
+				return
+			}
+			timer = nil
+			lock.Unlock()
+		})
+	}
+	next = publisherChainWithComplete(publisherSelf, func(next *PublisherDef[T], in T) {
+		lock.Lock()
+		if timer == nil {
+			startWindow()
+			if option.Leading {
+				lock.Unlock()
+
+				next.Publish(in)
+				return
+			}
+		}
+		pending, hasPending = in, true
+		lock.Unlock()
+	}, func(next *PublisherDef[T]) {
+		lock.Lock()
+		isCompleted = true
+		if timer != nil {
+			timer.Stop()
+			timer = nil
+		}
+		val, isTrailingEmitted := pending, hasPending && option.Trailing
+		hasPending = false
+		lock.Unlock()
+
+		// Flush the pending item before completion
+		if isTrailingEmitted {
+			next.Publish(val)
+		}
+		next.Complete()
+	})
+
+	return next
+}
+
+// PublisherBufferCount Collect items into batches of n items and publish each full batch
+func PublisherBufferCount[T any](publisherSelf *PublisherDef[T], n int) *PublisherDef[[]T] {
+	var lock sync.Mutex
+	buffer := make([]T, 0, n)
+	return publisherChainWithComplete(publisherSelf, func(next *PublisherDef[[]T], in T) {
+		lock.Lock()
+		buffer = append(buffer, in)
+		if len(buffer) < n {
+			lock.Unlock()
+			return
+		}
+		batch := buffer
+		buffer = make([]T, 0, n)
+		lock.Unlock()
+
+		next.Publish(batch)
+	}, func(next *PublisherDef[[]T]) {
+		lock.Lock()
+		batch := buffer
+		buffer = nil
+		lock.Unlock()
+
+		// Flush the partial batch before completion
+		if len(batch) > 0 {
+			next.Publish(batch)
+		}
+		next.Complete()
+	})
+}
+
+// PublisherBufferTime Collect items into batches and publish each batch after the duration since its first item,
+// or as soon as it reaches maxCount items(no limit if maxCount <= 0); timers run on the timeScheduler(DefaultTimeScheduler if nil)
+func PublisherBufferTime[T any](publisherSelf *PublisherDef[T], duration time.Duration, maxCount int, timeScheduler TimeScheduler) *PublisherDef[[]T] {
+	if timeScheduler == nil {
+		timeScheduler = DefaultTimeScheduler
+	}
+
+	var lock sync.Mutex
+	var timer TimerHandle
+	var buffer []T
+	generation := 0
+	return publisherChainWithComplete(publisherSelf, func(next *PublisherDef[[]T], in T) {
+		lock.Lock()
+		buffer = append(buffer, in)
+		if maxCount > 0 && len(buffer) >= maxCount {
+			if timer != nil {
+				timer.Stop()
+				timer = nil
+			}
+			generation++
+			batch := buffer
+			buffer = nil
+			lock.Unlock()
+
+			next.Publish(batch)
+			return
+		}
+		if timer == nil {
+			currentGeneration := generation
+			timer = timeScheduler.AfterFunc(duration, func() {
+				lock.Lock()
+				// Outdated timer(the batch has been flushed by maxCount)
+				if generation != currentGeneration || len(buffer) == 0 {
+					lock.Unlock()
+					return
+				}
+				generation++
+				timer = nil
+				batch := buffer
+				buffer = nil
+				lock.Unlock()
+
+				next.Publish(batch)
+			})
+		}
+		lock.Unlock()
+	}, func(next *PublisherDef[[]T]) {
+		lock.Lock()
+		if timer != nil {
+			timer.Stop()
+			timer = nil
+		}
+		generation++
+		batch := buffer
+		buffer = nil
+		lock.Unlock()
+
+		// Flush the partial batch before completion
+		if len(batch) > 0 {
+			next.Publish(batch)
+		}
+		next.Complete()
+	})
+}
+
+// PublisherRetry Publish items of the Publisher made by the factory, and make & subscribe a new one when it fails(at most n retries)
+//
+// NOTE: Publishers stay terminated after Error(), so the factory makes a new source for each attempt.
+func PublisherRetry[T any](factory func() *PublisherDef[T], n int) *PublisherDef[T] {
+	return PublisherRetryWhen(factory, RetryPolicy{MaxRetries: n}, nil)
+}
+
+// PublisherRetryWhen PublisherRetry by the RetryPolicy(e.g. ExponentialBackoff), delays run on the timeScheduler(DefaultTimeScheduler if nil)
+func PublisherRetryWhen[T any](factory func() *PublisherDef[T], policy RetryPolicy, timeScheduler TimeScheduler) *PublisherDef[T] {
+	if timeScheduler == nil {
+		timeScheduler = DefaultTimeScheduler
+	}
+	next := PublisherNewGenerics[T]()
+
+	var lock sync.Mutex
+	retries := 0
+	var unsubscribeCurrent func()
+	var subscribe func()
+	subscribe = func() {
+		if next.IsTerminated() {
+			return
+		}
+
+		lock.Lock()
+		currentRetries := retries
+		lock.Unlock()
+
+		source := factory()
+		s := source.Subscribe(Subscription[T]{
+			OnNext: next.Publish,
+			OnError: func(err error) {
+				lock.Lock()
+				retries++
+				retry := retries
+				lock.Unlock()
+
+				if !policy.CanRetry(retry, err) {
+					next.Error(err)
+					return
+				}
+				delay := policy.Delay(retry)
+				if policy.OnRetry != nil {
+					policy.OnRetry(retry, err, delay)
+				}
+				if delay <= 0 {
+					subscribe()
+					return
+				}
+				timeScheduler.AfterFunc(delay, subscribe)
+			},
+			OnComplete: next.Complete,
+		})
+
+		lock.Lock()
+		// Not retried during Subscribe()
+		if currentRetries == retries {
+			unsubscribeCurrent = func() {
+				source.Unsubscribe(s)
+			}
+		}
+		lock.Unlock()
+	}
+
+	subscribe()
+	publisherLinkUpstream(next, func() {
+		lock.Lock()
+		fn := unsubscribeCurrent
+		lock.Unlock()
+
+		if fn != nil {
+			fn()
+		}
+	})
+
+	return next
+}
@@ -0,0 +1,270 @@
+package fpgo
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func collectPublisher[T any](p *PublisherDef[T]) *[]T {
+	result := make([]T, 0)
+	p.Subscribe(Subscription[T]{
+		OnNext: func(in T) {
+			result = append(result, in)
+		},
+	})
+	return &result
+}
+
+func TestPublisherOperators(t *testing.T) {
+	p := PublisherNewGenerics[int]()
+
+	mapped := collectPublisher(PublisherMap(p, strconv.Itoa))
+	filtered := collectPublisher(p.Filter(func(v int) bool {
+		return v%2 == 0
+	}))
+	scanned := collectPublisher(p.Scan(func(sum int, v int) int {
+		return sum + v
+	}, 0))
+	scannedString := collectPublisher(PublisherScan(p, func(memo string, v int) string {
+		return memo + strconv.Itoa(v)
+	}, ">"))
+	taken := collectPublisher(p.Take(2))
+	skipped := collectPublisher(p.Skip(2))
+	chained := collectPublisher(p.Skip(1).Filter(func(v int) bool {
+		return v != 3
+	}).Map(func(v int) int {
+		return v * 10
+	}).Take(2))
+
+	for i := 1; i <= 5; i++ {
+		p.Publish(i)
+	}
+
+	assert.Equal(t, []string{"1", "2", "3", "4", "5"}, *mapped)
+	assert.Equal(t, []int{2, 4}, *filtered)
+	assert.Equal(t, []int{1, 3, 6, 10, 15}, *scanned)
+	assert.Equal(t, []string{">1", ">12", ">123", ">1234", ">12345"}, *scannedString)
+	assert.Equal(t, []int{1, 2}, *taken)
+	assert.Equal(t, []int{3, 4, 5}, *skipped)
+	assert.Equal(t, []int{20, 40}, *chained)
+
+	// Take() unsubscribed the upstream
+	p2 := PublisherNewGenerics[int]()
+	taken = collectPublisher(p2.Take(1))
+	assert.Equal(t, 1, len(p2.subscribers))
+	p2.Publish(1)
+	p2.Publish(2)
+	assert.Equal(t, 0, len(p2.subscribers))
+	assert.Equal(t, []int{1}, *taken)
+}
+
+func TestPublisherDistinctUntilChangedPairwise(t *testing.T) {
+	p := PublisherNewGenerics[int]()
+	distinct := collectPublisher(p.DistinctUntilChanged(EqComparable[int]()))
+	distinctByParity := collectPublisher(p.DistinctUntilChanged(EqByKey(func(v int) int {
+		return v % 2
+	})))
+	pairs := collectPublisher(PublisherPairwise(p))
+	changes := collectPublisher(PublisherMap(PublisherPairwise(p.DistinctUntilChanged(EqComparable[int]())), func(pair Tuple2[int, int]) int {
+		return pair.V2 - pair.V1
+	}))
+
+	for _, v := range []int{1, 1, 2, 2, 4, 3} {
+		p.Publish(v)
+	}
+	assert.Equal(t, []int{1, 2, 4, 3}, *distinct)
+	assert.Equal(t, []int{1, 2, 3}, *distinctByParity)
+	assert.Equal(t, []Tuple2[int, int]{{1, 1}, {1, 2}, {2, 2}, {2, 4}, {4, 3}}, *pairs)
+	assert.Equal(t, []int{1, 2, -1}, *changes)
+}
+
+func TestPublisherDebounceThrottle(t *testing.T) {
+	var p *PublisherDef[int]
+	var actual *[]int
+	timeScheduler := NewVirtualTimeScheduler(time.Now())
+
+	// Debounce(trailing)
+	p = PublisherNewGenerics[int]()
+	actual = collectPublisher(p.DebounceWithOption(10*time.Millisecond, TimingOption{Trailing: true, TimeScheduler: timeScheduler}))
+	p.Publish(1)
+	timeScheduler.Advance(5 * time.Millisecond)
+	p.Publish(2)
+	timeScheduler.Advance(9 * time.Millisecond)
+	assert.Equal(t, []int{}, *actual)
+	timeScheduler.Advance(1 * time.Millisecond)
+	assert.Equal(t, []int{2}, *actual)
+	p.Publish(3)
+	timeScheduler.Advance(10 * time.Millisecond)
+	assert.Equal(t, []int{2, 3}, *actual)
+
+	// Debounce(leading & trailing)
+	p = PublisherNewGenerics[int]()
+	actual = collectPublisher(p.DebounceWithOption(10*time.Millisecond, TimingOption{Leading: true, Trailing: true, TimeScheduler: timeScheduler}))
+	p.Publish(1)
+	assert.Equal(t, []int{1}, *actual)
+	timeScheduler.Advance(10 * time.Millisecond)
+	assert.Equal(t, []int{1}, *actual)
+	p.Publish(2)
+	p.Publish(3)
+	timeScheduler.Advance(10 * time.Millisecond)
+	assert.Equal(t, []int{1, 2, 3}, *actual)
+
+	// Throttle(leading)
+	p = PublisherNewGenerics[int]()
+	actual = collectPublisher(p.ThrottleWithOption(10*time.Millisecond, TimingOption{Leading: true, TimeScheduler: timeScheduler}))
+	p.Publish(1)
+	p.Publish(2)
+	timeScheduler.Advance(5 * time.Millisecond)
+	p.Publish(3)
+	timeScheduler.Advance(5 * time.Millisecond)
+	p.Publish(4)
+	p.Publish(5)
+	timeScheduler.Advance(10 * time.Millisecond)
+	assert.Equal(t, []int{1, 4}, *actual)
+
+	// Throttle(leading & trailing)
+	p = PublisherNewGenerics[int]()
+	actual = collectPublisher(p.ThrottleWithOption(10*time.Millisecond, TimingOption{Leading: true, Trailing: true, TimeScheduler: timeScheduler}))
+	p.Publish(1)
+	p.Publish(2)
+	p.Publish(3)
+	assert.Equal(t, []int{1}, *actual)
+	timeScheduler.Advance(10 * time.Millisecond)
+	assert.Equal(t, []int{1, 3}, *actual)
+	p.Publish(4)
+	timeScheduler.Advance(10 * time.Millisecond)
+	assert.Equal(t, []int{1, 3, 4}, *actual)
+	timeScheduler.Advance(10 * time.Millisecond)
+	p.Publish(5)
+	assert.Equal(t, []int{1, 3, 4, 5}, *actual)
+
+	// Throttle: the trailing item is flushed on completion and the window timer is stopped
+	timeScheduler = NewVirtualTimeScheduler(time.Now())
+	p = PublisherNewGenerics[int]()
+	throttled := p.ThrottleWithOption(10*time.Millisecond, TimingOption{Leading: true, Trailing: true, TimeScheduler: timeScheduler})
+	actual = collectPublisher(throttled)
+	p.Publish(1)
+	p.Publish(2)
+	p.Complete()
+	assert.Equal(t, []int{1, 2}, *actual)
+	assert.Equal(t, true, throttled.IsTerminated())
+	assert.Equal(t, 0, len(timeScheduler.timers))
+	timeScheduler.Advance(10 * time.Millisecond)
+	assert.Equal(t, []int{1, 2}, *actual)
+
+	// Real time
+	p = PublisherNewGenerics[int]()
+	ch := make(chan int, 1)
+	p.Debounce(time.Millisecond).Subscribe(Subscription[int]{
+		OnNext: func(in int) {
+			ch <- in
+		},
+	})
+	p.Publish(1)
+	p.Publish(2)
+	assert.Equal(t, 2, <-ch)
+}
+
+func TestPublisherBuffer(t *testing.T) {
+	p := PublisherNewGenerics[int]()
+	counted := collectPublisher(PublisherBufferCount(p, 2))
+	for i := 1; i <= 5; i++ {
+		p.Publish(i)
+	}
+	assert.Equal(t, [][]int{{1, 2}, {3, 4}}, *counted)
+
+	timeScheduler := NewVirtualTimeScheduler(time.Now())
+	p = PublisherNewGenerics[int]()
+	timed := collectPublisher(PublisherBufferTime(p, 10*time.Millisecond, 3, timeScheduler))
+	p.Publish(1)
+	timeScheduler.Advance(5 * time.Millisecond)
+	p.Publish(2)
+	assert.Equal(t, [][]int{}, *timed)
+	timeScheduler.Advance(5 * time.Millisecond)
+	assert.Equal(t, [][]int{{1, 2}}, *timed)
+	// Flushed by maxCount
+	p.Publish(3)
+	p.Publish(4)
+	p.Publish(5)
+	assert.Equal(t, [][]int{{1, 2}, {3, 4, 5}}, *timed)
+	timeScheduler.Advance(10 * time.Millisecond)
+	assert.Equal(t, [][]int{{1, 2}, {3, 4, 5}}, *timed)
+	p.Publish(6)
+	timeScheduler.Advance(10 * time.Millisecond)
+	assert.Equal(t, [][]int{{1, 2}, {3, 4, 5}, {6}}, *timed)
+}
+
+func TestPublisherOperatorsFlushOnComplete(t *testing.T) {
+	timeScheduler := NewVirtualTimeScheduler(time.Now())
+
+	p := PublisherNewGenerics[int]()
+	counted := collectPublisher(PublisherBufferCount(p, 2))
+	timed := collectPublisher(PublisherBufferTime(p, 10*time.Millisecond, 0, timeScheduler))
+	debounced := collectPublisher(p.DebounceWithOption(10*time.Millisecond, TimingOption{Trailing: true, TimeScheduler: timeScheduler}))
+	p.Publish(1)
+	p.Publish(2)
+	p.Publish(3)
+	p.Complete()
+	assert.Equal(t, [][]int{{1, 2}, {3}}, *counted)
+	assert.Equal(t, [][]int{{1, 2, 3}}, *timed)
+	assert.Equal(t, []int{3}, *debounced)
+
+	// Timers have been stopped
+	timeScheduler.Advance(10 * time.Millisecond)
+	assert.Equal(t, [][]int{{1, 2, 3}}, *timed)
+	assert.Equal(t, []int{3}, *debounced)
+}
+
+func TestPublisherRetry(t *testing.T) {
+	var sources []*PublisherDef[int]
+	factory := func() *PublisherDef[int] {
+		source := PublisherNewGenerics[int]()
+		sources = append(sources, source)
+		return source
+	}
+	var actualErr error
+	var actual []int
+	subscription := Subscription[int]{
+		OnNext: func(in int) {
+			actual = append(actual, in)
+		},
+		OnError: func(err error) {
+			actualErr = err
+		},
+	}
+
+	PublisherRetry(factory, 1).Subscribe(subscription)
+	sources[0].Publish(1)
+	sources[0].Error(errors.New("first"))
+	assert.Equal(t, 2, len(sources))
+	sources[1].Publish(2)
+	sources[1].Error(errors.New("second"))
+	assert.Equal(t, 2, len(sources))
+	assert.Equal(t, []int{1, 2}, actual)
+	assert.EqualError(t, actualErr, "second")
+
+	// Backoff
+	sources, actual, actualErr = nil, nil, nil
+	timeScheduler := NewVirtualTimeScheduler(time.Now())
+	retried := PublisherRetryWhen(factory, ExponentialBackoff(-1, 10*time.Millisecond, time.Second), timeScheduler)
+	retried.Subscribe(subscription)
+	sources[0].Error(errors.New("first"))
+	timeScheduler.Advance(9 * time.Millisecond)
+	assert.Equal(t, 1, len(sources))
+	timeScheduler.Advance(1 * time.Millisecond)
+	assert.Equal(t, 2, len(sources))
+	sources[1].Error(errors.New("second"))
+	timeScheduler.Advance(10 * time.Millisecond)
+	assert.Equal(t, 2, len(sources))
+	timeScheduler.Advance(10 * time.Millisecond)
+	assert.Equal(t, 3, len(sources))
+	sources[2].Publish(3)
+	sources[2].Complete()
+	assert.Equal(t, []int{3}, actual)
+	assert.Equal(t, nil, actualErr)
+	assert.Equal(t, true, retried.IsTerminated())
+}
@@ -0,0 +1,168 @@
+package fpgo
+
+import (
+	"sync"
+	"time"
+)
+
+// Publisher Sources
+
+// publisherSource New a Publisher started by start() when it's subscribed first,
+// and stopped by stop() once it's terminated or its last subscriber unsubscribes(it's never restarted)
+func publisherSource[T any](start func(), stop func()) *PublisherDef[T] {
+	var lock sync.Mutex
+	isStarted := false
+	isStopped := false
+
+	p := PublisherNewGenerics[T]()
+	p.onFirstSubscribe = func() {
+		lock.Lock()
+		defer lock.Unlock()
+
+		if isStarted || isStopped || start == nil {
+			return
+		}
+		isStarted = true
+		start()
+	}
+	stopOnce := func() {
+		lock.Lock()
+		defer lock.Unlock()
+
+		if isStopped {
+			return
+		}
+		isStopped = true
+		stop()
+	}
+	p.unsubscribeUpstream = stopOnce
+	p.onLastUnsubscribe = stopOnce
+	return p
+}
+
+// IntervalPublisher New a Publisher publishing 0, 1, 2, ... every duration(it starts ticking when it's subscribed first)
+func IntervalPublisher(duration time.Duration) *PublisherDef[int] {
+	return IntervalPublisherWithScheduler(duration, nil)
+}
+
+// IntervalPublisherWithScheduler IntervalPublisher running on the timeScheduler(DefaultTimeScheduler if nil)
+func IntervalPublisherWithScheduler(duration time.Duration, timeScheduler TimeScheduler) *PublisherDef[int] {
+	if timeScheduler == nil {
+		timeScheduler = DefaultTimeScheduler
+	}
+
+	var lock sync.Mutex
+	var timer TimerHandle
+	isStopped := false
+	count := 0
+	var p *PublisherDef[int]
+	var tick func()
+	tick = func() {
+		lock.Lock()
+		if isStopped {
+			lock.Unlock()
+			return
+		}
+		current := count
+		count++
+		timer = timeScheduler.AfterFunc(duration, tick)
+		lock.Unlock()
+
+		p.Publish(current)
+	}
+	p = publisherSource[int](func() {
+		lock.Lock()
+		defer lock.Unlock()
+
+		timer = timeScheduler.AfterFunc(duration, tick)
+	}, func() {
+		lock.Lock()
+		defer lock.Unlock()
+
+		isStopped = true
+		if timer != nil {
+			timer.Stop()
+		}
+	})
+
+	return p
+}
+
+// TimerPublisher New a Publisher publishing the time once after the delay(since it's subscribed first) and then completing
+func TimerPublisher(delay time.Duration) *PublisherDef[time.Time] {
+	return TimerPublisherWithScheduler(delay, nil)
+}
+
+// TimerPublisherWithScheduler TimerPublisher running on the timeScheduler(DefaultTimeScheduler if nil)
+func TimerPublisherWithScheduler(delay time.Duration, timeScheduler TimeScheduler) *PublisherDef[time.Time] {
+	if timeScheduler == nil {
+		timeScheduler = DefaultTimeScheduler
+	}
+
+	// Both start() & stop() are called under the lock of publisherSource
+	var timer TimerHandle
+	var p *PublisherDef[time.Time]
+	p = publisherSource[time.Time](func() {
+		timer = timeScheduler.AfterFunc(delay, func() {
+			p.Publish(timeScheduler.Now())
+			p.Complete()
+		})
+	}, func() {
+		if timer != nil {
+			timer.Stop()
+		}
+	})
+
+	return p
+}
+
+// FromTicker New a Publisher publishing the ticks of the Ticker since it's subscribed first(the Ticker is stopped when the Publisher stops)
+func FromTicker(ticker *time.Ticker) *PublisherDef[time.Time] {
+	stopCh := make(chan bool)
+	var p *PublisherDef[time.Time]
+	p = publisherSource[time.Time](func() {
+		go func() {
+			for {
+				select {
+				case <-stopCh:
+					return
+				case tick := <-ticker.C:
+					p.Publish(tick)
+				}
+			}
+		}()
+	}, func() {
+		ticker.Stop()
+		close(stopCh)
+	})
+
+	return p
+}
+
+// PublisherFromChannel New a Publisher publishing items received from the channel, it completes when the channel is closed
+//
+// It starts receiving when it's subscribed first, and stops once it's terminated or its last subscriber unsubscribes.
+func PublisherFromChannel[T any](ch <-chan T) *PublisherDef[T] {
+	stopCh := make(chan bool)
+	var p *PublisherDef[T]
+	p = publisherSource[T](func() {
+		go func() {
+			for {
+				select {
+				case <-stopCh:
+					return
+				case in, ok := <-ch:
+					if !ok {
+						p.Complete()
+						return
+					}
+					p.Publish(in)
+				}
+			}
+		}()
+	}, func() {
+		close(stopCh)
+	})
+
+	return p
+}
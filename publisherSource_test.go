@@ -0,0 +1,119 @@
+package fpgo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntervalTimerPublisher(t *testing.T) {
+	timeScheduler := NewVirtualTimeScheduler(time.Now())
+
+	interval := IntervalPublisherWithScheduler(10*time.Millisecond, timeScheduler)
+	// Not ticking until it's subscribed
+	timeScheduler.Advance(50 * time.Millisecond)
+	assert.Equal(t, 0, len(timeScheduler.timers))
+	actual := collectPublisher(interval)
+	timeScheduler.Advance(35 * time.Millisecond)
+	assert.Equal(t, []int{0, 1, 2}, *actual)
+	// Stopped by the disposal of the last subscription
+	interval.Unsubscribe(interval.subscribers[0])
+	timeScheduler.Advance(50 * time.Millisecond)
+	assert.Equal(t, []int{0, 1, 2}, *actual)
+	assert.Equal(t, 0, len(timeScheduler.timers))
+
+	// Stopped by Complete()
+	interval = IntervalPublisherWithScheduler(10*time.Millisecond, timeScheduler)
+	actual = collectPublisher(interval.Take(2))
+	timeScheduler.Advance(50 * time.Millisecond)
+	assert.Equal(t, []int{0, 1}, *actual)
+	assert.Equal(t, 0, len(timeScheduler.timers))
+
+	timer := TimerPublisherWithScheduler(10*time.Millisecond, timeScheduler)
+	// Not started until it's subscribed
+	timeScheduler.Advance(50 * time.Millisecond)
+	assert.Equal(t, 0, len(timeScheduler.timers))
+	assert.Equal(t, false, timer.IsTerminated())
+	timerStart := timeScheduler.Now()
+	fired := collectPublisher(timer)
+	timeScheduler.Advance(10 * time.Millisecond)
+	assert.Equal(t, []time.Time{timerStart.Add(10 * time.Millisecond)}, *fired)
+	assert.Equal(t, true, timer.IsTerminated())
+}
+
+func TestFromTicker(t *testing.T) {
+	ticker := FromTicker(time.NewTicker(time.Millisecond))
+	ticks := make(chan time.Time)
+	done := make(chan bool)
+	defer close(done)
+	s := ticker.Subscribe(Subscription[time.Time]{
+		OnNext: func(tick time.Time) {
+			select {
+			case ticks <- tick:
+			// Don't block the tick being published after the test
+			case <-done:
+			}
+		},
+	})
+	<-ticks
+	<-ticks
+	ticker.Unsubscribe(s)
+	ticker.Complete()
+	assert.Equal(t, true, ticker.IsTerminated())
+
+	// Subscribed later, the ticks are not consumed before that
+	ticker = FromTicker(time.NewTicker(time.Millisecond))
+	time.Sleep(5 * time.Millisecond)
+	ch, s := ticker.ToChannel(0)
+	<-ch
+	ticker.Unsubscribe(s)
+}
+
+func TestPublisherChannel(t *testing.T) {
+	source := make(chan int)
+	p := PublisherFromChannel(source)
+	ch, _ := p.Map(func(v int) int {
+		return v * 10
+	}).ToChannel(3)
+	source <- 1
+	source <- 2
+	source <- 3
+	close(source)
+	actual := make([]int, 0)
+	for v := range ch {
+		actual = append(actual, v)
+	}
+	assert.Equal(t, []int{10, 20, 30}, actual)
+	assert.Equal(t, true, p.IsTerminated())
+
+	// Not received until it's subscribed
+	source = make(chan int, 3)
+	source <- 1
+	source <- 2
+	p = PublisherFromChannel(source)
+	time.Sleep(5 * time.Millisecond)
+	ch, s := p.ToChannel(3)
+	source <- 3
+	close(source)
+	actual = make([]int, 0)
+	for v := range ch {
+		actual = append(actual, v)
+	}
+	assert.Equal(t, []int{1, 2, 3}, actual)
+	assert.Equal(t, true, p.IsTerminated())
+
+	// Unsubscribed by the consumer while publishing is blocked
+	p = PublisherNewGenerics[int]()
+	ch, s = p.ToChannel(0)
+	published := make(chan bool)
+	go func() {
+		p.Publish(1)
+		close(published)
+	}()
+	p.Unsubscribe(s)
+	<-published
+	_, ok := <-ch
+	assert.Equal(t, false, ok)
+
+}
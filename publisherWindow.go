@@ -0,0 +1,174 @@
+package fpgo
+
+import (
+	"sync"
+	"time"
+)
+
+// Publisher Window Operators
+
+type publisherWindowItem[T any] struct {
+	period int
+	val    T
+}
+
+// PublisherWindowCount Publish windows of size items, a new window starts every skip items
+// (tumbling if skip is size or <= 0, sliding if skip < size; skip > size is taken as size)
+//
+// The partial window with unpublished items is flushed before completion.
+func PublisherWindowCount[T comparable](publisherSelf *PublisherDef[T], size int, skip int) *PublisherDef[*StreamDef[T]] {
+	if size <= 0 {
+		size = 1
+	}
+	if skip <= 0 || skip > size {
+		skip = size
+	}
+
+	var lock sync.Mutex
+	buffer := make([]T, 0, size)
+	fresh := 0
+	return publisherChainWithComplete(publisherSelf, func(next *PublisherDef[*StreamDef[T]], in T) {
+		lock.Lock()
+		buffer = append(buffer, in)
+		fresh++
+		if len(buffer) < size {
+			lock.Unlock()
+			return
+		}
+		window := StreamFromArray(Concat(buffer))
+		buffer = Concat(buffer[skip:])
+		fresh = 0
+		lock.Unlock()
+
+		next.Publish(window)
+	}, func(next *PublisherDef[*StreamDef[T]]) {
+		lock.Lock()
+		window := StreamFromArray(buffer)
+		isFresh := fresh > 0
+		buffer = nil
+		fresh = 0
+		lock.Unlock()
+
+		// Flush the partial window before completion
+		if isFresh {
+			next.Publish(window)
+		}
+		next.Complete()
+	})
+}
+
+// PublisherWindowTime Publish windows of the items within the last duration every period
+// (tumbling if period is duration or <= 0, sliding if period < duration, duration is rounded up to a multiple of period);
+// timers run on the timeScheduler(DefaultTimeScheduler if nil)
+//
+// Empty windows are published as well(e.g. for per-minute counts), the window with unpublished items is flushed before completion.
+// The period timer runs only while the result is subscribed.
+func PublisherWindowTime[T comparable](publisherSelf *PublisherDef[T], duration time.Duration, period time.Duration, timeScheduler TimeScheduler) *PublisherDef[*StreamDef[T]] {
+	if timeScheduler == nil {
+		timeScheduler = DefaultTimeScheduler
+	}
+	if period <= 0 {
+		period = duration
+	}
+	periodsPerWindow := 1
+	if period > 0 && duration > period {
+		periodsPerWindow = int((duration + period - 1) / period)
+	}
+
+	var lock sync.Mutex
+	var timer TimerHandle
+	var buffer []publisherWindowItem[T]
+	fresh := 0
+	isStopped := false
+	// Items are tagged by the index of the period they arrived in(rather than by time, so boundaries are exact)
+	currentPeriod := 0
+	takeWindow := func(firstPeriod int) *StreamDef[T] {
+		for len(buffer) > 0 && buffer[0].period < firstPeriod {
+			buffer = buffer[1:]
+		}
+		window := make([]T, len(buffer))
+		for i, item := range buffer {
+			window[i] = item.val
+		}
+		fresh = 0
+		return StreamFromArray(window)
+	}
+
+	next := publisherChainWithComplete(publisherSelf, func(next *PublisherDef[*StreamDef[T]], in T) {
+		lock.Lock()
+		defer lock.Unlock()
+		buffer = append(buffer, publisherWindowItem[T]{period: currentPeriod, val: in})
+		fresh++
+	}, func(next *PublisherDef[*StreamDef[T]]) {
+		lock.Lock()
+		isStopped = true
+		if timer != nil {
+			timer.Stop()
+			timer = nil
+		}
+		isFresh := fresh > 0
+		// The current partial window
+		window := takeWindow(currentPeriod - periodsPerWindow + 1)
+		buffer = nil
+		lock.Unlock()
+
+		// Flush the window before completion
+		if isFresh {
+			next.Publish(window)
+		}
+		next.Complete()
+	})
+
+	// ticking The generation of the running timer(0 if it's not running), ticks of the stale ones are ignored
+	generation, ticking := 0, 0
+	var tick func(current int)
+	tick = func(current int) {
+		lock.Lock()
+		if isStopped || current != ticking || next.IsTerminated() {
+			lock.Unlock()
+			return
+		}
+		currentPeriod++
+		window := takeWindow(currentPeriod - periodsPerWindow)
+		timer = timeScheduler.AfterFunc(period, func() {
+			tick(current)
+		})
+		lock.Unlock()
+
+		next.Publish(window)
+	}
+	// The timer runs only while it's subscribed
+	next.onFirstSubscribe = func() {
+		lock.Lock()
+		defer lock.Unlock()
+
+		if isStopped || ticking != 0 {
+			return
+		}
+		generation++
+		current := generation
+		ticking = current
+		timer = timeScheduler.AfterFunc(period, func() {
+			tick(current)
+		})
+	}
+	next.onLastUnsubscribe = func() {
+		lock.Lock()
+		defer lock.Unlock()
+
+		ticking = 0
+		if timer != nil {
+			timer.Stop()
+			timer = nil
+		}
+	}
+
+	return next
+}
+
+// PublisherWindowCollect Aggregate each window by the Collector(e.g. Counting() for per-window counts)
+func PublisherWindowCollect[T comparable, A any, R any](publisherSelf *PublisherDef[*StreamDef[T]], collector Collector[T, A, R]) *PublisherDef[R] {
+	return PublisherMap(publisherSelf, func(window *StreamDef[T]) R {
+		return StreamCollect(window, collector)
+	})
+}
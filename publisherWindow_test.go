@@ -0,0 +1,113 @@
+package fpgo
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func collectWindows[T comparable](p *PublisherDef[*StreamDef[T]]) *[][]T {
+	result := make([][]T, 0)
+	p.Subscribe(Subscription[*StreamDef[T]]{
+		OnNext: func(in *StreamDef[T]) {
+			result = append(result, in.ToArray())
+		},
+	})
+	return &result
+}
+
+func TestPublisherWindowCount(t *testing.T) {
+	p := PublisherNewGenerics[int]()
+	tumbling := collectWindows(PublisherWindowCount(p, 2, 0))
+	sliding := collectWindows(PublisherWindowCount(p, 3, 1))
+	hopping := collectWindows(PublisherWindowCount(p, 3, 2))
+	sums := collectPublisher(PublisherWindowCollect(PublisherWindowCount(p, 2, 2), Summing[int]()))
+	for i := 1; i <= 5; i++ {
+		p.Publish(i)
+	}
+	assert.Equal(t, [][]int{{1, 2}, {3, 4}}, *tumbling)
+	assert.Equal(t, [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}}, *sliding)
+	assert.Equal(t, [][]int{{1, 2, 3}, {3, 4, 5}}, *hopping)
+	assert.Equal(t, []int{3, 7}, *sums)
+
+	// Flush the windows with unpublished items
+	p.Complete()
+	assert.Equal(t, [][]int{{1, 2}, {3, 4}, {5}}, *tumbling)
+	assert.Equal(t, [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}}, *sliding)
+	assert.Equal(t, [][]int{{1, 2, 3}, {3, 4, 5}}, *hopping)
+	assert.Equal(t, []int{3, 7, 5}, *sums)
+}
+
+func TestPublisherWindowTime(t *testing.T) {
+	timeScheduler := NewVirtualTimeScheduler(time.Now())
+	p := PublisherNewGenerics[int]()
+	tumbling := collectWindows(PublisherWindowTime(p, 10*time.Millisecond, 0, timeScheduler))
+	sliding := collectWindows(PublisherWindowTime(p, 10*time.Millisecond, 5*time.Millisecond, timeScheduler))
+	counts := collectPublisher(PublisherWindowCollect(PublisherWindowTime(p, 10*time.Millisecond, 0, timeScheduler), Counting[int]()))
+
+	p.Publish(1)
+	timeScheduler.Advance(5 * time.Millisecond)
+	assert.Equal(t, [][]int{}, *tumbling)
+	assert.Equal(t, [][]int{{1}}, *sliding)
+	p.Publish(2)
+	timeScheduler.Advance(5 * time.Millisecond)
+	assert.Equal(t, [][]int{{1, 2}}, *tumbling)
+	assert.Equal(t, [][]int{{1}, {1, 2}}, *sliding)
+	p.Publish(3)
+	timeScheduler.Advance(5 * time.Millisecond)
+	assert.Equal(t, [][]int{{1}, {1, 2}, {2, 3}}, *sliding)
+
+	// Empty windows
+	timeScheduler.Advance(15 * time.Millisecond)
+	assert.Equal(t, [][]int{{1, 2}, {3}, {}}, *tumbling)
+	assert.Equal(t, [][]int{{1}, {1, 2}, {2, 3}, {3}, {}, {}}, *sliding)
+	assert.Equal(t, []int{2, 1, 0}, *counts)
+
+	// Flush the window with unpublished items & stop the timers
+	p.Publish(4)
+	p.Complete()
+	assert.Equal(t, [][]int{{1, 2}, {3}, {}, {4}}, *tumbling)
+	assert.Equal(t, []int{2, 1, 0, 1}, *counts)
+	timeScheduler.Advance(30 * time.Millisecond)
+	assert.Equal(t, [][]int{{1, 2}, {3}, {}, {4}}, *tumbling)
+	assert.Equal(t, 7, len(*sliding))
+
+	// Stopped by errors
+	p = PublisherNewGenerics[int]()
+	windowed := PublisherWindowTime(p, 10*time.Millisecond, 0, timeScheduler)
+	tumbling = collectWindows(windowed)
+	p.Error(errors.New("failed"))
+	timeScheduler.Advance(30 * time.Millisecond)
+	assert.Equal(t, [][]int{}, *tumbling)
+	assert.Equal(t, 0, len(timeScheduler.timers))
+}
+
+func TestPublisherWindowTimeSubscription(t *testing.T) {
+	timeScheduler := NewVirtualTimeScheduler(time.Now())
+	p := PublisherNewGenerics[int]()
+	windowed := PublisherWindowTime(p, 10*time.Millisecond, 0, timeScheduler)
+	// Not ticking until it's subscribed
+	assert.Equal(t, 0, len(timeScheduler.timers))
+	p.Publish(1)
+	timeScheduler.Advance(30 * time.Millisecond)
+
+	ch, s := windowed.ToChannel(10)
+	assert.Equal(t, 1, len(timeScheduler.timers))
+	timeScheduler.Advance(10 * time.Millisecond)
+	assert.Equal(t, []int{1}, (<-ch).ToArray())
+
+	// Stopped after the last subscriber leaves
+	windowed.Unsubscribe(s)
+	assert.Equal(t, 0, len(timeScheduler.timers))
+	p.Publish(2)
+	timeScheduler.Advance(30 * time.Millisecond)
+
+	// Resumed by the next subscriber
+	tumbling := collectWindows(windowed)
+	timeScheduler.Advance(20 * time.Millisecond)
+	assert.Equal(t, [][]int{{2}, {}}, *tumbling)
+	p.Complete()
+	assert.Equal(t, 0, len(timeScheduler.timers))
+}
@@ -1,7 +1,10 @@
 package fpgo
 
 import (
+	"errors"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -65,3 +68,145 @@ func TestPublisher(t *testing.T) {
 	p.Publish((1))
 	assert.Equal(t, expected, actual)
 }
+
+func TestReplayBehaviorPublisher(t *testing.T) {
+	replay := ReplayPublisher[int](2)
+	early := collectPublisher(replay)
+	replay.Publish(1)
+	replay.Publish(2)
+	replay.Publish(3)
+	late := collectPublisher(replay)
+	assert.Equal(t, []int{1, 2, 3}, *early)
+	assert.Equal(t, []int{2, 3}, *late)
+	replay.Publish(4)
+	assert.Equal(t, []int{2, 3, 4}, *late)
+
+	behavior := BehaviorPublisher("initial")
+	first := collectPublisher(behavior)
+	assert.Equal(t, []string{"initial"}, *first)
+	behavior.Publish("updated")
+	second := collectPublisher(behavior)
+	assert.Equal(t, []string{"initial", "updated"}, *first)
+	assert.Equal(t, []string{"updated"}, *second)
+
+	// Plain Publisher doesn't replay
+	p := PublisherNewGenerics[int]()
+	p.Publish(1)
+	assert.Equal(t, []int{}, *collectPublisher(p))
+}
+
+func TestReplayPublisherConcurrentPublish(t *testing.T) {
+	replay := ReplayPublisher[int](2)
+	replay.Publish(1)
+	replay.Publish(2)
+
+	// Published while the new subscriber is being replayed
+	var lock sync.Mutex
+	var actual []int
+	published := make(chan bool)
+	completed := make(chan bool)
+	replay.Subscribe(Subscription[int]{
+		OnNext: func(in int) {
+			if in == 1 {
+				go func() {
+					replay.Publish(3)
+					replay.Complete()
+					close(published)
+				}()
+				select {
+				case <-published:
+				case <-time.After(10 * time.Millisecond):
+				}
+			}
+			lock.Lock()
+			actual = append(actual, in)
+			lock.Unlock()
+		},
+		OnComplete: func() {
+			close(completed)
+		},
+	})
+	<-completed
+	lock.Lock()
+	defer lock.Unlock()
+	assert.Equal(t, []int{1, 2, 3}, actual)
+}
+
+func TestPublisherTermination(t *testing.T) {
+	var actual []int
+	var actualErr error
+	isCompleted := false
+	subscription := Subscription[int]{
+		OnNext: func(in int) {
+			actual = append(actual, in)
+		},
+		OnError: func(err error) {
+			actualErr = err
+		},
+		OnComplete: func() {
+			isCompleted = true
+		},
+	}
+
+	p := PublisherNewGenerics[int]()
+	p.Subscribe(subscription)
+	p.Publish(1)
+	p.Complete()
+	p.Publish(2)
+	p.Error(errors.New("ignored"))
+	assert.Equal(t, []int{1}, actual)
+	assert.Equal(t, true, isCompleted)
+	assert.Equal(t, nil, actualErr)
+	assert.Equal(t, true, p.IsTerminated())
+	assert.Equal(t, 0, len(p.subscribers))
+
+	// Late subscribers get the terminal event(after the replayed values)
+	actual, isCompleted = nil, false
+	replay := ReplayPublisher[int](1)
+	replay.Publish(1)
+	replay.Error(errors.New("failed"))
+	replay.Subscribe(subscription)
+	assert.Equal(t, []int{1}, actual)
+	assert.Equal(t, false, isCompleted)
+	assert.EqualError(t, actualErr, "failed")
+
+	// Propagated by operators
+	actual, actualErr, isCompleted = nil, nil, false
+	p = PublisherNewGenerics[int]()
+	p.Filter(func(v int) bool {
+		return v > 0
+	}).Map(func(v int) int {
+		return v * 2
+	}).Subscribe(subscription)
+	p.Publish(1)
+	p.Error(errors.New("failed"))
+	assert.Equal(t, []int{2}, actual)
+	assert.EqualError(t, actualErr, "failed")
+
+	// Take() completes & unsubscribes the upstream
+	actual, actualErr, isCompleted = nil, nil, false
+	p = PublisherNewGenerics[int]()
+	p.Take(2).Subscribe(subscription)
+	p.Publish(1)
+	p.Publish(2)
+	assert.Equal(t, []int{1, 2}, actual)
+	assert.Equal(t, true, isCompleted)
+	assert.Equal(t, 0, len(p.subscribers))
+	// Completed during Subscribe() by replayed values
+	replay = ReplayPublisher[int](3)
+	replay.Publish(1)
+	replay.Publish(2)
+	replay.Publish(3)
+	assert.Equal(t, true, replay.Take(2).IsTerminated())
+	assert.Equal(t, 0, len(replay.subscribers))
+
+	// MonadIO completes after its value
+	isCompleted = false
+	MonadIOJustGenerics(1).Subscribe(Subscription[int]{
+		OnNext: func(int) {},
+		OnComplete: func() {
+			isCompleted = true
+		},
+	})
+	assert.Equal(t, true, isCompleted)
+}
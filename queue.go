@@ -1,8 +1,10 @@
 package fpgo
 
 import (
+	"context"
 	"errors"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -164,6 +166,29 @@ func (q ChannelQueue[T]) TakeWithTimeout(timeout time.Duration) (T, error) {
 	}
 }
 
+// OfferWithContext Put the T val, blocking until there's space or the context is done(returning ctx.Err())
+func (q ChannelQueue[T]) OfferWithContext(ctx context.Context, val T) error {
+	select {
+	case q <- val:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// TakeWithContext Take the T val, blocking until there's one or the context is done(returning ctx.Err())
+func (q ChannelQueue[T]) TakeWithContext(ctx context.Context) (T, error) {
+	select {
+	case val, ok := <-q:
+		if !ok {
+			return *new(T), ErrQueueIsClosed
+		}
+		return val, nil
+	case <-ctx.Done():
+		return *new(T), ctx.Err()
+	}
+}
+
 // Offer Offer the T val(non-blocking)
 func (q ChannelQueue[T]) Offer(val T) error {
 	select {
@@ -184,6 +209,46 @@ func (q ChannelQueue[T]) Poll() (T, error) {
 	}
 }
 
+// Drain Take all T vals currently buffered(non-blocking)
+func (q ChannelQueue[T]) Drain() []T {
+	result := make([]T, 0, len(q))
+	for {
+		select {
+		case val, ok := <-q:
+			if !ok {
+				return result
+			}
+			result = append(result, val)
+		default:
+			return result
+		}
+	}
+}
+
+// PollN Take at most n T vals, waiting for them until the timeout(or the ChannelQueue is closed)
+func (q ChannelQueue[T]) PollN(n int, timeout time.Duration) []T {
+	if n <= 0 {
+		return []T{}
+	}
+
+	result := make([]T, 0, n)
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for len(result) < n {
+		select {
+		case val, ok := <-q:
+			if !ok {
+				return result
+			}
+			result = append(result, val)
+		case <-timer.C:
+			return result
+		}
+	}
+	return result
+}
+
 // LinkedList & DoublyLinkedList
 
 // LinkedListItem LinkedListItem inspired by Collection utils
@@ -419,6 +484,8 @@ func (q *LinkedListQueue[T]) Shift() (T, error) {
 	q.first = node.Next
 	if q.first == nil {
 		q.last = nil
+	} else {
+		q.first.Prev = nil
 	}
 	val := *node.Val
 
@@ -459,6 +526,8 @@ func (q *LinkedListQueue[T]) Pop() (T, error) {
 	q.last = node.Prev
 	if q.last == nil {
 		q.first = nil
+	} else {
+		q.last.Next = nil
 	}
 	val := *node.Val
 	q.recycleNode(node)
@@ -501,6 +570,9 @@ func (q *LinkedListQueue[T]) recycleNode(node *DoublyListItem[T]) {
 
 // BufferedChannelQueue BlockingQueue with ChannelQueue & scalable pool, inspired by Collection utils
 type BufferedChannelQueue[T any] struct {
+	// The first fields for 64-bit atomic alignment
+	metrics QueueMetrics
+
 	lock     sync.RWMutex
 	isClosed AtomBool
 
@@ -514,6 +586,18 @@ type BufferedChannelQueue[T any] struct {
 
 	blockingQueue ChannelQueue[T]
 	pool          *LinkedListQueue[T]
+	spillStore    QueueSpillStore[T]
+
+	// The head taken out by Peek(), it's taken before the others
+	peeked    T
+	hasPeeked bool
+	// Wake up the takers blocked by the ChannelQueue when there's a peeked head
+	peekedCh chan struct{}
+
+	watermarkLock sync.Mutex
+	highWatermark queueWatermark
+	lowWatermark  queueWatermark
+	isAboveHigh   bool
 }
 
 // NewBufferedChannelQueue New BufferedChannelQueue instance from a Queue[T]
@@ -526,6 +610,7 @@ func NewBufferedChannelQueue[T any](channelCapacity int, bufferSizeMaximum int,
 
 		blockingQueue: NewChannelQueue[T](channelCapacity),
 		pool:          pool,
+		peekedCh:      make(chan struct{}, 1),
 
 		loadFromPoolDuration:             10 * time.Millisecond,
 		freeNodeHookPoolIntervalDuration: 10 * time.Millisecond,
@@ -567,6 +652,7 @@ func (q *BufferedChannelQueue[T]) loadFromPool() {
 		var val T
 		var pollErr, offerErr error
 
+		q.loadFromSpillStore()
 		for q.pool.Count() > 0 {
 			// Try poll from the pool
 			val, pollErr = q.pool.Poll()
@@ -580,6 +666,7 @@ func (q *BufferedChannelQueue[T]) loadFromPool() {
 				q.pool.Unshift(val)
 				break
 			}
+			q.loadFromSpillStore()
 		}
 		q.lock.Unlock()
 
@@ -588,17 +675,67 @@ func (q *BufferedChannelQueue[T]) loadFromPool() {
 	}
 }
 
+// loadFromSpillStore Refill the pool by the spilled items(locked by the caller)
+func (q *BufferedChannelQueue[T]) loadFromSpillStore() {
+	if q.spillStore == nil {
+		return
+	}
+
+	for q.pool.Count() < q.bufferSizeMaximum && q.spillStore.Count() > 0 {
+		val, err := q.pollSpillStore()
+		if err != nil {
+			break
+		}
+		q.pool.Offer(val)
+	}
+}
+
+// pollSpillStore Poll the spill store, skipping the items failed to be polled(e.g. corrupted records) as long as the store moves on
+//
+// NOTE: otherwise a bad item would keep the store non-empty and the new items spilled forever.
+func (q *BufferedChannelQueue[T]) pollSpillStore() (T, error) {
+	for {
+		count := q.spillStore.Count()
+		val, err := q.spillStore.Poll()
+		if err == nil || q.spillStore.Count() >= count {
+			return val, err
+		}
+	}
+}
+
+// isSpilling Are there spilled items(locked by the caller), new items should be spilled too for keeping the order
+func (q *BufferedChannelQueue[T]) isSpilling() bool {
+	return q.spillStore != nil && q.spillStore.Count() > 0
+}
+
 func (q *BufferedChannelQueue[T]) notifyWorkers() {
 	q.loadWorkerCh.Offer(1)
 	q.freeNodeWorkerCh.Offer(1)
 }
 
 // SetBufferSizeMaximum Set MaximumBufferSize(maximum number of buffered items outside the ChannelQueue)
+//
+// NOTE: it's resizable at runtime, buffered items are kept when shrinking(Offer() fails until the buffer drains below the size).
 func (q *BufferedChannelQueue[T]) SetBufferSizeMaximum(size int) *BufferedChannelQueue[T] {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
 	q.bufferSizeMaximum = size
 	return q
 }
 
+// SetSpillStore Set the QueueSpillStore(e.g. DiskQueue) taking the overflowed items instead of rejecting them by ErrQueueIsFull
+//
+// NOTE: the items already in the store(e.g. reopened DiskQueue) are taken before the new ones.
+func (q *BufferedChannelQueue[T]) SetSpillStore(spillStore QueueSpillStore[T]) *BufferedChannelQueue[T] {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	q.spillStore = spillStore
+	q.loadWorkerCh.Offer(1)
+	return q
+}
+
 // SetNodeHookPoolSize Set nodeHookPoolSize(the buffering node hooks ideal size)
 func (q *BufferedChannelQueue[T]) SetNodeHookPoolSize(size int) *BufferedChannelQueue[T] {
 	q.nodeHookPoolSize = size
@@ -619,6 +756,9 @@ func (q *BufferedChannelQueue[T]) SetFreeNodeHookPoolIntervalDuration(duration t
 
 // GetBufferSizeMaximum Get MaximumBufferSize(maximum number of buffered items outside the ChannelQueue)
 func (q *BufferedChannelQueue[T]) GetBufferSizeMaximum() int {
+	q.lock.RLock()
+	defer q.lock.RUnlock()
+
 	return q.bufferSizeMaximum
 }
 
@@ -653,7 +793,35 @@ func (q *BufferedChannelQueue[T]) Count() int {
 	q.lock.RLock()
 	defer q.lock.RUnlock()
 
-	return len(q.blockingQueue) + q.pool.Count()
+	return q.count()
+}
+
+// count Count items(locked by the caller)
+func (q *BufferedChannelQueue[T]) count() int {
+	count := len(q.blockingQueue) + q.pool.Count()
+	if q.spillStore != nil {
+		count += q.spillStore.Count()
+	}
+	if q.hasPeeked {
+		count++
+	}
+	return count
+}
+
+// LoadFactor Get the ratio of items to the capacity(the ChannelQueue capacity + MaximumBufferSize), could be > 1 after shrinking
+func (q *BufferedChannelQueue[T]) LoadFactor() float64 {
+	if q.isClosed.Get() {
+		return 0
+	}
+
+	q.lock.RLock()
+	defer q.lock.RUnlock()
+
+	capacity := cap(q.blockingQueue) + q.bufferSizeMaximum
+	if capacity <= 0 {
+		return 0
+	}
+	return float64(q.count()) / float64(capacity)
 }
 
 // IsClosed Is the BufferedChannelQueue closed
@@ -667,6 +835,11 @@ func (q *BufferedChannelQueue[T]) Close() {
 	defer q.lock.Unlock()
 
 	q.isClosed.Set(true)
+	dropped := len(q.blockingQueue) + q.pool.Count()
+	if q.hasPeeked {
+		dropped++
+	}
+	atomic.AddUint64(&q.metrics.Dropped, uint64(dropped))
 	close(q.loadWorkerCh)
 	close(q.blockingQueue)
 }
@@ -714,7 +887,7 @@ func (q *BufferedChannelQueue[T]) Take() (T, error) {
 
 	q.notifyWorkers()
 
-	return q.blockingQueue.Take()
+	return q.recordTaken(q.take(context.Background(), nil))
 }
 
 // TakeWithTimeout Take the T val(blocking), with timeout
@@ -725,11 +898,132 @@ func (q *BufferedChannelQueue[T]) TakeWithTimeout(timeout time.Duration) (T, err
 
 	q.notifyWorkers()
 
-	return q.blockingQueue.TakeWithTimeout(timeout)
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	return q.recordTaken(q.take(context.Background(), timer.C))
+}
+
+// TakeWithContext Take the T val, blocking until there's one or the context is done(returning ctx.Err())
+func (q *BufferedChannelQueue[T]) TakeWithContext(ctx context.Context) (T, error) {
+	if q.isClosed.Get() {
+		return *new(T), ErrQueueIsClosed
+	}
+
+	q.notifyWorkers()
+
+	return q.recordTaken(q.take(ctx, nil))
+}
+
+// take Take the peeked head or the T val from the ChannelQueue(blocking)
+func (q *BufferedChannelQueue[T]) take(ctx context.Context, timeoutCh <-chan time.Time) (T, error) {
+	for {
+		if val, ok := q.takePeeked(); ok {
+			return val, nil
+		}
+
+		select {
+		case val, ok := <-q.blockingQueue:
+			if !ok {
+				return *new(T), ErrQueueIsClosed
+			}
+			return val, nil
+		case <-q.peekedCh:
+		case <-timeoutCh:
+			return *new(T), ErrQueueTakeTimeout
+		case <-ctx.Done():
+			return *new(T), ctx.Err()
+		}
+	}
+}
+
+// takePeeked Take the head taken out by Peek() if there's one
+func (q *BufferedChannelQueue[T]) takePeeked() (T, bool) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	return q.takePeekedLocked()
+}
+
+// takePeekedLocked takePeeked(locked by the caller)
+func (q *BufferedChannelQueue[T]) takePeekedLocked() (T, bool) {
+	if !q.hasPeeked {
+		return *new(T), false
+	}
+
+	val := q.peeked
+	q.peeked, q.hasPeeked = *new(T), false
+	return val, true
+}
+
+// Peek Peek the T val from the first position without removing it(non-blocking)
+//
+// NOTE: the head is moved from the ChannelQueue(or the pool/spill store) into a peek slot, it's taken first by the next Take()/Poll().
+func (q *BufferedChannelQueue[T]) Peek() (T, error) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if q.isClosed.Get() {
+		return *new(T), ErrQueueIsClosed
+	}
+	if q.hasPeeked {
+		return q.peeked, nil
+	}
+
+	// In order: the ChannelQueue, the pool & the spill store
+	val, err := q.blockingQueue.Poll()
+	if err != nil {
+		val, err = q.pool.Poll()
+	}
+	if err != nil && q.spillStore != nil {
+		val, err = q.pollSpillStore()
+	}
+	if err != nil {
+		return *new(T), ErrQueueIsEmpty
+	}
+
+	q.peeked, q.hasPeeked = val, true
+	queueSignal(q.peekedCh)
+	q.loadWorkerCh.Offer(1)
+	return val, nil
+}
+
+// OfferWithContext Offer the T val, retrying every loadFromPoolDuration while it's full until the context is done(returning ctx.Err())
+func (q *BufferedChannelQueue[T]) OfferWithContext(ctx context.Context, val T) error {
+	for {
+		err := q.offer(val)
+		if err == nil {
+			atomic.AddUint64(&q.metrics.Offered, 1)
+			q.checkWatermarks()
+		}
+		if err != ErrQueueIsFull {
+			return err
+		}
+
+		q.notifyWorkers()
+		select {
+		case <-time.After(q.loadFromPoolDuration):
+		case <-ctx.Done():
+			atomic.AddUint64(&q.metrics.Rejected, 1)
+			return ctx.Err()
+		}
+	}
 }
 
 // Offer Offer the T val(non-blocking)
 func (q *BufferedChannelQueue[T]) Offer(val T) error {
+	err := q.offer(val)
+	switch err {
+	case nil:
+		atomic.AddUint64(&q.metrics.Offered, 1)
+		q.checkWatermarks()
+	case ErrQueueIsFull:
+		atomic.AddUint64(&q.metrics.Rejected, 1)
+	}
+	return err
+}
+
+func (q *BufferedChannelQueue[T]) offer(val T) error {
 	q.lock.Lock()
 	defer q.lock.Unlock()
 
@@ -738,9 +1032,10 @@ func (q *BufferedChannelQueue[T]) Offer(val T) error {
 	}
 
 	poolCount := q.pool.Count()
+	isSpilling := q.isSpilling()
 
 	// If appearing nothing in the pool
-	if poolCount == 0 {
+	if poolCount == 0 && !isSpilling {
 		// Try channel
 		err := q.blockingQueue.Offer(val)
 		if err == nil {
@@ -755,8 +1050,17 @@ func (q *BufferedChannelQueue[T]) Offer(val T) error {
 	}
 
 	// Before +1: >=, After +1: >
-	if poolCount >= q.bufferSizeMaximum {
-		return ErrQueueIsFull
+	if isSpilling || poolCount >= q.bufferSizeMaximum {
+		if q.spillStore == nil {
+			return ErrQueueIsFull
+		}
+
+		err := q.spillStore.Offer(val)
+		if err != nil {
+			return err
+		}
+		q.loadWorkerCh.Offer(1)
+		return nil
 	}
 
 	q.pool.Offer(val)
@@ -772,5 +1076,81 @@ func (q *BufferedChannelQueue[T]) Poll() (T, error) {
 
 	q.notifyWorkers()
 
-	return q.blockingQueue.Poll()
+	if val, ok := q.takePeeked(); ok {
+		return q.recordTaken(val, nil)
+	}
+	return q.recordTaken(q.blockingQueue.Poll())
+}
+
+// Drain Take all T vals currently buffered(non-blocking)
+func (q *BufferedChannelQueue[T]) Drain() []T {
+	result := q.pollN(-1)
+	q.recordTakenN(len(result))
+	return result
+}
+
+// PollN Take at most n T vals, waiting for them until the timeout(or the BufferedChannelQueue is closed)
+func (q *BufferedChannelQueue[T]) PollN(n int, timeout time.Duration) []T {
+	if n <= 0 {
+		return []T{}
+	}
+
+	result := q.pollN(n)
+	q.recordTakenN(len(result))
+	deadline := time.Now().Add(timeout)
+	for len(result) < n {
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			break
+		}
+
+		val, err := q.TakeWithTimeout(remaining)
+		if err != nil {
+			break
+		}
+		result = append(result, val)
+		polled := q.pollN(n - len(result))
+		q.recordTakenN(len(polled))
+		result = append(result, polled...)
+	}
+	return result
+}
+
+// pollN Take at most n(unlimited if < 0) T vals in order, from the ChannelQueue first then the pool & the spill store(non-blocking)
+func (q *BufferedChannelQueue[T]) pollN(n int) []T {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if q.isClosed.Get() {
+		return []T{}
+	}
+
+	result := make([]T, 0)
+	if n != 0 {
+		if val, ok := q.takePeekedLocked(); ok {
+			result = append(result, val)
+		}
+	}
+	for n < 0 || len(result) < n {
+		val, err := q.blockingQueue.Poll()
+		if err != nil {
+			break
+		}
+		result = append(result, val)
+	}
+	for n < 0 || len(result) < n {
+		val, err := q.pool.Poll()
+		if err != nil {
+			break
+		}
+		result = append(result, val)
+	}
+	for q.spillStore != nil && (n < 0 || len(result) < n) {
+		val, err := q.pollSpillStore()
+		if err != nil {
+			break
+		}
+		result = append(result, val)
+	}
+	return result
 }
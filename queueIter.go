@@ -0,0 +1,54 @@
+//go:build go1.23
+
+package fpgo
+
+import "iter"
+
+// Queue Iterators
+
+// queueIter Iterate the T vals taken by take until it fails(e.g. ErrQueueIsClosed) or the loop breaks
+func queueIter[T any](take func() (T, error)) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for {
+			val, err := take()
+			if err != nil || !yield(val) {
+				return
+			}
+		}
+	}
+}
+
+// Iter Iterate the T vals(blocking) until the ChannelQueue is closed & drained
+func (q ChannelQueue[T]) Iter() iter.Seq[T] {
+	return queueIter(q.Take)
+}
+
+// Iter Iterate the T vals(blocking) until the BufferedChannelQueue is closed
+func (q *BufferedChannelQueue[T]) Iter() iter.Seq[T] {
+	return queueIter(q.Take)
+}
+
+// Iter Iterate the T vals by the priority(blocking) until the PriorityChannelQueue is closed & drained
+func (q *PriorityChannelQueue[T]) Iter() iter.Seq[T] {
+	return queueIter(q.Take)
+}
+
+// Iter Iterate the T vals from the first position(blocking) until the Deque is closed & drained
+func (q *Deque[T]) Iter() iter.Seq[T] {
+	return queueIter(q.TakeFirst)
+}
+
+// Iter Iterate the T vals once they're available(blocking) until the DelayQueue is closed
+func (q *DelayQueue[T]) Iter() iter.Seq[T] {
+	return queueIter(q.Take)
+}
+
+// Iter Iterate the T vals(blocking by spinning) until the RingQueue is closed & drained
+func (q *RingQueue[T]) Iter() iter.Seq[T] {
+	return queueIter(q.Take)
+}
+
+// Iter Iterate the T vals(blocking) until the BroadcastQueue or the consumer is closed
+func (consumer *BroadcastConsumer[T]) Iter() iter.Seq[T] {
+	return queueIter(consumer.Take)
+}
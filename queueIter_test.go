@@ -0,0 +1,60 @@
+//go:build go1.23
+
+package fpgo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueueIter(t *testing.T) {
+	// NOTE: range-over-func needs the go1.23 language version, call the iter.Seq directly
+	collect := func(seq func(yield func(int) bool)) []int {
+		result := []int{}
+		seq(func(val int) bool {
+			result = append(result, val)
+			return true
+		})
+		return result
+	}
+
+	channelQueue := NewChannelQueue[int](3)
+	channelQueue.Offer(1)
+	channelQueue.Offer(2)
+	close(channelQueue)
+	assert.Equal(t, []int{1, 2}, collect(channelQueue.Iter()))
+
+	priorityQueue := NewPriorityChannelQueue(0, func(a, b int) bool {
+		return a > b
+	})
+	priorityQueue.Offer(1)
+	priorityQueue.Offer(3)
+	priorityQueue.Offer(2)
+	priorityQueue.Close()
+	assert.Equal(t, []int{3, 2, 1}, collect(priorityQueue.Iter()))
+
+	// Break
+	deque := NewDeque[int](0)
+	deque.Offer(1)
+	deque.Offer(2)
+	var actual []int
+	deque.Iter()(func(val int) bool {
+		actual = append(actual, val)
+		return false
+	})
+	assert.Equal(t, []int{1}, actual)
+	deque.Close()
+	assert.Equal(t, []int{2}, collect(deque.Iter()))
+
+	ringQueue := NewRingQueue[int](2)
+	ringQueue.Offer(1)
+	ringQueue.Close()
+	assert.Equal(t, []int{1}, collect(ringQueue.Iter()))
+
+	broadcastQueue := NewBroadcastQueue[int](0)
+	consumer := broadcastQueue.Subscribe()
+	broadcastQueue.Offer(1)
+	broadcastQueue.Close()
+	assert.Equal(t, []int{1}, collect(consumer.Iter()))
+}
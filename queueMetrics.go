@@ -0,0 +1,98 @@
+package fpgo
+
+import "sync/atomic"
+
+// Queue Metrics
+
+// QueueMetrics Counters of a queue
+type QueueMetrics struct {
+	// Offered The number of accepted items
+	Offered uint64
+	// Polled The number of taken items
+	Polled uint64
+	// Rejected The number of items rejected by ErrQueueIsFull
+	Rejected uint64
+	// Dropped The number of items discarded by Close()
+	Dropped uint64
+}
+
+type queueWatermark struct {
+	threshold int
+	fn        func(count int)
+}
+
+// Metrics Get the counters of the BufferedChannelQueue
+//
+// NOTE: items received from GetChannel() directly aren't counted as Polled.
+func (q *BufferedChannelQueue[T]) Metrics() QueueMetrics {
+	return QueueMetrics{
+		Offered:  atomic.LoadUint64(&q.metrics.Offered),
+		Polled:   atomic.LoadUint64(&q.metrics.Polled),
+		Rejected: atomic.LoadUint64(&q.metrics.Rejected),
+		Dropped:  atomic.LoadUint64(&q.metrics.Dropped),
+	}
+}
+
+// OnHighWatermark Call fn once the Count() rises to the threshold, it's called again only after the Count() falls back(below the low watermark if set)
+func (q *BufferedChannelQueue[T]) OnHighWatermark(threshold int, fn func(count int)) *BufferedChannelQueue[T] {
+	q.watermarkLock.Lock()
+	defer q.watermarkLock.Unlock()
+
+	q.highWatermark = queueWatermark{threshold: threshold, fn: fn}
+	return q
+}
+
+// OnLowWatermark Call fn once the Count() falls to the threshold after reaching the high watermark
+func (q *BufferedChannelQueue[T]) OnLowWatermark(threshold int, fn func(count int)) *BufferedChannelQueue[T] {
+	q.watermarkLock.Lock()
+	defer q.watermarkLock.Unlock()
+
+	q.lowWatermark = queueWatermark{threshold: threshold, fn: fn}
+	return q
+}
+
+func (q *BufferedChannelQueue[T]) recordTaken(val T, err error) (T, error) {
+	if err == nil {
+		q.recordTakenN(1)
+	}
+	return val, err
+}
+
+func (q *BufferedChannelQueue[T]) recordTakenN(n int) {
+	if n <= 0 {
+		return
+	}
+
+	atomic.AddUint64(&q.metrics.Polled, uint64(n))
+	q.checkWatermarks()
+}
+
+// checkWatermarks Call the watermark callbacks(outside the locks) if the Count() crosses them
+func (q *BufferedChannelQueue[T]) checkWatermarks() {
+	q.watermarkLock.Lock()
+	if q.highWatermark.fn == nil && q.lowWatermark.fn == nil {
+		q.watermarkLock.Unlock()
+		return
+	}
+
+	count := q.Count()
+	var fn func(int)
+	if !q.isAboveHigh {
+		if q.highWatermark.fn != nil && count >= q.highWatermark.threshold {
+			q.isAboveHigh = true
+			fn = q.highWatermark.fn
+		}
+	} else if q.lowWatermark.fn != nil {
+		if count <= q.lowWatermark.threshold {
+			q.isAboveHigh = false
+			fn = q.lowWatermark.fn
+		}
+	} else if count < q.highWatermark.threshold {
+		q.isAboveHigh = false
+	}
+	q.watermarkLock.Unlock()
+
+	if fn != nil {
+		fn(count)
+	}
+}
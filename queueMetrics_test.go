@@ -0,0 +1,36 @@
+package fpgo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBufferedChannelQueueMetrics(t *testing.T) {
+	var highs, lows []int
+	bufferedChannelQueue := NewBufferedChannelQueue[int](2, 2, 10).
+		OnHighWatermark(3, func(count int) {
+			highs = append(highs, count)
+		}).
+		OnLowWatermark(1, func(count int) {
+			lows = append(lows, count)
+		})
+
+	for i := 1; i <= 5; i++ {
+		bufferedChannelQueue.Offer(i)
+	}
+	assert.Equal(t, []int{3}, highs)
+	bufferedChannelQueue.PollN(2, time.Millisecond)
+	assert.Empty(t, lows)
+	bufferedChannelQueue.TakeWithTimeout(time.Second)
+	assert.Equal(t, []int{1}, lows)
+	// Reached again
+	bufferedChannelQueue.Offer(6)
+	bufferedChannelQueue.Offer(7)
+	assert.Equal(t, []int{3, 3}, highs)
+
+	assert.Equal(t, QueueMetrics{Offered: 6, Polled: 3, Rejected: 1}, bufferedChannelQueue.Metrics())
+	bufferedChannelQueue.Close()
+	assert.Equal(t, uint64(3), bufferedChannelQueue.Metrics().Dropped)
+}
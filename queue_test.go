@@ -1,6 +1,7 @@
 package fpgo
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -375,3 +376,165 @@ func TestNewBufferedChannelQueue(t *testing.T) {
 	assert.GreaterOrEqual(t, bufferedChannelQueue.pool.nodeCount, 100)
 	close(asyncTaskDone)
 }
+
+func TestChannelQueueBatch(t *testing.T) {
+	channelQueue := NewChannelQueue[int](5)
+	for i := 1; i <= 4; i++ {
+		channelQueue.Offer(i)
+	}
+	assert.Equal(t, []int{1, 2}, channelQueue.PollN(2, time.Millisecond))
+	// Timeout with partial results
+	assert.Equal(t, []int{3, 4}, channelQueue.PollN(3, time.Millisecond))
+	go func() {
+		time.Sleep(time.Millisecond)
+		channelQueue.Offer(5)
+	}()
+	assert.Equal(t, []int{5}, channelQueue.PollN(1, time.Second))
+
+	channelQueue.Offer(6)
+	channelQueue.Offer(7)
+	assert.Equal(t, []int{6, 7}, channelQueue.Drain())
+	assert.Equal(t, []int{}, channelQueue.Drain())
+	channelQueue.Offer(8)
+	close(channelQueue)
+	assert.Equal(t, []int{8}, channelQueue.Drain())
+	assert.Equal(t, []int{}, channelQueue.PollN(1, time.Second))
+
+	bufferedChannelQueue := NewBufferedChannelQueue[int](2, 10, 10)
+	defer bufferedChannelQueue.Close()
+	for i := 1; i <= 5; i++ {
+		bufferedChannelQueue.Offer(i)
+	}
+	assert.Equal(t, []int{1, 2, 3}, bufferedChannelQueue.PollN(3, time.Millisecond))
+	assert.Equal(t, []int{4, 5}, bufferedChannelQueue.Drain())
+	assert.Equal(t, 0, bufferedChannelQueue.Count())
+	go func() {
+		time.Sleep(time.Millisecond)
+		bufferedChannelQueue.Offer(6)
+	}()
+	assert.Equal(t, []int{6}, bufferedChannelQueue.PollN(2, 20*time.Millisecond))
+}
+
+func TestBufferedChannelQueuePeek(t *testing.T) {
+	bufferedChannelQueue := NewBufferedChannelQueue[int](1, 10, 10)
+	_, err := bufferedChannelQueue.Peek()
+	assert.Equal(t, ErrQueueIsEmpty, err)
+
+	for i := 1; i <= 3; i++ {
+		bufferedChannelQueue.Offer(i)
+	}
+	val, _ := bufferedChannelQueue.Peek()
+	assert.Equal(t, 1, val)
+	val, _ = bufferedChannelQueue.Peek()
+	assert.Equal(t, 1, val)
+	assert.Equal(t, 3, bufferedChannelQueue.Count())
+	val, _ = bufferedChannelQueue.Poll()
+	assert.Equal(t, 1, val)
+	val, _ = bufferedChannelQueue.Peek()
+	assert.Equal(t, 2, val)
+	val, _ = bufferedChannelQueue.TakeWithTimeout(time.Second)
+	assert.Equal(t, 2, val)
+	bufferedChannelQueue.Peek()
+	assert.Equal(t, []int{3}, bufferedChannelQueue.Drain())
+
+	// A blocked taker gets the peeked head
+	taken := make(chan int)
+	go func() {
+		val, _ := bufferedChannelQueue.Take()
+		taken <- val
+	}()
+	time.Sleep(time.Millisecond)
+	bufferedChannelQueue.Offer(4)
+	bufferedChannelQueue.Peek()
+	assert.Equal(t, 4, <-taken)
+
+	bufferedChannelQueue.Close()
+	_, err = bufferedChannelQueue.Peek()
+	assert.Equal(t, ErrQueueIsClosed, err)
+}
+
+func TestBufferedChannelQueueResize(t *testing.T) {
+	bufferedChannelQueue := NewBufferedChannelQueue[int](2, 2, 10)
+	defer bufferedChannelQueue.Close()
+	for i := 1; i <= 4; i++ {
+		assert.NoError(t, bufferedChannelQueue.Offer(i))
+	}
+	assert.Equal(t, ErrQueueIsFull, bufferedChannelQueue.Offer(5))
+	assert.Equal(t, 1.0, bufferedChannelQueue.LoadFactor())
+
+	// Grow
+	bufferedChannelQueue.SetBufferSizeMaximum(6)
+	assert.Equal(t, 6, bufferedChannelQueue.GetBufferSizeMaximum())
+	assert.NoError(t, bufferedChannelQueue.Offer(5))
+	assert.NoError(t, bufferedChannelQueue.Offer(6))
+	assert.Equal(t, 0.75, bufferedChannelQueue.LoadFactor())
+
+	// Shrink without dropping
+	bufferedChannelQueue.SetBufferSizeMaximum(1)
+	assert.Equal(t, ErrQueueIsFull, bufferedChannelQueue.Offer(7))
+	assert.Equal(t, 2.0, bufferedChannelQueue.LoadFactor())
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6}, bufferedChannelQueue.Drain())
+	assert.Equal(t, 0.0, bufferedChannelQueue.LoadFactor())
+}
+
+func TestQueueWithContext(t *testing.T) {
+	type contextQueue interface {
+		Offer(val int) error
+		OfferWithContext(ctx context.Context, val int) error
+		TakeWithContext(ctx context.Context) (int, error)
+	}
+	bufferedChannelQueue := NewBufferedChannelQueue[int](1, 0, 0)
+	defer bufferedChannelQueue.Close()
+	less := func(a, b int) bool {
+		return a < b
+	}
+	for _, queue := range []contextQueue{
+		NewChannelQueue[int](1),
+		bufferedChannelQueue,
+		NewPriorityChannelQueue(1, less),
+		NewDeque[int](1),
+		NewRingQueue[int](1),
+	} {
+		// Full
+		for queue.Offer(0) == nil {
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+		assert.Equal(t, context.DeadlineExceeded, queue.OfferWithContext(ctx, 1))
+		cancel()
+
+		for {
+			ctx, cancel = context.WithTimeout(context.Background(), time.Millisecond)
+			_, err := queue.TakeWithContext(ctx)
+			cancel()
+			if err != nil {
+				assert.Equal(t, context.DeadlineExceeded, err)
+				break
+			}
+		}
+		ctx, cancel = context.WithCancel(context.Background())
+		cancel()
+		_, err := queue.TakeWithContext(ctx)
+		assert.Equal(t, context.Canceled, err)
+
+		assert.NoError(t, queue.OfferWithContext(context.Background(), 2))
+		val, err := queue.TakeWithContext(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 2, val)
+	}
+
+	broadcastQueue := NewBroadcastQueue[int](1)
+	consumer := broadcastQueue.Subscribe()
+	broadcastQueue.Offer(1)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Equal(t, context.Canceled, broadcastQueue.OfferWithContext(ctx, 2))
+	val, _ := consumer.TakeWithContext(context.Background())
+	assert.Equal(t, 1, val)
+	_, err := consumer.TakeWithContext(ctx)
+	assert.Equal(t, context.Canceled, err)
+
+	delayQueue := NewDelayQueue[int]()
+	delayQueue.Offer(1, time.Hour)
+	_, err = delayQueue.TakeWithContext(ctx)
+	assert.Equal(t, context.Canceled, err)
+}
@@ -0,0 +1,260 @@
+package fpgo
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"time"
+)
+
+// RateLimiter
+
+// ErrRateLimitExceeded The request is rejected because it can't be served within the limit of the RateLimiter
+var ErrRateLimitExceeded = errors.New("rate limit exceeded")
+
+// RateLimiter Limit the rate of requests(TokenBucketLimiter & LeakyBucketLimiter implement it)
+type RateLimiter interface {
+	// Allow Take a permit right now, false if it's not available without waiting
+	Allow() bool
+	// Reserve Reserve a permit, the caller should act after Delay() if it's OK()
+	Reserve() *Reservation
+	// Wait Wait for a permit(ErrRateLimitExceeded if it can't be reserved, or the ctx error if it's done while waiting)
+	Wait(ctx context.Context) error
+}
+
+// RateLimiterOption Options of RateLimiter
+type RateLimiterOption struct {
+	// TimeScheduler The clock of the RateLimiter(DefaultTimeScheduler if nil)
+	TimeScheduler TimeScheduler
+}
+
+// Reservation A reserved permit of RateLimiter
+type Reservation struct {
+	ok     bool
+	delay  time.Duration
+	cancel func()
+	once   sync.Once
+}
+
+// OK Check the permit is reserved or not
+func (reservationSelf *Reservation) OK() bool {
+	return reservationSelf.ok
+}
+
+// Delay Get the delay before acting on the permit(from the time it's reserved)
+func (reservationSelf *Reservation) Delay() time.Duration {
+	return reservationSelf.delay
+}
+
+// Cancel Give the permit back(as much as possible) if it won't be used
+func (reservationSelf *Reservation) Cancel() {
+	if !reservationSelf.ok || reservationSelf.cancel == nil {
+		return
+	}
+	reservationSelf.once.Do(reservationSelf.cancel)
+}
+
+// rateLimiterBase Shared parts of the RateLimiter implementations
+type rateLimiterBase struct {
+	lock          sync.Mutex
+	timeScheduler TimeScheduler
+	// reserve Reserve a permit within maxDelay at now(lock held)
+	reserve func(now time.Time, maxDelay time.Duration) *Reservation
+}
+
+func newRateLimiterBase(opts ...RateLimiterOption) rateLimiterBase {
+	var option RateLimiterOption
+	if len(opts) > 0 {
+		option = opts[0]
+	}
+	if option.TimeScheduler == nil {
+		option.TimeScheduler = DefaultTimeScheduler
+	}
+	return rateLimiterBase{timeScheduler: option.TimeScheduler}
+}
+
+func (limiterSelf *rateLimiterBase) doReserve(maxDelay time.Duration) *Reservation {
+	limiterSelf.lock.Lock()
+	defer limiterSelf.lock.Unlock()
+
+	return limiterSelf.reserve(limiterSelf.timeScheduler.Now(), maxDelay)
+}
+
+// Allow Take a permit right now, false if it's not available without waiting
+func (limiterSelf *rateLimiterBase) Allow() bool {
+	return limiterSelf.doReserve(0).OK()
+}
+
+// Reserve Reserve a permit, the caller should act after Delay() if it's OK()
+func (limiterSelf *rateLimiterBase) Reserve() *Reservation {
+	return limiterSelf.doReserve(math.MaxInt64)
+}
+
+// Wait Wait for a permit(ErrRateLimitExceeded if it can't be reserved, or the ctx error if it's done while waiting)
+func (limiterSelf *rateLimiterBase) Wait(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	reservation := limiterSelf.Reserve()
+	if !reservation.OK() {
+		return ErrRateLimitExceeded
+	}
+	if reservation.Delay() <= 0 {
+		return nil
+	}
+
+	ready := make(chan struct{})
+	timer := limiterSelf.timeScheduler.AfterFunc(reservation.Delay(), func() {
+		close(ready)
+	})
+	select {
+	case <-ready:
+		return nil
+	case <-ctx.Done():
+		timer.Stop()
+		reservation.Cancel()
+		return ctx.Err()
+	}
+}
+
+// TokenBucketLimiter
+
+// TokenBucketLimiter RateLimiter refilling rate tokens per second up to burst tokens, a request takes a token(bursts are allowed)
+type TokenBucketLimiter struct {
+	rateLimiterBase
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// NewTokenBucketLimiter New TokenBucketLimiter refilling rate tokens per second(unlimited if <= 0) up to burst tokens(1 if <= 0), it starts full
+func NewTokenBucketLimiter(rate float64, burst int, opts ...RateLimiterOption) *TokenBucketLimiter {
+	if burst <= 0 {
+		burst = 1
+	}
+	limiter := &TokenBucketLimiter{
+		rateLimiterBase: newRateLimiterBase(opts...),
+		rate:            rate,
+		burst:           float64(burst),
+		tokens:          float64(burst),
+	}
+	limiter.last = limiter.timeScheduler.Now()
+	limiter.reserve = limiter.doReserveAt
+	return limiter
+}
+
+// Tokens Get the available tokens(negative if they're reserved ahead)
+func (limiterSelf *TokenBucketLimiter) Tokens() float64 {
+	limiterSelf.lock.Lock()
+	defer limiterSelf.lock.Unlock()
+
+	limiterSelf.refill(limiterSelf.timeScheduler.Now())
+	return limiterSelf.tokens
+}
+
+func (limiterSelf *TokenBucketLimiter) refill(now time.Time) {
+	if now.After(limiterSelf.last) {
+		limiterSelf.tokens = math.Min(limiterSelf.burst, limiterSelf.tokens+now.Sub(limiterSelf.last).Seconds()*limiterSelf.rate)
+		limiterSelf.last = now
+	}
+}
+
+func (limiterSelf *TokenBucketLimiter) doReserveAt(now time.Time, maxDelay time.Duration) *Reservation {
+	if limiterSelf.rate <= 0 {
+		return &Reservation{ok: true}
+	}
+
+	limiterSelf.refill(now)
+	var delay time.Duration
+	if tokens := limiterSelf.tokens - 1; tokens < 0 {
+		delay = time.Duration(math.Ceil(-tokens / limiterSelf.rate * float64(time.Second)))
+	}
+	if delay > maxDelay {
+		return &Reservation{}
+	}
+
+	limiterSelf.tokens--
+	return &Reservation{ok: true, delay: delay, cancel: func() {
+		limiterSelf.lock.Lock()
+		defer limiterSelf.lock.Unlock()
+
+		limiterSelf.refill(limiterSelf.timeScheduler.Now())
+		limiterSelf.tokens = math.Min(limiterSelf.burst, limiterSelf.tokens+1)
+	}}
+}
+
+// LeakyBucketLimiter
+
+// LeakyBucketLimiter RateLimiter spacing requests evenly by 1/rate seconds(no bursts), at most capacity requests could wait in the bucket
+type LeakyBucketLimiter struct {
+	rateLimiterBase
+	interval time.Duration
+	capacity int
+	next     time.Time
+}
+
+// NewLeakyBucketLimiter New LeakyBucketLimiter serving rate requests per second(unlimited if <= 0) with capacity waiting requests at most(no waiting if <= 0)
+func NewLeakyBucketLimiter(rate float64, capacity int, opts ...RateLimiterOption) *LeakyBucketLimiter {
+	var interval time.Duration
+	if rate > 0 {
+		interval = time.Duration(float64(time.Second) / rate)
+	}
+	if capacity < 0 {
+		capacity = 0
+	}
+	limiter := &LeakyBucketLimiter{
+		rateLimiterBase: newRateLimiterBase(opts...),
+		interval:        interval,
+		capacity:        capacity,
+	}
+	limiter.reserve = limiter.doReserveAt
+	return limiter
+}
+
+func (limiterSelf *LeakyBucketLimiter) doReserveAt(now time.Time, maxDelay time.Duration) *Reservation {
+	if limiterSelf.interval <= 0 {
+		return &Reservation{ok: true}
+	}
+
+	at := now
+	if limiterSelf.next.After(now) {
+		at = limiterSelf.next
+	}
+	delay := at.Sub(now)
+	if delay > maxDelay || delay > time.Duration(limiterSelf.capacity)*limiterSelf.interval {
+		return &Reservation{}
+	}
+
+	limiterSelf.next = at.Add(limiterSelf.interval)
+	reserved := limiterSelf.next
+	return &Reservation{ok: true, delay: delay, cancel: func() {
+		limiterSelf.lock.Lock()
+		defer limiterSelf.lock.Unlock()
+
+		// Only the latest reservation could be given back
+		if limiterSelf.next.Equal(reserved) {
+			limiterSelf.next = reserved.Add(-limiterSelf.interval)
+		}
+	}}
+}
+
+// Decorators
+
+// RateLimited Decorate fn to wait for a permit of the RateLimiter before each call(ErrRateLimitExceeded or the ctx error without calling fn)
+func RateLimited[T any, R any](limiter RateLimiter, fn func(context.Context, T) (R, error)) func(context.Context, T) (R, error) {
+	return func(ctx context.Context, in T) (R, error) {
+		if err := limiter.Wait(ctx); err != nil {
+			return *new(R), err
+		}
+		return fn(ctx, in)
+	}
+}
+
+// ThrottleByLimiter Publish items allowed by the RateLimiter right now and drop the others
+func (publisherSelf *PublisherDef[T]) ThrottleByLimiter(limiter RateLimiter) *PublisherDef[T] {
+	return publisherSelf.Filter(func(T) bool {
+		return limiter.Allow()
+	})
+}
@@ -0,0 +1,129 @@
+package fpgo
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenBucketLimiter(t *testing.T) {
+	timeScheduler := NewVirtualTimeScheduler(time.Now())
+	var limiter RateLimiter
+	tokenBucket := NewTokenBucketLimiter(10, 3, RateLimiterOption{TimeScheduler: timeScheduler})
+	limiter = tokenBucket
+
+	// Burst
+	assert.Equal(t, true, limiter.Allow())
+	assert.Equal(t, true, limiter.Allow())
+	assert.Equal(t, true, limiter.Allow())
+	assert.Equal(t, false, limiter.Allow())
+	timeScheduler.Advance(100 * time.Millisecond)
+	assert.Equal(t, true, limiter.Allow())
+	assert.Equal(t, false, limiter.Allow())
+
+	// Refilled up to the burst
+	timeScheduler.Advance(time.Second)
+	assert.Equal(t, 3.0, tokenBucket.Tokens())
+
+	// Reserve ahead
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, time.Duration(0), limiter.Reserve().Delay())
+	}
+	reservation := limiter.Reserve()
+	assert.Equal(t, true, reservation.OK())
+	assert.Equal(t, 100*time.Millisecond, reservation.Delay())
+	assert.Equal(t, 200*time.Millisecond, limiter.Reserve().Delay())
+	reservation.Cancel()
+	reservation.Cancel()
+	assert.InDelta(t, -1.0, tokenBucket.Tokens(), 0.0001)
+
+	// Wait
+	done := make(chan error)
+	go func() {
+		done <- limiter.Wait(context.Background())
+	}()
+	assert.Eventually(t, func() bool {
+		return tokenBucket.Tokens() < -1.5
+	}, time.Second, time.Millisecond)
+	timeScheduler.Advance(199 * time.Millisecond)
+	select {
+	case <-done:
+		assert.Fail(t, "it should be waiting")
+	default:
+	}
+	timeScheduler.Advance(time.Millisecond)
+	assert.NoError(t, <-done)
+
+	// Canceled while waiting
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		done <- limiter.Wait(ctx)
+	}()
+	assert.Eventually(t, func() bool {
+		return tokenBucket.Tokens() < -0.5
+	}, time.Second, time.Millisecond)
+	cancel()
+	assert.Equal(t, context.Canceled, <-done)
+	assert.InDelta(t, 0.0, tokenBucket.Tokens(), 0.0001)
+	assert.Equal(t, context.Canceled, limiter.Wait(ctx))
+
+	// Unlimited
+	unlimited := NewTokenBucketLimiter(0, 1)
+	for i := 0; i < 100; i++ {
+		assert.Equal(t, true, unlimited.Allow())
+	}
+}
+
+func TestLeakyBucketLimiter(t *testing.T) {
+	timeScheduler := NewVirtualTimeScheduler(time.Now())
+	limiter := NewLeakyBucketLimiter(10, 2, RateLimiterOption{TimeScheduler: timeScheduler})
+
+	// No burst
+	assert.Equal(t, true, limiter.Allow())
+	assert.Equal(t, false, limiter.Allow())
+	timeScheduler.Advance(100 * time.Millisecond)
+	assert.Equal(t, true, limiter.Allow())
+
+	// At most 2 waiting
+	assert.Equal(t, 100*time.Millisecond, limiter.Reserve().Delay())
+	last := limiter.Reserve()
+	assert.Equal(t, 200*time.Millisecond, last.Delay())
+	assert.Equal(t, false, limiter.Reserve().OK())
+	assert.Equal(t, ErrRateLimitExceeded, limiter.Wait(context.Background()))
+	last.Cancel()
+	assert.Equal(t, 200*time.Millisecond, limiter.Reserve().Delay())
+
+	timeScheduler.Advance(300 * time.Millisecond)
+	assert.Equal(t, true, limiter.Allow())
+	timeScheduler.Advance(100 * time.Millisecond)
+	assert.NoError(t, limiter.Wait(context.Background()))
+	assert.Equal(t, true, NewLeakyBucketLimiter(0, 0).Allow())
+}
+
+func TestRateLimitDecorators(t *testing.T) {
+	timeScheduler := NewVirtualTimeScheduler(time.Now())
+	limiter := NewTokenBucketLimiter(1, 2, RateLimiterOption{TimeScheduler: timeScheduler})
+
+	p := PublisherNewGenerics[int]()
+	actual := collectPublisher(p.ThrottleByLimiter(limiter))
+	for i := 1; i <= 4; i++ {
+		p.Publish(i)
+	}
+	timeScheduler.Advance(time.Second)
+	p.Publish(5)
+	p.Publish(6)
+	assert.Equal(t, []int{1, 2, 5}, *actual)
+
+	leaky := NewLeakyBucketLimiter(1, 0, RateLimiterOption{TimeScheduler: timeScheduler})
+	format := RateLimited(leaky, func(_ context.Context, v int) (string, error) {
+		return strconv.Itoa(v), nil
+	})
+	result, err := format(context.Background(), 1)
+	assert.Equal(t, "1", result)
+	assert.NoError(t, err)
+	_, err = format(context.Background(), 2)
+	assert.Equal(t, ErrRateLimitExceeded, err)
+}
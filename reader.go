@@ -0,0 +1,75 @@
+package fpgo
+
+// Reader
+
+// Reader Reader monad inspired by Haskell, a computation of T depending on the environment E(e.g. configs/dependencies)
+type Reader[E any, T any] struct {
+	run func(E) T
+}
+
+// NewReader New Reader by the function of the environment
+func NewReader[E any, T any](run func(E) T) *Reader[E, T] {
+	return &Reader[E, T]{run: run}
+}
+
+// ReaderOf New Reader ignoring the environment and returning the value
+func ReaderOf[E any, T any](val T) *Reader[E, T] {
+	return NewReader(func(E) T {
+		return val
+	})
+}
+
+// ReaderAsk New Reader returning the environment itself
+func ReaderAsk[E any]() *Reader[E, E] {
+	return NewReader(func(env E) E {
+		return env
+	})
+}
+
+// ReaderAsks New Reader returning a part of the environment selected by fn
+func ReaderAsks[E any, T any](fn func(E) T) *Reader[E, T] {
+	return NewReader(fn)
+}
+
+// Run Run the Reader with the environment
+func (readerSelf *Reader[E, T]) Run(env E) T {
+	return readerSelf.run(env)
+}
+
+// Map Map the result of the Reader by function
+func (readerSelf *Reader[E, T]) Map(fn func(T) T) *Reader[E, T] {
+	return ReaderMap(readerSelf, fn)
+}
+
+// FlatMap FlatMap the result of the Reader by function(the same environment is passed to the next Reader)
+func (readerSelf *Reader[E, T]) FlatMap(fn func(T) *Reader[E, T]) *Reader[E, T] {
+	return ReaderFlatMap(readerSelf, fn)
+}
+
+// Local New Reader running with the environment modified by fn(e.g. overriding a config locally)
+func (readerSelf *Reader[E, T]) Local(fn func(E) E) *Reader[E, T] {
+	return NewReader(func(env E) T {
+		return readerSelf.run(fn(env))
+	})
+}
+
+// ToMonadIO New MonadIO running the Reader with the environment when it's evaluated
+func (readerSelf *Reader[E, T]) ToMonadIO(env E) *MonadIODef[T] {
+	return MonadIONewGenerics(func() T {
+		return readerSelf.run(env)
+	})
+}
+
+// ReaderMap Map the result of the Reader into another type by function
+func ReaderMap[E any, T any, R any](reader *Reader[E, T], fn func(T) R) *Reader[E, R] {
+	return NewReader(func(env E) R {
+		return fn(reader.run(env))
+	})
+}
+
+// ReaderFlatMap FlatMap the result of the Reader into a Reader of another type(the same environment is passed to it)
+func ReaderFlatMap[E any, T any, R any](reader *Reader[E, T], fn func(T) *Reader[E, R]) *Reader[E, R] {
+	return NewReader(func(env E) R {
+		return fn(reader.run(env)).run(env)
+	})
+}
@@ -0,0 +1,42 @@
+package fpgo
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type readerTestConfig struct {
+	Host string
+	Port int
+}
+
+func TestReader(t *testing.T) {
+	host := ReaderAsks(func(config readerTestConfig) string {
+		return config.Host
+	})
+	port := ReaderAsks(func(config readerTestConfig) int {
+		return config.Port
+	})
+	address := ReaderFlatMap(host, func(host string) *Reader[readerTestConfig, string] {
+		return ReaderMap(port, func(port int) string {
+			return host + ":" + strconv.Itoa(port)
+		})
+	})
+	config := readerTestConfig{Host: "localhost", Port: 80}
+
+	assert.Equal(t, "localhost:80", address.Run(config))
+	assert.Equal(t, "http://localhost:80", address.Map(func(address string) string {
+		return "http://" + address
+	}).Run(config))
+	assert.Equal(t, "localhost:8080", address.Local(func(config readerTestConfig) readerTestConfig {
+		config.Port = 8080
+		return config
+	}).Run(config))
+	assert.Equal(t, config, ReaderAsk[readerTestConfig]().Run(config))
+	assert.Equal(t, 1, ReaderOf[readerTestConfig](1).Run(config))
+	assert.Equal(t, 81, port.FlatMap(func(port int) *Reader[readerTestConfig, int] {
+		return ReaderOf[readerTestConfig](port + 1)
+	}).ToMonadIO(config).Eval())
+}
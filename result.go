@@ -0,0 +1,155 @@
+package fpgo
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+var (
+	// ErrResultIsErr Result Is Err (Unwrap() on a failed Result)
+	ErrResultIsErr = errors.New("result is err")
+)
+
+// Result
+
+// Result Result inspired by Rust/Scala(Try), holding either a value or an error
+type Result[T any] struct {
+	val T
+	err error
+}
+
+// ResultOk New a successful Result by a given value
+func ResultOk[T any](val T) Result[T] {
+	return Result[T]{val: val}
+}
+
+// ResultErr New a failed Result by a given error
+func ResultErr[T any](err error) Result[T] {
+	return Result[T]{err: err}
+}
+
+// ResultFrom New Result by the common Golang (value, error) returns
+func ResultFrom[T any](val T, err error) Result[T] {
+	if err != nil {
+		return ResultErr[T](err)
+	}
+
+	return ResultOk(val)
+}
+
+// IsOk Check is it successful
+func (resultSelf Result[T]) IsOk() bool {
+	return resultSelf.err == nil
+}
+
+// IsErr Check is it failed
+func (resultSelf Result[T]) IsErr() bool {
+	return resultSelf.err != nil
+}
+
+// Get Get the (value, error) pair
+func (resultSelf Result[T]) Get() (T, error) {
+	return resultSelf.val, resultSelf.err
+}
+
+// Err Get the error (nil if it's successful)
+func (resultSelf Result[T]) Err() error {
+	return resultSelf.err
+}
+
+// Or Get the value, if it's failed then return a given fallback value
+func (resultSelf Result[T]) Or(or T) T {
+	if resultSelf.IsErr() {
+		return or
+	}
+
+	return resultSelf.val
+}
+
+// Unwrap Get the value, panic if it's failed
+func (resultSelf Result[T]) Unwrap() T {
+	if resultSelf.IsErr() {
+		panic(ErrResultIsErr)
+	}
+
+	return resultSelf.val
+}
+
+// Is Check is it failed by the target error(by errors.Is, following the Unwrap() chain of the error)
+func (resultSelf Result[T]) Is(target error) bool {
+	return resultSelf.err != nil && errors.Is(resultSelf.err, target)
+}
+
+// As Find the first error matching the target in the Unwrap() chain of the error(by errors.As), false if it's successful
+func (resultSelf Result[T]) As(target interface{}) bool {
+	return resultSelf.err != nil && errors.As(resultSelf.err, target)
+}
+
+// Format Format as Ok(value) or Err(error) for fmt, the verb & flags are applied to the value/error(e.g. %+v)
+func (resultSelf Result[T]) Format(f fmt.State, verb rune) {
+	if resultSelf.IsErr() {
+		fmt.Fprintf(f, "Err("+formatDirective(f, verb)+")", resultSelf.err)
+		return
+	}
+	fmt.Fprintf(f, "Ok("+formatDirective(f, verb)+")", resultSelf.val)
+}
+
+// formatDirective Rebuild the directive(e.g. %+08.3v) from the fmt.State & the verb
+func formatDirective(f fmt.State, verb rune) string {
+	directive := "%"
+	for _, flag := range "+-# 0" {
+		if f.Flag(int(flag)) {
+			directive += string(flag)
+		}
+	}
+	if width, ok := f.Width(); ok {
+		directive += strconv.Itoa(width)
+	}
+	if precision, ok := f.Precision(); ok {
+		directive += "." + strconv.Itoa(precision)
+	}
+	return directive + string(verb)
+}
+
+// ResultSequence Turn []Result[T] into Result[[]T], short-circuiting on the first error
+func ResultSequence[T any](list ...Result[T]) Result[[]T] {
+	values := make([]T, len(list))
+	for i, result := range list {
+		if result.IsErr() {
+			return ResultErr[[]T](result.err)
+		}
+		values[i] = result.val
+	}
+
+	return ResultOk(values)
+}
+
+// ResultTraverse Map the values by fn and sequence them, short-circuiting on the first error
+func ResultTraverse[T any, R any](fn func(T) Result[R], list ...T) Result[[]R] {
+	values := make([]R, len(list))
+	for i, item := range list {
+		result := fn(item)
+		if result.IsErr() {
+			return ResultErr[[]R](result.err)
+		}
+		values[i] = result.val
+	}
+
+	return ResultOk(values)
+}
+
+// PartitionResults Split the successful values & errors of the given Results (order kept)
+func PartitionResults[T any](list []Result[T]) ([]T, []error) {
+	values := make([]T, 0, len(list))
+	errs := make([]error, 0)
+	for _, result := range list {
+		if result.IsErr() {
+			errs = append(errs, result.err)
+		} else {
+			values = append(values, result.val)
+		}
+	}
+
+	return values, errs
+}
@@ -0,0 +1,78 @@
+package fpgo
+
+// ResultStream
+
+// ResultStreamDef Stream of Results, each item could be failed without aborting the whole Stream
+type ResultStreamDef[T any] []Result[T]
+
+// ResultStreamFrom New ResultStream instance from Results
+func ResultStreamFrom[T any](list ...Result[T]) *ResultStreamDef[T] {
+	return ResultStreamFromArray(list)
+}
+
+// ResultStreamFromArray New ResultStream instance from a Result array
+func ResultStreamFromArray[T any](list []Result[T]) *ResultStreamDef[T] {
+	result := ResultStreamDef[T](list)
+	return &result
+}
+
+// ResultStreamFromFunc New ResultStream instance by the results of fn on each item(e.g. strconv.Atoi)
+func ResultStreamFromFunc[T any, R any](fn func(T) (R, error), list ...T) *ResultStreamDef[R] {
+	result := make([]Result[R], len(list))
+	for i, item := range list {
+		result[i] = ResultFrom(fn(item))
+	}
+
+	return ResultStreamFromArray(result)
+}
+
+// ResultStreamMapOk Map the successful items by fn(the result type could be different), failed items are kept as they are
+func ResultStreamMapOk[T any, R any](resultStreamSelf *ResultStreamDef[T], fn func(T) (R, error)) *ResultStreamDef[R] {
+	result := make([]Result[R], len(*resultStreamSelf))
+	for i, item := range *resultStreamSelf {
+		if item.IsErr() {
+			result[i] = ResultErr[R](item.err)
+			continue
+		}
+		result[i] = ResultFrom(fn(item.val))
+	}
+
+	return ResultStreamFromArray(result)
+}
+
+// MapOk Map the successful items by fn(a failed fn makes the item failed), failed items are kept as they are
+func (resultStreamSelf *ResultStreamDef[T]) MapOk(fn func(T) (T, error)) *ResultStreamDef[T] {
+	return ResultStreamMapOk(resultStreamSelf, fn)
+}
+
+// FilterOk Filter the successful items by the predicate, failed items are kept as they are
+func (resultStreamSelf *ResultStreamDef[T]) FilterOk(fn Predicate[T]) *ResultStreamDef[T] {
+	result := make([]Result[T], 0, len(*resultStreamSelf))
+	for _, item := range *resultStreamSelf {
+		if item.IsErr() || fn(item.val) {
+			result = append(result, item)
+		}
+	}
+
+	return ResultStreamFromArray(result)
+}
+
+// CollectOrFirstErr Get all the values, or the first error if any item is failed
+func (resultStreamSelf *ResultStreamDef[T]) CollectOrFirstErr() ([]T, error) {
+	return ResultSequence(*resultStreamSelf...).Get()
+}
+
+// PartitionErrs Split the successful values & the errors(order kept)
+func (resultStreamSelf *ResultStreamDef[T]) PartitionErrs() ([]T, []error) {
+	return PartitionResults(*resultStreamSelf)
+}
+
+// Len Get the length of the ResultStream
+func (resultStreamSelf *ResultStreamDef[T]) Len() int {
+	return len(*resultStreamSelf)
+}
+
+// ToArray Get the Results as an array
+func (resultStreamSelf *ResultStreamDef[T]) ToArray() []Result[T] {
+	return *resultStreamSelf
+}
@@ -0,0 +1,48 @@
+package fpgo
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResultStream(t *testing.T) {
+	errNegative := errors.New("negative")
+	parsed := ResultStreamFromFunc(strconv.Atoi, "1", "x", "-2", "4")
+	assert.Equal(t, 4, parsed.Len())
+
+	positive := parsed.MapOk(func(v int) (int, error) {
+		if v < 0 {
+			return 0, errNegative
+		}
+		return v * 10, nil
+	})
+	values, errs := positive.PartitionErrs()
+	assert.Equal(t, []int{10, 40}, values)
+	assert.Equal(t, 2, len(errs))
+	assert.Equal(t, errNegative, errs[1])
+
+	values, err := positive.CollectOrFirstErr()
+	assert.Nil(t, values)
+	assert.True(t, errors.Is(err, strconv.ErrSyntax))
+
+	formatted := ResultStreamMapOk(parsed.FilterOk(func(v int) bool {
+		return v > 0
+	}), func(v int) (string, error) {
+		return "#" + strconv.Itoa(v), nil
+	})
+	assert.Equal(t, 3, formatted.Len())
+	assert.True(t, formatted.ToArray()[1].IsErr())
+	strs, errs := formatted.PartitionErrs()
+	assert.Equal(t, []string{"#1", "#4"}, strs)
+	assert.Equal(t, 1, len(errs))
+
+	values, err = ResultStreamFrom(ResultOk(1), ResultOk(2)).CollectOrFirstErr()
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, values)
+	values, err = ResultStreamFrom[int]().CollectOrFirstErr()
+	assert.NoError(t, err)
+	assert.Equal(t, []int{}, values)
+}
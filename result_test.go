@@ -0,0 +1,82 @@
+package fpgo
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResult(t *testing.T) {
+	var val int
+	var err error
+	errSample := errors.New("sample")
+
+	ok := ResultOk(1)
+	assert.Equal(t, true, ok.IsOk())
+	assert.Equal(t, false, ok.IsErr())
+	val, err = ok.Get()
+	assert.Equal(t, 1, val)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, ok.Or(2))
+	assert.Equal(t, 1, ok.Unwrap())
+
+	failed := ResultErr[int](errSample)
+	assert.Equal(t, false, failed.IsOk())
+	assert.Equal(t, true, failed.IsErr())
+	assert.Equal(t, errSample, failed.Err())
+	assert.Equal(t, 2, failed.Or(2))
+	assert.PanicsWithValue(t, ErrResultIsErr, func() {
+		failed.Unwrap()
+	})
+
+	assert.Equal(t, true, ResultFrom(strconv.Atoi("3")).IsOk())
+	assert.Equal(t, true, ResultFrom(strconv.Atoi("x")).IsErr())
+}
+
+func TestResultSequenceTraverse(t *testing.T) {
+	errSample := errors.New("sample")
+
+	assert.Equal(t, []int{1, 2, 3}, ResultSequence(ResultOk(1), ResultOk(2), ResultOk(3)).Unwrap())
+	assert.Equal(t, errSample, ResultSequence(ResultOk(1), ResultErr[int](errSample), ResultOk(3)).Err())
+	assert.Equal(t, []int{}, ResultSequence[int]().Unwrap())
+
+	called := 0
+	parse := func(in string) Result[int] {
+		called++
+		return ResultFrom(strconv.Atoi(in))
+	}
+	assert.Equal(t, []int{1, 2, 3}, ResultTraverse(parse, "1", "2", "3").Unwrap())
+	called = 0
+	assert.Equal(t, true, ResultTraverse(parse, "1", "x", "3").IsErr())
+	assert.Equal(t, 2, called)
+
+	values, errs := PartitionResults([]Result[int]{ResultOk(1), ResultErr[int](errSample), ResultOk(3)})
+	assert.Equal(t, []int{1, 3}, values)
+	assert.Equal(t, []error{errSample}, errs)
+}
+
+func TestResultErrorsFormat(t *testing.T) {
+	errSample := errors.New("sample")
+	failed := ResultErr[int](fmt.Errorf("wrapped: %w", errSample))
+	assert.True(t, failed.Is(errSample))
+	assert.False(t, failed.Is(ErrResultIsErr))
+	assert.False(t, ResultOk(1).Is(errSample))
+
+	_, err := os.Open("/not/existing")
+	var pathErr *fs.PathError
+	assert.True(t, ResultErr[int](err).As(&pathErr))
+	assert.Equal(t, "/not/existing", pathErr.Path)
+	assert.True(t, ResultErr[int](err).Is(fs.ErrNotExist))
+	assert.False(t, ResultOk(1).As(&pathErr))
+
+	assert.Equal(t, "Ok(1)", fmt.Sprintf("%v", ResultOk(1)))
+	assert.Equal(t, "Ok(  1)", fmt.Sprintf("%3d", ResultOk(1)))
+	assert.Equal(t, "Ok({A:1})", fmt.Sprintf("%+v", ResultOk(struct{ A int }{1})))
+	assert.Equal(t, "Err(wrapped: sample)", fmt.Sprintf("%v", failed))
+	assert.Equal(t, "[Ok(1) Err(sample)]", fmt.Sprint([]Result[int]{ResultOk(1), ResultErr[int](errSample)}))
+}
@@ -0,0 +1,103 @@
+package fpgo
+
+import (
+	"context"
+	"math/rand"
+	"time"
+)
+
+// Retry
+
+// RetryPolicy Decide whether & when to retry a failed attempt(shared by retrying utils)
+type RetryPolicy struct {
+	// MaxRetries The maximum number of retries(unlimited if < 0)
+	MaxRetries int
+	// InitialDelay The delay before the first retry
+	InitialDelay time.Duration
+	// Multiplier The delay grows by the multiplier for each retry(fixed delay if <= 1)
+	Multiplier float64
+	// MaxDelay The upper bound of the delay(no bound if <= 0)
+	MaxDelay time.Duration
+	// Jitter Randomize each delay within ±Jitter of it(e.g. 0.2 for ±20%, no jitter if <= 0)
+	Jitter float64
+	// ShouldRetry Decide whether the error is retryable(all errors if nil)
+	ShouldRetry func(error) bool
+	// OnRetry Called before each retry with the retry count(starting from 1), the last error & the delay
+	OnRetry func(retry int, err error, delay time.Duration)
+}
+
+// FixedBackoff New RetryPolicy retrying at most maxRetries times with the same delay
+func FixedBackoff(maxRetries int, delay time.Duration) RetryPolicy {
+	return RetryPolicy{MaxRetries: maxRetries, InitialDelay: delay}
+}
+
+// ExponentialBackoff New RetryPolicy retrying at most maxRetries times with the delay doubled each time(up to maxDelay)
+func ExponentialBackoff(maxRetries int, initialDelay time.Duration, maxDelay time.Duration) RetryPolicy {
+	return RetryPolicy{MaxRetries: maxRetries, InitialDelay: initialDelay, Multiplier: 2, MaxDelay: maxDelay}
+}
+
+// JitteredBackoff New RetryPolicy like ExponentialBackoff but with each delay randomized within ±jitter of it
+func JitteredBackoff(maxRetries int, initialDelay time.Duration, maxDelay time.Duration, jitter float64) RetryPolicy {
+	policy := ExponentialBackoff(maxRetries, initialDelay, maxDelay)
+	policy.Jitter = jitter
+	return policy
+}
+
+// CanRetry Check if the retry-th retry(starting from 1) is allowed for the error
+func (policySelf RetryPolicy) CanRetry(retry int, err error) bool {
+	if policySelf.MaxRetries >= 0 && retry > policySelf.MaxRetries {
+		return false
+	}
+	return policySelf.ShouldRetry == nil || policySelf.ShouldRetry(err)
+}
+
+// Delay Get the delay before the retry-th retry(starting from 1)
+func (policySelf RetryPolicy) Delay(retry int) time.Duration {
+	delay := float64(policySelf.InitialDelay)
+	if policySelf.Multiplier > 1 {
+		for i := 1; i < retry; i++ {
+			delay *= policySelf.Multiplier
+			if policySelf.MaxDelay > 0 && delay >= float64(policySelf.MaxDelay) {
+				break
+			}
+		}
+	}
+	if policySelf.MaxDelay > 0 && delay > float64(policySelf.MaxDelay) {
+		delay = float64(policySelf.MaxDelay)
+	}
+	if policySelf.Jitter > 0 {
+		delay *= 1 + policySelf.Jitter*(2*rand.Float64()-1)
+		if delay < 0 {
+			delay = 0
+		}
+	}
+	return time.Duration(delay)
+}
+
+// Retry Call fn & retry it by the RetryPolicy while it fails, the last result & error are returned
+func Retry[T any](fn func() (T, error), policy RetryPolicy) (T, error) {
+	return RetryWithContext(context.Background(), func(context.Context) (T, error) {
+		return fn()
+	}, policy)
+}
+
+// RetryWithContext Retry with the context, ctx.Err() is returned if it's done while waiting for the next retry
+func RetryWithContext[T any](ctx context.Context, fn func(context.Context) (T, error), policy RetryPolicy) (T, error) {
+	result, err := fn(ctx)
+	for retry := 1; err != nil && policy.CanRetry(retry, err); retry++ {
+		delay := policy.Delay(retry)
+		if policy.OnRetry != nil {
+			policy.OnRetry(retry, err, delay)
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return result, ctx.Err()
+		case <-timer.C:
+		}
+		result, err = fn(ctx)
+	}
+	return result, err
+}
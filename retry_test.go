@@ -0,0 +1,97 @@
+package fpgo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	errRetryable := errors.New("retryable")
+
+	policy := FixedBackoff(2, time.Second)
+	assert.Equal(t, true, policy.CanRetry(1, errRetryable))
+	assert.Equal(t, true, policy.CanRetry(2, errRetryable))
+	assert.Equal(t, false, policy.CanRetry(3, errRetryable))
+	assert.Equal(t, time.Second, policy.Delay(1))
+	assert.Equal(t, time.Second, policy.Delay(3))
+
+	policy = ExponentialBackoff(-1, time.Second, 5*time.Second)
+	assert.Equal(t, true, policy.CanRetry(100, errRetryable))
+	assert.Equal(t, time.Second, policy.Delay(1))
+	assert.Equal(t, 2*time.Second, policy.Delay(2))
+	assert.Equal(t, 4*time.Second, policy.Delay(3))
+	assert.Equal(t, 5*time.Second, policy.Delay(4))
+	assert.Equal(t, 5*time.Second, policy.Delay(1000))
+
+	policy.ShouldRetry = func(err error) bool {
+		return err == errRetryable
+	}
+	assert.Equal(t, true, policy.CanRetry(1, errRetryable))
+	assert.Equal(t, false, policy.CanRetry(1, errors.New("fatal")))
+}
+
+func TestJitteredBackoff(t *testing.T) {
+	policy := JitteredBackoff(-1, 100*time.Millisecond, time.Second, 0.2)
+	for i := 0; i < 100; i++ {
+		delay := policy.Delay(1)
+		assert.True(t, delay >= 80*time.Millisecond && delay <= 120*time.Millisecond)
+		delay = policy.Delay(10)
+		assert.True(t, delay >= 800*time.Millisecond && delay <= 1200*time.Millisecond)
+	}
+}
+
+func TestRetry(t *testing.T) {
+	errFailed := errors.New("failed")
+	attempts := 0
+	failTwice := func() (int, error) {
+		attempts++
+		if attempts < 3 {
+			return 0, errFailed
+		}
+		return attempts, nil
+	}
+
+	var retried []int
+	policy := FixedBackoff(3, 0)
+	policy.OnRetry = func(retry int, err error, delay time.Duration) {
+		assert.Equal(t, errFailed, err)
+		retried = append(retried, retry)
+	}
+	result, err := Retry(failTwice, policy)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, result)
+	assert.Equal(t, []int{1, 2}, retried)
+
+	// The last error is kept
+	attempts = 0
+	_, err = Retry(failTwice, FixedBackoff(1, 0))
+	assert.Equal(t, errFailed, err)
+	assert.Equal(t, 2, attempts)
+
+	// Not retryable
+	attempts = 0
+	policy = FixedBackoff(3, 0)
+	policy.ShouldRetry = func(err error) bool {
+		return false
+	}
+	_, err = Retry(failTwice, policy)
+	assert.Equal(t, errFailed, err)
+	assert.Equal(t, 1, attempts)
+
+	// Cancelled while waiting
+	ctx, cancel := context.WithCancel(context.Background())
+	attempts = 0
+	start := time.Now()
+	time.AfterFunc(5*time.Millisecond, cancel)
+	_, err = RetryWithContext(ctx, func(context.Context) (int, error) {
+		attempts++
+		return 0, errFailed
+	}, FixedBackoff(-1, time.Hour))
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, 1, attempts)
+	assert.True(t, time.Since(start) < time.Second)
+}
@@ -0,0 +1,204 @@
+package fpgo
+
+import (
+	"context"
+	"runtime"
+	"sync/atomic"
+	"time"
+)
+
+// RingQueue
+
+// RingQueue Fixed-capacity lock-free MPMC(multi-producer multi-consumer) queue by a ring buffer & atomics
+//
+// NOTE: the blocking Put()/Take() spin(yielding the processor) instead of parking goroutines, prefer it for high-throughput paths.
+type RingQueue[T any] struct {
+	_    [8]uint64
+	head uint64
+	_    [7]uint64
+	tail uint64
+	_    [7]uint64
+
+	mask     uint64
+	slots    []ringSlot[T]
+	isClosed AtomBool
+}
+
+type ringSlot[T any] struct {
+	sequence uint64
+	val      T
+}
+
+// NewRingQueue New RingQueue instance with capacity(rounded up to a power of 2)
+func NewRingQueue[T any](capacity int) *RingQueue[T] {
+	size := uint64(2)
+	for size < uint64(capacity) {
+		size <<= 1
+	}
+
+	slots := make([]ringSlot[T], size)
+	for i := range slots {
+		slots[i].sequence = uint64(i)
+	}
+	return &RingQueue[T]{mask: size - 1, slots: slots}
+}
+
+// Offer Offer the T val(non-blocking)
+func (q *RingQueue[T]) Offer(val T) error {
+	if q.isClosed.Get() {
+		return ErrQueueIsClosed
+	}
+
+	pos := atomic.LoadUint64(&q.tail)
+	for {
+		slot := &q.slots[pos&q.mask]
+		diff := int64(atomic.LoadUint64(&slot.sequence)) - int64(pos)
+		if diff == 0 {
+			if atomic.CompareAndSwapUint64(&q.tail, pos, pos+1) {
+				slot.val = val
+				atomic.StoreUint64(&slot.sequence, pos+1)
+				return nil
+			}
+		} else if diff < 0 {
+			return ErrQueueIsFull
+		}
+		pos = atomic.LoadUint64(&q.tail)
+	}
+}
+
+// Poll Poll the T val(non-blocking)
+func (q *RingQueue[T]) Poll() (T, error) {
+	pos := atomic.LoadUint64(&q.head)
+	for {
+		slot := &q.slots[pos&q.mask]
+		diff := int64(atomic.LoadUint64(&slot.sequence)) - int64(pos+1)
+		if diff == 0 {
+			if atomic.CompareAndSwapUint64(&q.head, pos, pos+1) {
+				val := slot.val
+				slot.val = *new(T)
+				atomic.StoreUint64(&slot.sequence, pos+q.mask+1)
+				return val, nil
+			}
+		} else if diff < 0 {
+			if q.isClosed.Get() {
+				return *new(T), ErrQueueIsClosed
+			}
+			return *new(T), ErrQueueIsEmpty
+		}
+		pos = atomic.LoadUint64(&q.head)
+	}
+}
+
+// Put Put the T val(blocking by spinning)
+func (q *RingQueue[T]) Put(val T) error {
+	for spin := 0; ; spin++ {
+		err := q.Offer(val)
+		if err != ErrQueueIsFull {
+			return err
+		}
+		ringQueueBackoff(spin)
+	}
+}
+
+// PutWithTimeout Put the T val(blocking by spinning), with timeout
+func (q *RingQueue[T]) PutWithTimeout(val T, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for spin := 0; ; spin++ {
+		err := q.Offer(val)
+		if err != ErrQueueIsFull {
+			return err
+		}
+		if !time.Now().Before(deadline) {
+			return ErrQueuePutTimeout
+		}
+		ringQueueBackoff(spin)
+	}
+}
+
+// Take Take the T val(blocking by spinning)
+func (q *RingQueue[T]) Take() (T, error) {
+	for spin := 0; ; spin++ {
+		val, err := q.Poll()
+		if err != ErrQueueIsEmpty {
+			return val, err
+		}
+		ringQueueBackoff(spin)
+	}
+}
+
+// TakeWithTimeout Take the T val(blocking by spinning), with timeout
+func (q *RingQueue[T]) TakeWithTimeout(timeout time.Duration) (T, error) {
+	deadline := time.Now().Add(timeout)
+	for spin := 0; ; spin++ {
+		val, err := q.Poll()
+		if err != ErrQueueIsEmpty {
+			return val, err
+		}
+		if !time.Now().Before(deadline) {
+			return *new(T), ErrQueueTakeTimeout
+		}
+		ringQueueBackoff(spin)
+	}
+}
+
+// OfferWithContext Put the T val, blocking(by spinning) until there's space or the context is done(returning ctx.Err())
+func (q *RingQueue[T]) OfferWithContext(ctx context.Context, val T) error {
+	for spin := 0; ; spin++ {
+		err := q.Offer(val)
+		if err != ErrQueueIsFull {
+			return err
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		ringQueueBackoff(spin)
+	}
+}
+
+// TakeWithContext Take the T val, blocking(by spinning) until there's one or the context is done(returning ctx.Err())
+func (q *RingQueue[T]) TakeWithContext(ctx context.Context) (T, error) {
+	for spin := 0; ; spin++ {
+		val, err := q.Poll()
+		if err != ErrQueueIsEmpty {
+			return val, err
+		}
+		if ctx.Err() != nil {
+			return *new(T), ctx.Err()
+		}
+		ringQueueBackoff(spin)
+	}
+}
+
+// Count Count items(approximately while being used concurrently)
+func (q *RingQueue[T]) Count() int {
+	head := atomic.LoadUint64(&q.head)
+	tail := atomic.LoadUint64(&q.tail)
+	if tail < head {
+		return 0
+	}
+	return int(tail - head)
+}
+
+// Capacity Get the capacity
+func (q *RingQueue[T]) Capacity() int {
+	return len(q.slots)
+}
+
+// IsClosed Is the RingQueue closed
+func (q *RingQueue[T]) IsClosed() bool {
+	return q.isClosed.Get()
+}
+
+// Close Close the RingQueue, the remaining items could still be taken
+func (q *RingQueue[T]) Close() {
+	q.isClosed.Set(true)
+}
+
+// ringQueueBackoff Yield the processor for a while, then sleep shortly when it keeps spinning
+func ringQueueBackoff(spin int) {
+	if spin < 64 {
+		runtime.Gosched()
+		return
+	}
+	time.Sleep(10 * time.Microsecond)
+}
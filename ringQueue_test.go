@@ -0,0 +1,94 @@
+package fpgo
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRingQueue(t *testing.T) {
+	var queue Queue[int]
+	ringQueue := NewRingQueue[int](3)
+	queue = ringQueue
+	assert.Equal(t, 4, ringQueue.Capacity())
+
+	for i := 1; i <= 4; i++ {
+		assert.NoError(t, queue.Offer(i))
+	}
+	assert.Equal(t, ErrQueueIsFull, queue.Offer(5))
+	assert.Equal(t, ErrQueuePutTimeout, ringQueue.PutWithTimeout(5, time.Millisecond))
+	assert.Equal(t, 4, ringQueue.Count())
+	for i := 1; i <= 4; i++ {
+		val, err := queue.Poll()
+		assert.NoError(t, err)
+		assert.Equal(t, i, val)
+	}
+	_, err := queue.Poll()
+	assert.Equal(t, ErrQueueIsEmpty, err)
+	_, err = ringQueue.TakeWithTimeout(time.Millisecond)
+	assert.Equal(t, ErrQueueTakeTimeout, err)
+
+	// Multiple producers & consumers
+	var wg sync.WaitGroup
+	results := make(chan int, 4000)
+	for p := 0; p < 4; p++ {
+		p := p
+		go func() {
+			for i := 0; i < 1000; i++ {
+				queue.Put(p*1000 + i)
+			}
+		}()
+	}
+	for c := 0; c < 4; c++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				val, _ := queue.Take()
+				results <- val
+			}
+		}()
+	}
+	wg.Wait()
+	close(results)
+	seen := map[int]bool{}
+	for val := range results {
+		seen[val] = true
+	}
+	assert.Equal(t, 4000, len(seen))
+
+	ringQueue.Offer(1)
+	ringQueue.Close()
+	assert.Equal(t, true, ringQueue.IsClosed())
+	assert.Equal(t, ErrQueueIsClosed, queue.Offer(2))
+	val, _ := queue.Take()
+	assert.Equal(t, 1, val)
+	_, err = queue.Take()
+	assert.Equal(t, ErrQueueIsClosed, err)
+}
+
+func benchmarkQueue(b *testing.B, queue Queue[int]) {
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			for queue.Offer(1) != nil {
+			}
+			for {
+				if _, err := queue.Poll(); err == nil {
+					break
+				}
+			}
+		}
+	})
+}
+
+func BenchmarkRingQueue(b *testing.B) {
+	benchmarkQueue(b, NewRingQueue[int](1024))
+}
+
+func BenchmarkBufferedChannelQueue(b *testing.B) {
+	bufferedChannelQueue := NewBufferedChannelQueue[int](1024, 0, 0)
+	defer bufferedChannelQueue.Close()
+	benchmarkQueue(b, bufferedChannelQueue)
+}
@@ -0,0 +1,158 @@
+package fpgo
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Scheduler
+
+// Scheduler Schedule functions to run(HandlerDef & worker.WorkerPool implement it)
+type Scheduler interface {
+	Schedule(fn func()) error
+}
+
+// immediateScheduler Scheduler running fn on the calling goroutine
+type immediateScheduler struct{}
+
+// Schedule Run fn right now
+func (immediateScheduler) Schedule(fn func()) error {
+	fn()
+	return nil
+}
+
+// goroutineScheduler Scheduler running fn on a new goroutine
+type goroutineScheduler struct{}
+
+// Schedule Run fn on a new goroutine
+func (goroutineScheduler) Schedule(fn func()) error {
+	go fn()
+	return nil
+}
+
+// ImmediateScheduler Scheduler running functions on the calling goroutine
+var ImmediateScheduler Scheduler = immediateScheduler{}
+
+// GoroutineScheduler Scheduler running each function on a new goroutine
+var GoroutineScheduler Scheduler = goroutineScheduler{}
+
+// TimeScheduler
+
+// TimerHandle A scheduled timer which could be stopped(*time.Timer implements it)
+type TimerHandle interface {
+	// Stop Stop the timer, false if the timer has already fired or been stopped
+	Stop() bool
+}
+
+// TimeScheduler Schedule functions by time(inject a VirtualTimeScheduler for testing)
+type TimeScheduler interface {
+	Now() time.Time
+	AfterFunc(duration time.Duration, fn func()) TimerHandle
+}
+
+// realTimeScheduler TimeScheduler implemented by time.AfterFunc(fn runs on its own goroutine)
+type realTimeScheduler struct{}
+
+// Now Get the current time
+func (realTimeScheduler) Now() time.Time {
+	return time.Now()
+}
+
+// AfterFunc Call fn on its own goroutine after the duration
+func (realTimeScheduler) AfterFunc(duration time.Duration, fn func()) TimerHandle {
+	return time.AfterFunc(duration, fn)
+}
+
+// DefaultTimeScheduler The default TimeScheduler implemented by time.AfterFunc
+var DefaultTimeScheduler TimeScheduler = realTimeScheduler{}
+
+// VirtualTimeScheduler TimeScheduler with a virtual clock, timers fire only when Advance() is called(for testing)
+type VirtualTimeScheduler struct {
+	lock   sync.Mutex
+	now    time.Time
+	timers []*virtualTimer
+	serial int
+}
+
+type virtualTimer struct {
+	scheduler *VirtualTimeScheduler
+	deadline  time.Time
+	serial    int
+	fn        func()
+}
+
+// NewVirtualTimeScheduler New a VirtualTimeScheduler starting from the given time
+func NewVirtualTimeScheduler(now time.Time) *VirtualTimeScheduler {
+	return &VirtualTimeScheduler{now: now}
+}
+
+// Now Get the current virtual time
+func (schedulerSelf *VirtualTimeScheduler) Now() time.Time {
+	schedulerSelf.lock.Lock()
+	defer schedulerSelf.lock.Unlock()
+
+	return schedulerSelf.now
+}
+
+// AfterFunc Call fn(on the goroutine calling Advance()) after the virtual duration
+func (schedulerSelf *VirtualTimeScheduler) AfterFunc(duration time.Duration, fn func()) TimerHandle {
+	schedulerSelf.lock.Lock()
+	defer schedulerSelf.lock.Unlock()
+
+	schedulerSelf.serial++
+	timer := &virtualTimer{
+		scheduler: schedulerSelf,
+		deadline:  schedulerSelf.now.Add(duration),
+		serial:    schedulerSelf.serial,
+		fn:        fn,
+	}
+	schedulerSelf.timers = append(schedulerSelf.timers, timer)
+	return timer
+}
+
+// Advance Move the virtual clock forward and fire the due timers in order
+func (schedulerSelf *VirtualTimeScheduler) Advance(duration time.Duration) {
+	schedulerSelf.lock.Lock()
+	target := schedulerSelf.now.Add(duration)
+	for {
+		sort.SliceStable(schedulerSelf.timers, func(i, j int) bool {
+			a, b := schedulerSelf.timers[i], schedulerSelf.timers[j]
+			if a.deadline.Equal(b.deadline) {
+				return a.serial < b.serial
+			}
+			return a.deadline.Before(b.deadline)
+		})
+		if len(schedulerSelf.timers) == 0 || schedulerSelf.timers[0].deadline.After(target) {
+			break
+		}
+
+		timer := schedulerSelf.timers[0]
+		schedulerSelf.timers = schedulerSelf.timers[1:]
+		if timer.deadline.After(schedulerSelf.now) {
+			schedulerSelf.now = timer.deadline
+		}
+
+		// fn may schedule new timers
+		schedulerSelf.lock.Unlock()
+		timer.fn()
+		schedulerSelf.lock.Lock()
+	}
+	schedulerSelf.now = target
+	schedulerSelf.lock.Unlock()
+}
+
+// Stop Stop the virtual timer
+func (timerSelf *virtualTimer) Stop() bool {
+	schedulerSelf := timerSelf.scheduler
+	schedulerSelf.lock.Lock()
+	defer schedulerSelf.lock.Unlock()
+
+	for i, timer := range schedulerSelf.timers {
+		if timer == timerSelf {
+			schedulerSelf.timers = Concat(schedulerSelf.timers[:i:i], schedulerSelf.timers[i+1:])
+			return true
+		}
+	}
+	return false
+}
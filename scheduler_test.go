@@ -0,0 +1,97 @@
+package fpgo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVirtualTimeScheduler(t *testing.T) {
+	start := time.Now()
+	timeScheduler := NewVirtualTimeScheduler(start)
+	assert.Equal(t, start, timeScheduler.Now())
+
+	actual := make([]int, 0)
+	timeScheduler.AfterFunc(20*time.Millisecond, func() {
+		actual = append(actual, 2)
+	})
+	timeScheduler.AfterFunc(10*time.Millisecond, func() {
+		actual = append(actual, 1)
+		// Scheduled by a fired timer
+		timeScheduler.AfterFunc(5*time.Millisecond, func() {
+			actual = append(actual, 15)
+		})
+	})
+	stopped := timeScheduler.AfterFunc(10*time.Millisecond, func() {
+		actual = append(actual, -1)
+	})
+	assert.Equal(t, true, stopped.Stop())
+	assert.Equal(t, false, stopped.Stop())
+
+	timeScheduler.Advance(9 * time.Millisecond)
+	assert.Equal(t, []int{}, actual)
+	timeScheduler.Advance(11 * time.Millisecond)
+	assert.Equal(t, []int{1, 15, 2}, actual)
+	assert.Equal(t, start.Add(20*time.Millisecond), timeScheduler.Now())
+}
+
+func TestScheduler(t *testing.T) {
+	actual := 0
+	assert.NoError(t, ImmediateScheduler.Schedule(func() {
+		actual = 1
+	}))
+	assert.Equal(t, 1, actual)
+
+	done := make(chan int)
+	assert.NoError(t, GoroutineScheduler.Schedule(func() {
+		done <- 2
+	}))
+	assert.Equal(t, 2, <-done)
+
+	h := Handler.New()
+	assert.NoError(t, h.Schedule(func() {
+		done <- 3
+	}))
+	assert.Equal(t, 3, <-done)
+	h.Close()
+	assert.Equal(t, ErrHandlerIsClosed, h.Schedule(func() {}))
+}
+
+func TestPublisherSubscribeOnObserveOn(t *testing.T) {
+	var nilHandler *HandlerDef
+	p := PublisherNewGenerics[int]().SubscribeOn(nilHandler)
+	assert.Equal(t, nil, p.subOn)
+	actual := collectPublisher(p.ObserveOn(ImmediateScheduler))
+	p.Publish(1)
+	assert.Equal(t, []int{1}, *actual)
+
+	h := Handler.New()
+	defer h.Close()
+	p = PublisherNewGenerics[int]()
+	done := make(chan bool)
+	var observed []int
+	p.ObserveOn(h).Subscribe(Subscription[int]{
+		OnNext: func(in int) {
+			observed = append(observed, in)
+		},
+		OnComplete: func() {
+			close(done)
+		},
+	})
+	p.Publish(1)
+	p.Publish(2)
+	p.Complete()
+	<-done
+	assert.Equal(t, []int{1, 2}, observed)
+
+	received := make(chan int, 1)
+	p = PublisherNewGenerics[int]().SubscribeOn(GoroutineScheduler)
+	p.Subscribe(Subscription[int]{
+		OnNext: func(in int) {
+			received <- in
+		},
+	})
+	p.Publish(3)
+	assert.Equal(t, 3, <-received)
+}
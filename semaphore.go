@@ -0,0 +1,135 @@
+package fpgo
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+)
+
+// Semaphore
+
+// ErrBulkheadIsFull The call is rejected because both the concurrent & the waiting calls of the Bulkhead are full
+var ErrBulkheadIsFull = errors.New("bulkhead is full")
+
+// Semaphore Counting semaphore limiting the concurrent holders
+type Semaphore struct {
+	slots chan struct{}
+}
+
+// NewSemaphore New Semaphore allowing n concurrent holders(1 if <= 0)
+func NewSemaphore(n int) *Semaphore {
+	if n <= 0 {
+		n = 1
+	}
+	return &Semaphore{slots: make(chan struct{}, n)}
+}
+
+// Acquire Acquire a slot(blocking until a slot is released or the ctx is done)
+func (semaphoreSelf *Semaphore) Acquire(ctx context.Context) error {
+	// Prefer the ctx error if it's done already
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	select {
+	case semaphoreSelf.slots <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// TryAcquire Acquire a slot without blocking, false if there's no free slot
+func (semaphoreSelf *Semaphore) TryAcquire() bool {
+	select {
+	case semaphoreSelf.slots <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+// Release Release an acquired slot(panic if there's no acquired one)
+func (semaphoreSelf *Semaphore) Release() {
+	select {
+	case <-semaphoreSelf.slots:
+	default:
+		panic("fpgo: Semaphore released more than acquired")
+	}
+}
+
+// With Call fn while holding a slot(blocking until a slot is acquired)
+func (semaphoreSelf *Semaphore) With(fn func()) {
+	semaphoreSelf.Acquire(context.Background())
+	defer semaphoreSelf.Release()
+
+	fn()
+}
+
+// WithContext Call fn while holding a slot, the ctx error is returned if it's done before a slot is acquired
+func (semaphoreSelf *Semaphore) WithContext(ctx context.Context, fn func() error) error {
+	if err := semaphoreSelf.Acquire(ctx); err != nil {
+		return err
+	}
+	defer semaphoreSelf.Release()
+
+	return fn()
+}
+
+// Capacity Get the maximum number of concurrent holders
+func (semaphoreSelf *Semaphore) Capacity() int {
+	return cap(semaphoreSelf.slots)
+}
+
+// Acquired Get the number of acquired slots
+func (semaphoreSelf *Semaphore) Acquired() int {
+	return len(semaphoreSelf.slots)
+}
+
+// Bulkhead
+
+// Bulkhead Isolate calls by limiting the concurrent calls & the waiting calls(rejected with ErrBulkheadIsFull beyond them)
+type Bulkhead struct {
+	semaphore  *Semaphore
+	maxWaiting int64
+	waiting    int64
+}
+
+// NewBulkhead New Bulkhead allowing maxConcurrent calls(1 if <= 0) & maxWaiting calls waiting for them(no waiting if <= 0)
+func NewBulkhead(maxConcurrent int, maxWaiting int) *Bulkhead {
+	if maxWaiting < 0 {
+		maxWaiting = 0
+	}
+	return &Bulkhead{
+		semaphore:  NewSemaphore(maxConcurrent),
+		maxWaiting: int64(maxWaiting),
+	}
+}
+
+// Execute Call fn within the Bulkhead, ErrBulkheadIsFull if it's full or the ctx error if it's done while waiting
+func (bulkheadSelf *Bulkhead) Execute(ctx context.Context, fn func() error) error {
+	if !bulkheadSelf.semaphore.TryAcquire() {
+		if atomic.AddInt64(&bulkheadSelf.waiting, 1) > bulkheadSelf.maxWaiting {
+			atomic.AddInt64(&bulkheadSelf.waiting, -1)
+			return ErrBulkheadIsFull
+		}
+		err := bulkheadSelf.semaphore.Acquire(ctx)
+		atomic.AddInt64(&bulkheadSelf.waiting, -1)
+		if err != nil {
+			return err
+		}
+	}
+	defer bulkheadSelf.semaphore.Release()
+
+	return fn()
+}
+
+// Running Get the number of running calls
+func (bulkheadSelf *Bulkhead) Running() int {
+	return bulkheadSelf.semaphore.Acquired()
+}
+
+// Waiting Get the number of waiting calls
+func (bulkheadSelf *Bulkhead) Waiting() int {
+	return int(atomic.LoadInt64(&bulkheadSelf.waiting))
+}
@@ -0,0 +1,110 @@
+package fpgo
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSemaphore(t *testing.T) {
+	semaphore := NewSemaphore(2)
+	assert.Equal(t, 2, semaphore.Capacity())
+	assert.NoError(t, semaphore.Acquire(context.Background()))
+	assert.Equal(t, true, semaphore.TryAcquire())
+	assert.Equal(t, false, semaphore.TryAcquire())
+	assert.Equal(t, 2, semaphore.Acquired())
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, semaphore.Acquire(ctx))
+
+	semaphore.Release()
+	semaphore.Release()
+	assert.Equal(t, 0, semaphore.Acquired())
+	assert.Panics(t, semaphore.Release)
+
+	// Canceled already
+	canceled, cancelNow := context.WithCancel(context.Background())
+	cancelNow()
+	assert.Equal(t, context.Canceled, semaphore.Acquire(canceled))
+	assert.Equal(t, context.Canceled, semaphore.WithContext(canceled, func() error {
+		return nil
+	}))
+
+	errFailed := errors.New("failed")
+	assert.Equal(t, errFailed, semaphore.WithContext(context.Background(), func() error {
+		assert.Equal(t, 1, semaphore.Acquired())
+		return errFailed
+	}))
+
+	// Concurrency
+	var lock sync.Mutex
+	current, peak := 0, 0
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			semaphore.With(func() {
+				lock.Lock()
+				current++
+				peak = Max(peak, current)
+				lock.Unlock()
+				time.Sleep(time.Millisecond)
+				lock.Lock()
+				current--
+				lock.Unlock()
+			})
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 2, peak)
+	assert.Equal(t, 0, semaphore.Acquired())
+	assert.Equal(t, 1, NewSemaphore(0).Capacity())
+}
+
+func TestBulkhead(t *testing.T) {
+	bulkhead := NewBulkhead(1, 1)
+	started := make(chan bool)
+	release := make(chan bool)
+	go bulkhead.Execute(context.Background(), func() error {
+		close(started)
+		<-release
+		return nil
+	})
+	<-started
+	assert.Equal(t, 1, bulkhead.Running())
+
+	waited := make(chan error)
+	go func() {
+		waited <- bulkhead.Execute(context.Background(), func() error {
+			return nil
+		})
+	}()
+	assert.Eventually(t, func() bool {
+		return bulkhead.Waiting() == 1
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, ErrBulkheadIsFull, bulkhead.Execute(context.Background(), func() error {
+		return nil
+	}))
+
+	close(release)
+	assert.NoError(t, <-waited)
+	assert.Equal(t, 0, bulkhead.Waiting())
+	assert.Eventually(t, func() bool {
+		return bulkhead.Running() == 0
+	}, time.Second, time.Millisecond)
+
+	// No waiting
+	noWaiting := NewBulkhead(1, 0)
+	assert.NoError(t, noWaiting.Execute(context.Background(), func() error {
+		assert.Equal(t, ErrBulkheadIsFull, noWaiting.Execute(context.Background(), func() error {
+			return nil
+		}))
+		return nil
+	}))
+}
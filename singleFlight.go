@@ -0,0 +1,91 @@
+package fpgo
+
+import (
+	"sync"
+)
+
+// SingleFlight
+
+// SingleFlight Collapse the concurrent calls of the same key into one execution sharing the result(the zero value is ready to use)
+type SingleFlight[K comparable, V any] struct {
+	lock  sync.Mutex
+	calls map[K]*singleFlightCall[V]
+}
+
+// singleFlightCall A running call shared by the concurrent callers
+type singleFlightCall[V any] struct {
+	done    chan struct{}
+	val     V
+	err     error
+	panic   interface{}
+	callers int
+}
+
+// NewSingleFlight New SingleFlight
+func NewSingleFlight[K comparable, V any]() *SingleFlight[K, V] {
+	return &SingleFlight[K, V]{}
+}
+
+// Dedupe Call fn for the key, or wait for the running call of the same key and share its result
+//
+// NOTE: if fn panics, the panic is re-raised in all the callers sharing the call
+func (singleFlightSelf *SingleFlight[K, V]) Dedupe(key K, fn func() (V, error)) (V, error) {
+	val, err, _ := singleFlightSelf.Do(key, fn)
+	return val, err
+}
+
+// Do The same as Dedupe() but it also tells whether the result is shared with other callers
+func (singleFlightSelf *SingleFlight[K, V]) Do(key K, fn func() (V, error)) (val V, err error, shared bool) {
+	singleFlightSelf.lock.Lock()
+	if singleFlightSelf.calls == nil {
+		singleFlightSelf.calls = map[K]*singleFlightCall[V]{}
+	}
+	if call, ok := singleFlightSelf.calls[key]; ok {
+		call.callers++
+		singleFlightSelf.lock.Unlock()
+
+		<-call.done
+		if call.panic != nil {
+			panic(call.panic)
+		}
+		return call.val, call.err, true
+	}
+	call := &singleFlightCall[V]{done: make(chan struct{}), callers: 1}
+	singleFlightSelf.calls[key] = call
+	singleFlightSelf.lock.Unlock()
+
+	singleFlightSelf.run(key, call, fn)
+	if call.panic != nil {
+		panic(call.panic)
+	}
+
+	singleFlightSelf.lock.Lock()
+	shared = call.callers > 1
+	singleFlightSelf.lock.Unlock()
+	return call.val, call.err, shared
+}
+
+// Forget Forget the running call of the key, the later calls won't wait for it
+func (singleFlightSelf *SingleFlight[K, V]) Forget(key K) {
+	singleFlightSelf.lock.Lock()
+	defer singleFlightSelf.lock.Unlock()
+
+	delete(singleFlightSelf.calls, key)
+}
+
+func (singleFlightSelf *SingleFlight[K, V]) run(key K, call *singleFlightCall[V], fn func() (V, error)) {
+	defer func() {
+		if r := recover(); r != nil {
+			call.panic = r
+		}
+
+		singleFlightSelf.lock.Lock()
+		if singleFlightSelf.calls[key] == call {
+			delete(singleFlightSelf.calls, key)
+		}
+		singleFlightSelf.lock.Unlock()
+		close(call.done)
+	}()
+
+	call.val, call.err = fn()
+}
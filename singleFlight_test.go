@@ -0,0 +1,111 @@
+package fpgo
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSingleFlight(t *testing.T) {
+	var singleFlight SingleFlight[string, int]
+	var calls int32
+	release := make(chan bool)
+	fn := func() (int, error) {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		return 42, nil
+	}
+
+	var wg sync.WaitGroup
+	results := make([]int, 10)
+	sharedCount := int32(0)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			val, err, shared := singleFlight.Do("a", fn)
+			assert.NoError(t, err)
+			results[i] = val
+			if shared {
+				atomic.AddInt32(&sharedCount, 1)
+			}
+		}(i)
+	}
+	assert.Eventually(t, func() bool {
+		singleFlight.lock.Lock()
+		defer singleFlight.lock.Unlock()
+		return singleFlight.calls["a"] != nil && singleFlight.calls["a"].callers == 10
+	}, time.Second, time.Millisecond)
+	close(release)
+	wg.Wait()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	assert.Equal(t, []int{42, 42, 42, 42, 42, 42, 42, 42, 42, 42}, results)
+	assert.Equal(t, int32(10), sharedCount)
+
+	// Not running anymore
+	errFailed := errors.New("failed")
+	val, err := singleFlight.Dedupe("a", func() (int, error) {
+		return 0, errFailed
+	})
+	assert.Equal(t, 0, val)
+	assert.Equal(t, errFailed, err)
+
+	// Forget
+	started := make(chan bool)
+	release = make(chan bool)
+	go singleFlight.Dedupe("b", func() (int, error) {
+		close(started)
+		<-release
+		return 1, nil
+	})
+	<-started
+	singleFlight.Forget("b")
+	val, _ = NewSingleFlight[string, int]().Dedupe("b", func() (int, error) {
+		return 2, nil
+	})
+	assert.Equal(t, 2, val)
+	val, _ = singleFlight.Dedupe("b", func() (int, error) {
+		return 3, nil
+	})
+	assert.Equal(t, 3, val)
+	close(release)
+
+	// Panics are shared
+	assert.PanicsWithValue(t, "boom", func() {
+		singleFlight.Dedupe("c", func() (int, error) {
+			panic("boom")
+		})
+	})
+	val, _ = singleFlight.Dedupe("c", func() (int, error) {
+		return 4, nil
+	})
+	assert.Equal(t, 4, val)
+}
+
+func TestMemoizeDedupe(t *testing.T) {
+	var calls int32
+	release := make(chan bool)
+	memoized := Memoize(func(v int) int {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		return v * v
+	}, MemoizeOption{Dedupe: true})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.Equal(t, 9, memoized(3))
+		}()
+	}
+	time.Sleep(5 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	assert.Equal(t, 9, memoized(3))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
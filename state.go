@@ -0,0 +1,102 @@
+package fpgo
+
+// State
+
+// State State monad inspired by Haskell, a computation of A threading the state S purely
+type State[S any, A any] struct {
+	run func(S) (A, S)
+}
+
+// NewState New State by the transition function returning the result & the next state
+func NewState[S any, A any](run func(S) (A, S)) *State[S, A] {
+	return &State[S, A]{run: run}
+}
+
+// StateOf New State returning the value with the state unchanged
+func StateOf[S any, A any](val A) *State[S, A] {
+	return NewState(func(state S) (A, S) {
+		return val, state
+	})
+}
+
+// StateGet New State returning the current state
+func StateGet[S any]() *State[S, S] {
+	return NewState(func(state S) (S, S) {
+		return state, state
+	})
+}
+
+// StateGets New State returning a part of the current state selected by fn
+func StateGets[S any, A any](fn func(S) A) *State[S, A] {
+	return NewState(func(state S) (A, S) {
+		return fn(state), state
+	})
+}
+
+// StatePut New State replacing the state
+func StatePut[S any](state S) *State[S, struct{}] {
+	return NewState(func(S) (struct{}, S) {
+		return struct{}{}, state
+	})
+}
+
+// StateModify New State transforming the state by fn
+func StateModify[S any](fn func(S) S) *State[S, struct{}] {
+	return NewState(func(state S) (struct{}, S) {
+		return struct{}{}, fn(state)
+	})
+}
+
+// Run Run the State from the initial state, returning the result & the final state
+func (stateSelf *State[S, A]) Run(initial S) (A, S) {
+	return stateSelf.run(initial)
+}
+
+// Eval Run the State from the initial state, returning the result only
+func (stateSelf *State[S, A]) Eval(initial S) A {
+	result, _ := stateSelf.run(initial)
+	return result
+}
+
+// Exec Run the State from the initial state, returning the final state only
+func (stateSelf *State[S, A]) Exec(initial S) S {
+	_, state := stateSelf.run(initial)
+	return state
+}
+
+// Map Map the result of the State by function
+func (stateSelf *State[S, A]) Map(fn func(A) A) *State[S, A] {
+	return StateMap(stateSelf, fn)
+}
+
+// FlatMap FlatMap the result of the State by function(the next State runs from the updated state)
+func (stateSelf *State[S, A]) FlatMap(fn func(A) *State[S, A]) *State[S, A] {
+	return StateFlatMap(stateSelf, fn)
+}
+
+// StateMap Map the result of the State into another type by function
+func StateMap[S any, A any, B any](state *State[S, A], fn func(A) B) *State[S, B] {
+	return NewState(func(current S) (B, S) {
+		result, next := state.run(current)
+		return fn(result), next
+	})
+}
+
+// StateFlatMap FlatMap the result of the State into a State of another type(it runs from the updated state)
+func StateFlatMap[S any, A any, B any](state *State[S, A], fn func(A) *State[S, B]) *State[S, B] {
+	return NewState(func(current S) (B, S) {
+		result, next := state.run(current)
+		return fn(result).run(next)
+	})
+}
+
+// StateSequence Run the States in order threading the state, collecting the results
+func StateSequence[S any, A any](states ...*State[S, A]) *State[S, []A] {
+	return NewState(func(current S) ([]A, S) {
+		results := make([]A, len(states))
+		for i, state := range states {
+			results[i], current = state.run(current)
+		}
+		return results, current
+	})
+}
@@ -0,0 +1,58 @@
+package fpgo
+
+import (
+	"testing"
+	"unicode"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestState(t *testing.T) {
+	// Counter
+	next := StateFlatMap(StateGet[int](), func(count int) *State[int, int] {
+		return StateMap(StatePut(count+1), func(struct{}) int {
+			return count
+		})
+	})
+	results, final := StateSequence(next, next, next).Run(10)
+	assert.Equal(t, []int{10, 11, 12}, results)
+	assert.Equal(t, 13, final)
+
+	assert.Equal(t, 20, next.Map(func(v int) int {
+		return v * 2
+	}).Eval(10))
+	assert.Equal(t, 11, next.Exec(10))
+	assert.Equal(t, 22, StateModify(func(v int) int {
+		return v * 2
+	}).Exec(11))
+	assert.Equal(t, "a", StateOf[int]("a").Eval(0))
+	assert.Equal(t, 12, next.FlatMap(func(int) *State[int, int] {
+		return next
+	}).Exec(10))
+}
+
+func TestStateParser(t *testing.T) {
+	// Parse the leading digits as a number, the state is the rest of the input
+	digit := NewState(func(input string) (int, string) {
+		if input == "" || !unicode.IsDigit(rune(input[0])) {
+			return -1, input
+		}
+		return int(input[0] - '0'), input[1:]
+	})
+	var number func(acc int) *State[string, int]
+	number = func(acc int) *State[string, int] {
+		return StateFlatMap(digit, func(d int) *State[string, int] {
+			if d < 0 {
+				return StateOf[string](acc)
+			}
+			return number(acc*10 + d)
+		})
+	}
+
+	result, rest := number(0).Run("123abc")
+	assert.Equal(t, 123, result)
+	assert.Equal(t, "abc", rest)
+	assert.Equal(t, 3, StateGets(func(input string) int {
+		return len(input)
+	}).Eval(rest))
+}
@@ -0,0 +1,191 @@
+package fpgo
+
+import (
+	"errors"
+	"sync"
+)
+
+// STM
+
+// ErrTxRetryWithoutRead Tx.Retry() is called before reading any TVar, so it would be blocked forever
+var ErrTxRetryWithoutRead = errors.New("tx retry without reading any TVar")
+
+var (
+	// stmLock Readers of TVars share it, commits take it exclusively
+	stmLock sync.RWMutex
+	// stmCommitted Broadcast after each commit(for Tx.Retry())
+	stmCommitted = sync.NewCond(&stmLock)
+)
+
+// txSignal Signals aborting the transaction function
+type txSignal int
+
+const (
+	txSignalConflict txSignal = iota
+	txSignalRetry
+)
+
+// tvarBase The type-erased TVar for Tx(accessed with stmLock held)
+type tvarBase interface {
+	getVersion() uint64
+	setValue(val interface{})
+}
+
+// TVar Transactional variable inspired by Haskell STM, read & written within Atomically()
+type TVar[T any] struct {
+	version uint64
+	val     T
+}
+
+// NewTVar New TVar with the initial value
+func NewTVar[T any](val T) *TVar[T] {
+	return &TVar[T]{val: val}
+}
+
+// Load Get the committed value outside of transactions
+func (tvarSelf *TVar[T]) Load() T {
+	stmLock.RLock()
+	defer stmLock.RUnlock()
+
+	return tvarSelf.val
+}
+
+// Get Get the value within the transaction(the transaction restarts if the values it read are inconsistent)
+func (tvarSelf *TVar[T]) Get(tx *Tx) T {
+	if val, ok := tx.writes[tvarSelf]; ok {
+		return val.(T)
+	}
+
+	stmLock.RLock()
+	val, version := tvarSelf.val, tvarSelf.version
+	isValid := tx.validate()
+	stmLock.RUnlock()
+
+	if read, ok := tx.reads[tvarSelf]; !isValid || (ok && read != version) {
+		panic(txSignalConflict)
+	}
+	tx.reads[tvarSelf] = version
+	return val
+}
+
+// Set Set the value within the transaction(visible to others after it's committed)
+func (tvarSelf *TVar[T]) Set(tx *Tx, val T) {
+	tx.writes[tvarSelf] = val
+}
+
+// Modify Set the value by fn(the current value) within the transaction
+func (tvarSelf *TVar[T]) Modify(tx *Tx, fn func(T) T) {
+	tvarSelf.Set(tx, fn(tvarSelf.Get(tx)))
+}
+
+func (tvarSelf *TVar[T]) getVersion() uint64 {
+	return tvarSelf.version
+}
+
+func (tvarSelf *TVar[T]) setValue(val interface{}) {
+	tvarSelf.val = val.(T)
+	tvarSelf.version++
+}
+
+// Tx A transaction of Atomically()
+type Tx struct {
+	reads  map[tvarBase]uint64
+	writes map[tvarBase]interface{}
+}
+
+// Retry Abort the transaction and run it again after any TVar it read is changed(e.g. waiting for a condition)
+func (txSelf *Tx) Retry() {
+	panic(txSignalRetry)
+}
+
+// validate Check the TVars read are unchanged(stmLock held)
+func (txSelf *Tx) validate() bool {
+	for tvar, version := range txSelf.reads {
+		if tvar.getVersion() != version {
+			return false
+		}
+	}
+	return true
+}
+
+// Atomically Run fn as a transaction with optimistic concurrency: it restarts on conflicts,
+// and the writes are committed all at once only if fn returns nil(discarded otherwise)
+//
+// NOTE: fn may run more than once, it shouldn't have side effects other than TVars
+func Atomically(fn func(tx *Tx) error) error {
+	for {
+		tx := &Tx{
+			reads:  map[tvarBase]uint64{},
+			writes: map[tvarBase]interface{}{},
+		}
+		signal, err := tx.run(fn)
+		switch {
+		case signal == txSignalConflict:
+			continue
+		case signal == txSignalRetry:
+			if len(tx.reads) == 0 {
+				return ErrTxRetryWithoutRead
+			}
+			tx.waitForChange()
+			continue
+		case err != nil:
+			return err
+		}
+
+		if tx.commit() {
+			return nil
+		}
+	}
+}
+
+// AtomicallyGet Run fn as a transaction by Atomically() and return its result
+func AtomicallyGet[T any](fn func(tx *Tx) (T, error)) (T, error) {
+	var result T
+	err := Atomically(func(tx *Tx) error {
+		var err error
+		result, err = fn(tx)
+		return err
+	})
+	return result, err
+}
+
+func (txSelf *Tx) run(fn func(tx *Tx) error) (signal txSignal, err error) {
+	signal = -1
+	defer func() {
+		if r := recover(); r != nil {
+			abort, ok := r.(txSignal)
+			if !ok {
+				panic(r)
+			}
+			signal = abort
+		}
+	}()
+
+	err = fn(txSelf)
+	return signal, err
+}
+
+func (txSelf *Tx) commit() bool {
+	stmLock.Lock()
+	defer stmLock.Unlock()
+
+	if !txSelf.validate() {
+		return false
+	}
+	for tvar, val := range txSelf.writes {
+		tvar.setValue(val)
+	}
+	if len(txSelf.writes) > 0 {
+		stmCommitted.Broadcast()
+	}
+	return true
+}
+
+func (txSelf *Tx) waitForChange() {
+	stmLock.Lock()
+	defer stmLock.Unlock()
+
+	for txSelf.validate() {
+		stmCommitted.Wait()
+	}
+}
@@ -0,0 +1,97 @@
+package fpgo
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSTM(t *testing.T) {
+	errInsufficient := errors.New("insufficient")
+	accountA := NewTVar(100)
+	accountB := NewTVar(0)
+	transfer := func(from *TVar[int], to *TVar[int], amount int) error {
+		return Atomically(func(tx *Tx) error {
+			balance := from.Get(tx)
+			if balance < amount {
+				return errInsufficient
+			}
+			from.Set(tx, balance-amount)
+			to.Modify(tx, func(val int) int {
+				return val + amount
+			})
+			return nil
+		})
+	}
+
+	// Writes are discarded on errors
+	assert.Equal(t, errInsufficient, Atomically(func(tx *Tx) error {
+		accountB.Set(tx, 1000)
+		assert.Equal(t, 1000, accountB.Get(tx))
+		return transfer(accountB, accountA, 1)
+	}))
+	assert.Equal(t, 0, accountB.Load())
+
+	// Concurrent transfers keep the total
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			transfer(accountA, accountB, 3)
+		}()
+		go func() {
+			defer wg.Done()
+			transfer(accountB, accountA, 2)
+		}()
+	}
+	wg.Wait()
+	total, err := AtomicallyGet(func(tx *Tx) (int, error) {
+		return accountA.Get(tx) + accountB.Get(tx), nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 100, total)
+
+	// Panics are propagated
+	assert.PanicsWithValue(t, "boom", func() {
+		Atomically(func(tx *Tx) error {
+			panic("boom")
+		})
+	})
+}
+
+func TestSTMRetry(t *testing.T) {
+	queue := NewTVar([]int{})
+	taken := make(chan int)
+	go func() {
+		val, _ := AtomicallyGet(func(tx *Tx) (int, error) {
+			list := queue.Get(tx)
+			if len(list) == 0 {
+				tx.Retry()
+			}
+			queue.Set(tx, list[1:])
+			return list[0], nil
+		})
+		taken <- val
+	}()
+
+	select {
+	case <-taken:
+		assert.Fail(t, "it should be waiting")
+	case <-time.After(5 * time.Millisecond):
+	}
+	assert.NoError(t, Atomically(func(tx *Tx) error {
+		queue.Set(tx, append(queue.Get(tx), 1, 2))
+		return nil
+	}))
+	assert.Equal(t, 1, <-taken)
+	assert.Equal(t, []int{2}, queue.Load())
+
+	assert.Equal(t, ErrTxRetryWithoutRead, Atomically(func(tx *Tx) error {
+		tx.Retry()
+		return nil
+	}))
+}
@@ -1,6 +1,10 @@
 package fpgo
 
 import (
+	"bufio"
+	"container/heap"
+	"context"
+	"io"
 	"sort"
 )
 
@@ -20,11 +24,78 @@ func StreamFromArray[T comparable](list []T) *StreamDef[T] {
 	return &result
 }
 
+// StreamFromChannel New Stream instance from items received from the channel(blocking until the channel is closed)
+func StreamFromChannel[T comparable](ch <-chan T) *StreamDef[T] {
+	result := make([]T, 0)
+	for item := range ch {
+		result = append(result, item)
+	}
+
+	return StreamFromArray(result)
+}
+
+// StreamFromSeq New Stream instance from a sequence function(compatible with iter.Seq[T] of go1.23)
+func StreamFromSeq[T comparable](seq func(yield func(T) bool)) *StreamDef[T] {
+	result := make([]T, 0)
+	seq(func(item T) bool {
+		result = append(result, item)
+		return true
+	})
+
+	return StreamFromArray(result)
+}
+
+// StreamFromLines New Stream instance from lines of the reader(without line endings)
+func StreamFromLines(reader io.Reader) (*StreamDef[string], error) {
+	result := make([]string, 0)
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		result = append(result, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return StreamFromArray(result), nil
+}
+
 // ToArray Convert Stream to slice
 func (streamSelf *StreamDef[T]) ToArray() []T {
 	return DuplicateSlice(*streamSelf)
 }
 
+// ToChannel Send all items to a new channel(closed after all sent or the ctx is done) with the buffer size
+//
+// NOTE: the sending goroutine blocks until all items are received, cancel the ctx if the reader stops early
+func (streamSelf *StreamDef[T]) ToChannel(ctx context.Context, bufferSize int) <-chan T {
+	list := streamSelf.ToArray()
+	ch := make(chan T, bufferSize)
+	go func() {
+		defer close(ch)
+		for _, item := range list {
+			select {
+			case ch <- item:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// ToSeq Convert Stream to a sequence function(compatible with iter.Seq[T] of go1.23)
+func (streamSelf *StreamDef[T]) ToSeq() func(yield func(T) bool) {
+	list := streamSelf.ToArray()
+	return func(yield func(T) bool) {
+		for _, item := range list {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
 // Map Map all items of Stream by function
 func (streamSelf *StreamDef[T]) Map(fn func(T, int) T) *StreamDef[T] {
 	result := StreamFromArray(MapIndexed(fn, (*streamSelf)...))
@@ -59,6 +130,117 @@ func (streamSelf *StreamDef[T]) Distinct() *StreamDef[T] {
 	return &result
 }
 
+// Partition Split items into two Streams - one where the predicate is satisfied and one where the predicate is not
+func (streamSelf *StreamDef[T]) Partition(fn Predicate[T]) (*StreamDef[T], *StreamDef[T]) {
+	result := Partition(fn, (*streamSelf)...)
+
+	return StreamFromArray(result[0]), StreamFromArray(result[1])
+}
+
+// StreamDistinctBy Filter items having duplicated keys(by keyFn) and return a new Stream instance (first one kept)
+func StreamDistinctBy[T comparable, K comparable](streamSelf *StreamDef[T], keyFn TransformerFunctor[T, K]) *StreamDef[T] {
+	return StreamFromArray(UniqBy(keyFn, (*streamSelf)...))
+}
+
+// StreamGroupBy Group items by keys(by keyFn), the order within each group is kept
+func StreamGroupBy[T comparable, K comparable](streamSelf *StreamDef[T], keyFn TransformerFunctor[T, K]) map[K][]T {
+	return GroupBy(keyFn, (*streamSelf)...)
+}
+
+// StreamFlatMap Map each item into a Stream by function and flatten them into a new Stream(the result type could be different)
+func StreamFlatMap[T comparable, R comparable](streamSelf *StreamDef[T], fn func(T) *StreamDef[R]) *StreamDef[R] {
+	result := make([]R, 0, streamSelf.Len())
+	for _, item := range *streamSelf {
+		mapped := fn(item)
+		if mapped == nil {
+			continue
+		}
+		result = append(result, (*mapped)...)
+	}
+
+	return StreamFromArray(result)
+}
+
+// StreamZip Pair items of two Streams by their indexes(the longer one is truncated)
+func StreamZip[A comparable, B comparable](streamA *StreamDef[A], streamB *StreamDef[B]) *StreamDef[Tuple2[A, B]] {
+	return StreamZipWith(streamA, streamB, NewTuple2[A, B])
+}
+
+// StreamZipWith Combine items of two Streams by their indexes with function(the longer one is truncated)
+func StreamZipWith[A comparable, B comparable, R comparable](streamA *StreamDef[A], streamB *StreamDef[B], fn func(A, B) R) *StreamDef[R] {
+	minLen := streamA.Len()
+	if streamB.Len() < minLen {
+		minLen = streamB.Len()
+	}
+
+	result := make([]R, minLen)
+	for i := 0; i < minLen; i++ {
+		result[i] = fn(streamA.Get(i), streamB.Get(i))
+	}
+
+	return StreamFromArray(result)
+}
+
+// StreamUnzip Split a Stream of Tuple2 into the Streams of their first & second values(the reverse of StreamZip)
+func StreamUnzip[A comparable, B comparable](streamSelf *StreamDef[Tuple2[A, B]]) (*StreamDef[A], *StreamDef[B]) {
+	listA, listB := UnzipSlices(*streamSelf)
+	return StreamFromArray(listA), StreamFromArray(listB)
+}
+
+// StreamCartesianProduct Pair every item of streamA with every item of streamB(in row-major order)
+func StreamCartesianProduct[A comparable, B comparable](streamA *StreamDef[A], streamB *StreamDef[B]) *StreamDef[Tuple2[A, B]] {
+	return StreamFromArray(CartesianProduct(*streamA, *streamB))
+}
+
+// SummaryStatistics Statistics(count/sum/min/max/average) of numeric items
+type SummaryStatistics[T Numeric] struct {
+	Count   int
+	Sum     T
+	Min     T
+	Max     T
+	Average float64
+}
+
+// StreamSum Sum up items of the Stream
+func StreamSum[T Numeric](streamSelf *StreamDef[T]) T {
+	return Collect(Summing[T](), (*streamSelf)...)
+}
+
+// StreamAverage Average items of the Stream(0 if the Stream is empty)
+func StreamAverage[T Numeric](streamSelf *StreamDef[T]) float64 {
+	return Collect(Averaging[T](), (*streamSelf)...)
+}
+
+// StreamMin Get the minimum item of the Stream(0 if the Stream is empty)
+func StreamMin[T Numeric](streamSelf *StreamDef[T]) T {
+	return Min((*streamSelf)...)
+}
+
+// StreamMax Get the maximum item of the Stream(0 if the Stream is empty)
+func StreamMax[T Numeric](streamSelf *StreamDef[T]) T {
+	return Max((*streamSelf)...)
+}
+
+// StreamSummaryStatistics Get SummaryStatistics of the Stream in one pass
+func StreamSummaryStatistics[T Numeric](streamSelf *StreamDef[T]) SummaryStatistics[T] {
+	var result SummaryStatistics[T]
+	for i, item := range *streamSelf {
+		if i == 0 || item < result.Min {
+			result.Min = item
+		}
+		if i == 0 || item > result.Max {
+			result.Max = item
+		}
+		result.Sum += item
+		result.Count++
+	}
+	if result.Count > 0 {
+		result.Average = float64(result.Sum) / float64(result.Count)
+	}
+
+	return result
+}
+
 // Contains Check the item exists or not in the Stream
 func (streamSelf *StreamDef[T]) Contains(input T) bool {
 	return Exists(input, *streamSelf...)
@@ -220,6 +402,141 @@ func (streamSelf *StreamDef[T]) Sort(fn Comparator[T]) *StreamDef[T] {
 	return result
 }
 
+// Chunk Split items into chunks of n items (the last chunk may be smaller)
+func (streamSelf *StreamDef[T]) Chunk(n int) [][]T {
+	if n <= 0 || len(*streamSelf) == 0 {
+		return [][]T{}
+	}
+	return SplitEvery(n, streamSelf.ToArray()...)
+}
+
+// Window Get sliding windows of size items moving by step items (only full windows are returned)
+func (streamSelf *StreamDef[T]) Window(size int, step int) [][]T {
+	result := make([][]T, 0)
+	if size <= 0 || step <= 0 {
+		return result
+	}
+
+	list := *streamSelf
+	for i := 0; i+size <= len(list); i += step {
+		result = append(result, DuplicateSlice(list[i:i+size]))
+	}
+
+	return result
+}
+
+// SortedBy Sort Stream items by the less function (the same as Sort())
+func (streamSelf *StreamDef[T]) SortedBy(less Comparator[T]) *StreamDef[T] {
+	return streamSelf.Sort(less)
+}
+
+// MinBy Get the minimum item by the less function(false if the Stream is empty)
+func (streamSelf *StreamDef[T]) MinBy(less Comparator[T]) (T, bool) {
+	if streamSelf.Len() == 0 {
+		return *new(T), false
+	}
+
+	result := streamSelf.Get(0)
+	for _, item := range (*streamSelf)[1:] {
+		if less(item, result) {
+			result = item
+		}
+	}
+	return result, true
+}
+
+// MaxBy Get the maximum item by the less function(false if the Stream is empty)
+func (streamSelf *StreamDef[T]) MaxBy(less Comparator[T]) (T, bool) {
+	if streamSelf.Len() == 0 {
+		return *new(T), false
+	}
+
+	result := streamSelf.Get(0)
+	for _, item := range (*streamSelf)[1:] {
+		if less(result, item) {
+			result = item
+		}
+	}
+	return result, true
+}
+
+// SortByOrd Sort Stream items by Ord(stable)
+func (streamSelf *StreamDef[T]) SortByOrd(ord Ord[T]) *StreamDef[T] {
+	result := streamSelf.Clone()
+	sort.SliceStable(*result, func(i, j int) bool {
+		return ord((*result)[i], (*result)[j]) < 0
+	})
+	return result
+}
+
+// DistinctByEq Filter items equal(by Eq) to a previous one and return a new Stream instance (first one kept)
+func (streamSelf *StreamDef[T]) DistinctByEq(eq Eq[T]) *StreamDef[T] {
+	result := StreamDef[T]{}
+	for _, item := range *streamSelf {
+		if !Some(func(existing T) bool {
+			return eq(existing, item)
+		}, result...) {
+			result = append(result, item)
+		}
+	}
+	return &result
+}
+
+// MinByOrd Get the minimum item by Ord(false if the Stream is empty)
+func (streamSelf *StreamDef[T]) MinByOrd(ord Ord[T]) (T, bool) {
+	return streamSelf.MinBy(ord.Comparator())
+}
+
+// MaxByOrd Get the maximum item by Ord(false if the Stream is empty)
+func (streamSelf *StreamDef[T]) MaxByOrd(ord Ord[T]) (T, bool) {
+	return streamSelf.MaxBy(ord.Comparator())
+}
+
+// TopK Get the k largest items by the less function(largest first), by a heap without sorting all items
+func (streamSelf *StreamDef[T]) TopK(k int, less Comparator[T]) *StreamDef[T] {
+	if k <= 0 {
+		return new(StreamDef[T])
+	}
+
+	// Keep the k largest items in a min-heap
+	h := &comparatorHeap[T]{less: less}
+	for _, item := range *streamSelf {
+		if h.Len() < k {
+			heap.Push(h, item)
+		} else if less(h.list[0], item) {
+			h.list[0] = item
+			heap.Fix(h, 0)
+		}
+	}
+
+	result := make(StreamDef[T], h.Len())
+	for i := len(result) - 1; i >= 0; i-- {
+		result[i] = heap.Pop(h).(T)
+	}
+	return &result
+}
+
+// comparatorHeap heap.Interface implemented by a Comparator
+type comparatorHeap[T any] struct {
+	list []T
+	less Comparator[T]
+}
+
+func (h comparatorHeap[T]) Len() int           { return len(h.list) }
+func (h comparatorHeap[T]) Less(i, j int) bool { return h.less(h.list[i], h.list[j]) }
+func (h comparatorHeap[T]) Swap(i, j int)      { h.list[i], h.list[j] = h.list[j], h.list[i] }
+
+func (h *comparatorHeap[T]) Push(x interface{}) {
+	h.list = append(h.list, x.(T))
+}
+
+func (h *comparatorHeap[T]) Pop() interface{} {
+	lastIndex := len(h.list) - 1
+	last := h.list[lastIndex]
+	h.list = h.list[:lastIndex]
+	return last
+}
+
 // Get Get an item of Stream by its index
 func (streamSelf *StreamDef[T]) Get(i int) T {
 	return (*streamSelf)[i]
@@ -0,0 +1,50 @@
+package fpgo
+
+import (
+	"context"
+	"sync"
+)
+
+// Stream ForEach
+
+// ForEachLimited Call fn for each item on new goroutines, at most maxConcurrent calls at a time(1 if <= 0)
+// & started at most perSecond calls per second(unlimited if <= 0),
+// then wait for all of them and aggregate the errors as a *MultiError(nil if there's none)
+//
+// NOTE: a failed call doesn't stop the others, but the done ctx stops starting new calls.
+func (streamSelf *StreamDef[T]) ForEachLimited(ctx context.Context, maxConcurrent int, perSecond float64, fn func(T) error) error {
+	semaphore := NewSemaphore(maxConcurrent)
+	limiter := NewTokenBucketLimiter(perSecond, 1)
+
+	errs := make([]error, streamSelf.Len())
+	var ctxErr error
+	var wg sync.WaitGroup
+	for i, item := range *streamSelf {
+		if ctxErr = semaphore.Acquire(ctx); ctxErr != nil {
+			break
+		}
+		if ctxErr = limiter.Wait(ctx); ctxErr != nil {
+			semaphore.Release()
+			break
+		}
+
+		wg.Add(1)
+		go func(i int, item T) {
+			defer wg.Done()
+			defer semaphore.Release()
+			errs[i] = fn(item)
+		}(i, item)
+	}
+	wg.Wait()
+
+	errs = Filter(func(err error, _ int) bool {
+		return err != nil
+	}, errs...)
+	if ctxErr != nil {
+		errs = append(errs, ctxErr)
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return &MultiError{Errors: errs}
+}
@@ -0,0 +1,75 @@
+package fpgo
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStreamForEachLimited(t *testing.T) {
+	var lock sync.Mutex
+	current, peak := 0, 0
+	var visited []int
+	err := StreamFrom(1, 2, 3, 4, 5, 6, 7, 8).ForEachLimited(context.Background(), 3, 0, func(v int) error {
+		lock.Lock()
+		current++
+		if current > peak {
+			peak = current
+		}
+		visited = append(visited, v)
+		lock.Unlock()
+		time.Sleep(2 * time.Millisecond)
+		lock.Lock()
+		current--
+		lock.Unlock()
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 8, len(visited))
+	assert.True(t, peak > 0 && peak <= 3)
+
+	// Aggregated errors in the order of the items
+	err = StreamFrom(1, 2, 3, 4).ForEachLimited(context.Background(), 2, 0, func(v int) error {
+		if v%2 == 0 {
+			return errors.New("even " + strconv.Itoa(v))
+		}
+		return nil
+	})
+	var forEachErr *MultiError
+	assert.True(t, errors.As(err, &forEachErr))
+	assert.Equal(t, 2, len(forEachErr.Errors))
+	assert.EqualError(t, err, "2 error(s) occurred: [even 2; even 4]")
+
+	assert.NoError(t, StreamFrom[int]().ForEachLimited(context.Background(), 1, 1, func(v int) error {
+		return errors.New("never")
+	}))
+}
+
+func TestStreamForEachLimitedRate(t *testing.T) {
+	start := time.Now()
+	count := 0
+	err := StreamFrom(1, 2, 3).ForEachLimited(context.Background(), 1, 100, func(v int) error {
+		count++
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, count)
+	// The first call takes the initial token, the others wait 10ms each
+	assert.True(t, time.Since(start) >= 15*time.Millisecond)
+
+	// Stopped by the ctx
+	ctx, cancel := context.WithCancel(context.Background())
+	count = 0
+	err = StreamFrom(1, 2, 3).ForEachLimited(ctx, 1, 0.001, func(v int) error {
+		count++
+		cancel()
+		return nil
+	})
+	assert.Equal(t, 1, count)
+	assert.True(t, errors.Is(err, context.Canceled))
+}
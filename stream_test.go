@@ -1,7 +1,11 @@
 package fpgo
 
 import (
+	"context"
+	"io"
+	"strings"
 	"testing"
+	"testing/iotest"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -384,6 +388,190 @@ func TestStreamSetSetOperation(t *testing.T) {
 	assert.Equal(t, "70,72,end/end/end/end/end/", tempString)
 }
 
+func TestStreamDistinctGroupPartition(t *testing.T) {
+	s := StreamFrom("apple", "avocado", "banana", "blueberry", "cherry", "apple")
+
+	assert.Equal(t, []string{"apple", "avocado", "banana", "blueberry", "cherry"}, s.Distinct().ToArray())
+	assert.Equal(t, []string{"apple", "banana", "cherry"}, StreamDistinctBy(s, func(v string) byte {
+		return v[0]
+	}).ToArray())
+	assert.Equal(t, map[int][]string{
+		5: {"apple", "apple"},
+		6: {"banana", "cherry"},
+		7: {"avocado"},
+		9: {"blueberry"},
+	}, StreamGroupBy(s, func(v string) int {
+		return len(v)
+	}))
+
+	matched, unmatched := s.Partition(func(v string) bool {
+		return len(v) > 6
+	})
+	assert.Equal(t, []string{"avocado", "blueberry"}, matched.ToArray())
+	assert.Equal(t, []string{"apple", "banana", "cherry", "apple"}, unmatched.ToArray())
+}
+
+func TestStreamChunkWindow(t *testing.T) {
+	s := StreamFrom(1, 2, 3, 4, 5)
+
+	assert.Equal(t, [][]int{{1, 2}, {3, 4}, {5}}, s.Chunk(2))
+	assert.Equal(t, [][]int{{1, 2, 3, 4, 5}}, s.Chunk(10))
+	assert.Equal(t, [][]int{}, s.Chunk(0))
+	assert.Equal(t, [][]int{}, StreamFrom[int]().Chunk(2))
+
+	assert.Equal(t, [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}}, s.Window(3, 1))
+	assert.Equal(t, [][]int{{1, 2}, {3, 4}}, s.Window(2, 2))
+	assert.Equal(t, [][]int{{1}, {4}}, s.Window(1, 3))
+	assert.Equal(t, [][]int{}, s.Window(6, 1))
+	assert.Equal(t, [][]int{}, s.Window(2, 0))
+
+	// Chunks don't share the memory with the Stream
+	chunks := s.Chunk(2)
+	chunks[0][0] = 100
+	assert.Equal(t, 1, s.Get(0))
+}
+
+func TestStreamFlatMapZip(t *testing.T) {
+	s := StreamFrom(1, 2, 3)
+
+	assert.Equal(t, []string{"1", "2", "2", "3", "3", "3"}, StreamFlatMap(s, func(v int) *StreamDef[string] {
+		result := make([]string, v)
+		for i := range result {
+			result[i] = Maybe.Just(v).ToString()
+		}
+		return StreamFromArray(result)
+	}).ToArray())
+	assert.Equal(t, []int{2}, StreamFlatMap(s, func(v int) *StreamDef[int] {
+		if v == 2 {
+			return StreamFrom(v)
+		}
+		return nil
+	}).ToArray())
+
+	assert.Equal(t, []Tuple2[int, string]{
+		NewTuple2(1, "a"),
+		NewTuple2(2, "b"),
+	}, StreamZip(s, StreamFrom("a", "b")).ToArray())
+	assert.Equal(t, []string{"1a", "2b", "3c"}, StreamZipWith(s, StreamFrom("a", "b", "c", "d"), func(a int, b string) string {
+		return Maybe.Just(a).ToString() + b
+	}).ToArray())
+	assert.Equal(t, 0, StreamZip(s, StreamFrom[string]()).Len())
+
+	streamA, streamB := StreamUnzip(StreamZip(s, StreamFrom("a", "b")))
+	assert.Equal(t, []int{1, 2}, streamA.ToArray())
+	assert.Equal(t, []string{"a", "b"}, streamB.ToArray())
+	assert.Equal(t, []Tuple2[int, bool]{
+		NewTuple2(1, true),
+		NewTuple2(1, false),
+		NewTuple2(2, true),
+		NewTuple2(2, false),
+	}, StreamCartesianProduct(StreamFrom(1, 2), StreamFrom(true, false)).ToArray())
+	assert.Equal(t, 0, StreamCartesianProduct(StreamFrom[int](), StreamFrom(true)).Len())
+}
+
+func TestStreamSources(t *testing.T) {
+	ch := make(chan int, 3)
+	ch <- 1
+	ch <- 2
+	ch <- 3
+	close(ch)
+	assert.Equal(t, []int{1, 2, 3}, StreamFromChannel(ch).ToArray())
+
+	seq := func(yield func(int) bool) {
+		for i := 0; i < 10; i++ {
+			if !yield(i * i) {
+				return
+			}
+		}
+	}
+	assert.Equal(t, []int{0, 1, 4, 9, 16, 25, 36, 49, 64, 81}, StreamFromSeq(seq).ToArray())
+
+	s, err := StreamFromLines(strings.NewReader("a\nb\r\n\nc"))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "", "c"}, s.ToArray())
+	_, err = StreamFromLines(iotest.ErrReader(io.ErrUnexpectedEOF))
+	assert.Equal(t, io.ErrUnexpectedEOF, err)
+}
+
+func TestStreamSinks(t *testing.T) {
+	s := StreamFrom(1, 2, 3)
+
+	actual := make([]int, 0)
+	for v := range s.ToChannel(context.Background(), 0) {
+		actual = append(actual, v)
+	}
+	assert.Equal(t, []int{1, 2, 3}, actual)
+
+	// Stop sending once the ctx is done
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := StreamFromArray(make([]int, 100)).ToChannel(ctx, 0)
+	<-ch
+	cancel()
+	count := 0
+	for range ch {
+		count++
+	}
+	assert.True(t, count < 99)
+
+	actual = make([]int, 0)
+	s.ToSeq()(func(v int) bool {
+		actual = append(actual, v)
+		return v < 2
+	})
+	assert.Equal(t, []int{1, 2}, actual)
+	assert.Equal(t, s.ToArray(), StreamFromSeq(s.ToSeq()).ToArray())
+}
+
+func TestStreamSortedTopK(t *testing.T) {
+	s := StreamFrom(5, 1, 4, 2, 3)
+	less := func(a, b int) bool {
+		return a < b
+	}
+	var val int
+	var ok bool
+
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, s.SortedBy(less).ToArray())
+	assert.Equal(t, []int{5, 1, 4, 2, 3}, s.ToArray())
+
+	val, ok = s.MinBy(less)
+	assert.Equal(t, 1, val)
+	assert.Equal(t, true, ok)
+	val, ok = s.MaxBy(less)
+	assert.Equal(t, 5, val)
+	assert.Equal(t, true, ok)
+	_, ok = StreamFrom[int]().MinBy(less)
+	assert.Equal(t, false, ok)
+	_, ok = StreamFrom[int]().MaxBy(less)
+	assert.Equal(t, false, ok)
+
+	assert.Equal(t, []int{5, 4, 3}, s.TopK(3, less).ToArray())
+	assert.Equal(t, []int{1, 2}, s.TopK(2, func(a, b int) bool {
+		return a > b
+	}).ToArray())
+	assert.Equal(t, []int{5, 4, 3, 2, 1}, s.TopK(10, less).ToArray())
+	assert.Equal(t, 0, s.TopK(0, less).Len())
+	assert.Equal(t, []int{5, 1, 4, 2, 3}, s.ToArray())
+}
+
+func TestStreamStatistics(t *testing.T) {
+	s := StreamFrom(3, 1, 4, 1, 5)
+
+	assert.Equal(t, 14, StreamSum(s))
+	assert.Equal(t, 2.8, StreamAverage(s))
+	assert.Equal(t, 1, StreamMin(s))
+	assert.Equal(t, 5, StreamMax(s))
+	assert.Equal(t, SummaryStatistics[int]{
+		Count:   5,
+		Sum:     14,
+		Min:     1,
+		Max:     5,
+		Average: 2.8,
+	}, StreamSummaryStatistics(s))
+	assert.Equal(t, SummaryStatistics[float64]{}, StreamSummaryStatistics(StreamFrom[float64]()))
+	assert.Equal(t, float64(0), StreamAverage(StreamFrom[float64]()))
+	assert.Equal(t, -1.5, StreamMin(StreamFrom(-1.5, 2.0)))
+}
+
 func streamIntTransformer(s *StreamDef[int]) string {
 	result := ""
 	for _, item := range SortOrderedAscending(s.ToArray()...) {
@@ -0,0 +1,29 @@
+package fpgo
+
+import "time"
+
+// Function Timing
+
+// DebounceFunc Debounce fn: it's called with the last argument once no calls happen for the duration(trailing)
+func DebounceFunc[T any](fn func(T), duration time.Duration) func(T) {
+	return DebounceFuncWithOption(fn, duration, TimingOption{Trailing: true})
+}
+
+// DebounceFuncWithOption DebounceFunc with leading/trailing call options & the TimeScheduler
+func DebounceFuncWithOption[T any](fn func(T), duration time.Duration, option TimingOption) func(T) {
+	publisher := PublisherNewGenerics[T]()
+	publisher.DebounceWithOption(duration, option).Subscribe(Subscription[T]{OnNext: fn})
+	return publisher.Publish
+}
+
+// ThrottleFunc Throttle fn: it's called at most once per duration(leading), other calls in the window are dropped
+func ThrottleFunc[T any](fn func(T), duration time.Duration) func(T) {
+	return ThrottleFuncWithOption(fn, duration, TimingOption{Leading: true})
+}
+
+// ThrottleFuncWithOption ThrottleFunc with leading/trailing call options & the TimeScheduler
+func ThrottleFuncWithOption[T any](fn func(T), duration time.Duration, option TimingOption) func(T) {
+	publisher := PublisherNewGenerics[T]()
+	publisher.ThrottleWithOption(duration, option).Subscribe(Subscription[T]{OnNext: fn})
+	return publisher.Publish
+}
@@ -0,0 +1,48 @@
+package fpgo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDebounceThrottleFunc(t *testing.T) {
+	timeScheduler := NewVirtualTimeScheduler(time.Now())
+	var actual []int
+	record := func(v int) {
+		actual = append(actual, v)
+	}
+
+	debounced := DebounceFuncWithOption(record, 10*time.Millisecond, TimingOption{Trailing: true, TimeScheduler: timeScheduler})
+	debounced(1)
+	timeScheduler.Advance(5 * time.Millisecond)
+	debounced(2)
+	timeScheduler.Advance(9 * time.Millisecond)
+	assert.Empty(t, actual)
+	timeScheduler.Advance(1 * time.Millisecond)
+	assert.Equal(t, []int{2}, actual)
+
+	actual = nil
+	throttled := ThrottleFuncWithOption(record, 10*time.Millisecond, TimingOption{Leading: true, TimeScheduler: timeScheduler})
+	throttled(1)
+	throttled(2)
+	timeScheduler.Advance(10 * time.Millisecond)
+	throttled(3)
+	assert.Equal(t, []int{1, 3}, actual)
+
+	// Real time
+	done := make(chan int, 1)
+	debouncedReal := DebounceFunc(func(v int) {
+		done <- v
+	}, time.Millisecond)
+	debouncedReal(1)
+	debouncedReal(2)
+	assert.Equal(t, 2, <-done)
+
+	actual = nil
+	throttledReal := ThrottleFunc(record, time.Hour)
+	throttledReal(1)
+	throttledReal(2)
+	assert.Equal(t, []int{1}, actual)
+}
@@ -0,0 +1,150 @@
+package fpgo
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Tuple
+
+// Tuple2 Tuple of 2 values inspired by Scala/Haskell(encoded as a JSON array)
+type Tuple2[A any, B any] struct {
+	V1 A
+	V2 B
+}
+
+// NewTuple2 New Tuple2 instance by values
+func NewTuple2[A any, B any](v1 A, v2 B) Tuple2[A, B] {
+	return Tuple2[A, B]{V1: v1, V2: v2}
+}
+
+// Unpack Get all values of the Tuple2
+func (tupleSelf Tuple2[A, B]) Unpack() (A, B) {
+	return tupleSelf.V1, tupleSelf.V2
+}
+
+// Swap New Tuple2 with the 2 values swapped
+func (tupleSelf Tuple2[A, B]) Swap() Tuple2[B, A] {
+	return Tuple2[B, A]{V1: tupleSelf.V2, V2: tupleSelf.V1}
+}
+
+// MarshalJSON Encode the Tuple2 as a JSON array
+func (tupleSelf Tuple2[A, B]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]interface{}{tupleSelf.V1, tupleSelf.V2})
+}
+
+// UnmarshalJSON Decode the Tuple2 from a JSON array of 2 elements
+func (tupleSelf *Tuple2[A, B]) UnmarshalJSON(data []byte) error {
+	return unmarshalTupleJSON(data, &tupleSelf.V1, &tupleSelf.V2)
+}
+
+// MapFirst New Tuple2 with the first value transformed by fn
+func MapFirst[A any, B any, R any](tuple Tuple2[A, B], fn func(A) R) Tuple2[R, B] {
+	return Tuple2[R, B]{V1: fn(tuple.V1), V2: tuple.V2}
+}
+
+// MapSecond New Tuple2 with the second value transformed by fn
+func MapSecond[A any, B any, R any](tuple Tuple2[A, B], fn func(B) R) Tuple2[A, R] {
+	return Tuple2[A, R]{V1: tuple.V1, V2: fn(tuple.V2)}
+}
+
+// Tuple3 Tuple of 3 values inspired by Scala/Haskell(encoded as a JSON array)
+type Tuple3[A any, B any, C any] struct {
+	V1 A
+	V2 B
+	V3 C
+}
+
+// NewTuple3 New Tuple3 instance by values
+func NewTuple3[A any, B any, C any](v1 A, v2 B, v3 C) Tuple3[A, B, C] {
+	return Tuple3[A, B, C]{V1: v1, V2: v2, V3: v3}
+}
+
+// Unpack Get all values of the Tuple3
+func (tupleSelf Tuple3[A, B, C]) Unpack() (A, B, C) {
+	return tupleSelf.V1, tupleSelf.V2, tupleSelf.V3
+}
+
+// MarshalJSON Encode the Tuple3 as a JSON array
+func (tupleSelf Tuple3[A, B, C]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]interface{}{tupleSelf.V1, tupleSelf.V2, tupleSelf.V3})
+}
+
+// UnmarshalJSON Decode the Tuple3 from a JSON array of 3 elements
+func (tupleSelf *Tuple3[A, B, C]) UnmarshalJSON(data []byte) error {
+	return unmarshalTupleJSON(data, &tupleSelf.V1, &tupleSelf.V2, &tupleSelf.V3)
+}
+
+// Tuple4 Tuple of 4 values inspired by Scala/Haskell(encoded as a JSON array)
+type Tuple4[A any, B any, C any, D any] struct {
+	V1 A
+	V2 B
+	V3 C
+	V4 D
+}
+
+// NewTuple4 New Tuple4 instance by values
+func NewTuple4[A any, B any, C any, D any](v1 A, v2 B, v3 C, v4 D) Tuple4[A, B, C, D] {
+	return Tuple4[A, B, C, D]{V1: v1, V2: v2, V3: v3, V4: v4}
+}
+
+// Unpack Get all values of the Tuple4
+func (tupleSelf Tuple4[A, B, C, D]) Unpack() (A, B, C, D) {
+	return tupleSelf.V1, tupleSelf.V2, tupleSelf.V3, tupleSelf.V4
+}
+
+// MarshalJSON Encode the Tuple4 as a JSON array
+func (tupleSelf Tuple4[A, B, C, D]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]interface{}{tupleSelf.V1, tupleSelf.V2, tupleSelf.V3, tupleSelf.V4})
+}
+
+// UnmarshalJSON Decode the Tuple4 from a JSON array of 4 elements
+func (tupleSelf *Tuple4[A, B, C, D]) UnmarshalJSON(data []byte) error {
+	return unmarshalTupleJSON(data, &tupleSelf.V1, &tupleSelf.V2, &tupleSelf.V3, &tupleSelf.V4)
+}
+
+// Tuple5 Tuple of 5 values inspired by Scala/Haskell(encoded as a JSON array)
+type Tuple5[A any, B any, C any, D any, E any] struct {
+	V1 A
+	V2 B
+	V3 C
+	V4 D
+	V5 E
+}
+
+// NewTuple5 New Tuple5 instance by values
+func NewTuple5[A any, B any, C any, D any, E any](v1 A, v2 B, v3 C, v4 D, v5 E) Tuple5[A, B, C, D, E] {
+	return Tuple5[A, B, C, D, E]{V1: v1, V2: v2, V3: v3, V4: v4, V5: v5}
+}
+
+// Unpack Get all values of the Tuple5
+func (tupleSelf Tuple5[A, B, C, D, E]) Unpack() (A, B, C, D, E) {
+	return tupleSelf.V1, tupleSelf.V2, tupleSelf.V3, tupleSelf.V4, tupleSelf.V5
+}
+
+// MarshalJSON Encode the Tuple5 as a JSON array
+func (tupleSelf Tuple5[A, B, C, D, E]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]interface{}{tupleSelf.V1, tupleSelf.V2, tupleSelf.V3, tupleSelf.V4, tupleSelf.V5})
+}
+
+// UnmarshalJSON Decode the Tuple5 from a JSON array of 5 elements
+func (tupleSelf *Tuple5[A, B, C, D, E]) UnmarshalJSON(data []byte) error {
+	return unmarshalTupleJSON(data, &tupleSelf.V1, &tupleSelf.V2, &tupleSelf.V3, &tupleSelf.V4, &tupleSelf.V5)
+}
+
+// unmarshalTupleJSON Decode the JSON array into the pointers of the tuple values in order
+func unmarshalTupleJSON(data []byte, values ...interface{}) error {
+	var elements []json.RawMessage
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+	if len(elements) != len(values) {
+		return fmt.Errorf("tuple: expected a JSON array of %d elements but got %d", len(values), len(elements))
+	}
+	for i, element := range elements {
+		if err := json.Unmarshal(element, values[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
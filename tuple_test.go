@@ -0,0 +1,58 @@
+package fpgo
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTuple(t *testing.T) {
+	tuple2 := NewTuple2(1, "a")
+	v1, v2 := tuple2.Unpack()
+	assert.Equal(t, 1, v1)
+	assert.Equal(t, "a", v2)
+	assert.Equal(t, NewTuple2("a", 1), tuple2.Swap())
+	assert.Equal(t, NewTuple2("1", "a"), MapFirst(tuple2, strconv.Itoa))
+	assert.Equal(t, NewTuple2(1, 1), MapSecond(tuple2, func(v string) int {
+		return len(v)
+	}))
+
+	a, b, c, d, e := NewTuple5(1, "a", true, 0.5, []int{2}).Unpack()
+	assert.Equal(t, 1, a)
+	assert.Equal(t, "a", b)
+	assert.Equal(t, true, c)
+	assert.Equal(t, 0.5, d)
+	assert.Equal(t, []int{2}, e)
+}
+
+func TestTupleJSON(t *testing.T) {
+	data, err := json.Marshal(NewTuple2(1, "a"))
+	assert.NoError(t, err)
+	assert.Equal(t, `[1,"a"]`, string(data))
+	data, err = json.Marshal([]Tuple3[int, string, bool]{NewTuple3(1, "a", true)})
+	assert.NoError(t, err)
+	assert.Equal(t, `[[1,"a",true]]`, string(data))
+	data, err = json.Marshal(NewTuple4(1, "a", true, []int{2}))
+	assert.NoError(t, err)
+	assert.Equal(t, `[1,"a",true,[2]]`, string(data))
+	data, err = json.Marshal(NewTuple5(1, "a", true, []int{2}, NewTuple2(3, "b")))
+	assert.NoError(t, err)
+	assert.Equal(t, `[1,"a",true,[2],[3,"b"]]`, string(data))
+
+	var tuple5 Tuple5[int, string, bool, []int, Tuple2[int, string]]
+	assert.NoError(t, json.Unmarshal(data, &tuple5))
+	assert.Equal(t, NewTuple5(1, "a", true, []int{2}, NewTuple2(3, "b")), tuple5)
+
+	var tuple2 Tuple2[int, string]
+	assert.Error(t, json.Unmarshal([]byte(`[1]`), &tuple2))
+	assert.Error(t, json.Unmarshal([]byte(`[1,2]`), &tuple2))
+	assert.Error(t, json.Unmarshal([]byte(`{"V1":1}`), &tuple2))
+	var tuple3 Tuple3[int, string, bool]
+	assert.NoError(t, json.Unmarshal([]byte(`[1,"a",true]`), &tuple3))
+	assert.Equal(t, NewTuple3(1, "a", true), tuple3)
+	var tuple4 Tuple4[int, int, int, int]
+	assert.NoError(t, json.Unmarshal([]byte(`[1,2,3,4]`), &tuple4))
+	assert.Equal(t, NewTuple4(1, 2, 3, 4), tuple4)
+}
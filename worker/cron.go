@@ -0,0 +1,450 @@
+package worker
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	fpgo "github.com/TeaEntityLab/fpGo/v2"
+)
+
+var (
+	// ErrCronExpressionInvalid The cron expression is invalid
+	ErrCronExpressionInvalid = errors.New("invalid cron expression")
+	// ErrCronSchedulerIsClosed CronScheduler Is Closed
+	ErrCronSchedulerIsClosed = errors.New("cronScheduler is closed")
+)
+
+// CronExpression
+
+// cronField The range & the names of a field of cron expressions
+type cronField struct {
+	min   int
+	max   int
+	names map[string]int
+}
+
+var (
+	cronFieldSecond  = cronField{min: 0, max: 59}
+	cronFieldMinute  = cronField{min: 0, max: 59}
+	cronFieldHour    = cronField{min: 0, max: 23}
+	cronFieldDay     = cronField{min: 1, max: 31}
+	cronFieldMonth   = cronField{min: 1, max: 12, names: map[string]int{"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6, "jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12}}
+	cronFieldWeekday = cronField{min: 0, max: 7, names: map[string]int{"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6}}
+)
+
+// cronDescriptors Predefined cron expressions(in 6 fields)
+var cronDescriptors = map[string]string{
+	"@yearly":   "0 0 0 1 1 *",
+	"@annually": "0 0 0 1 1 *",
+	"@monthly":  "0 0 0 1 * *",
+	"@weekly":   "0 0 0 * * 0",
+	"@daily":    "0 0 0 * * *",
+	"@midnight": "0 0 0 * * *",
+	"@hourly":   "0 0 * * * *",
+}
+
+// cronSearchYears Give up searching the next run beyond the years(e.g. Feb 30th never comes)
+const cronSearchYears = 5
+
+// CronExpression Parsed cron expression(matched fields are stored as bits)
+type CronExpression struct {
+	expr     string
+	location *time.Location
+
+	seconds  uint64
+	minutes  uint64
+	hours    uint64
+	days     uint64
+	months   uint64
+	weekdays uint64
+	// Either of day/weekday is "*": both should match, otherwise any of them matches(like the standard cron)
+	isDayStar     bool
+	isWeekdayStar bool
+}
+
+// ParseCronExpression Parse the cron expression of 5 fields(minute hour day month weekday)
+// or 6 fields(second minute hour day month weekday), with an optional "CRON_TZ=<zone> " or "TZ=<zone> " prefix
+//
+// Fields support "*", "?", lists("1,3"), ranges("1-5"), steps("*/15", "10-30/5", "5/10") & names of months/weekdays("JAN", "MON-FRI"),
+// weekday 7 is Sunday as well; descriptors "@yearly", "@annually", "@monthly", "@weekly", "@daily", "@midnight" & "@hourly" are supported too.
+func ParseCronExpression(expr string) (*CronExpression, error) {
+	expression := &CronExpression{expr: expr}
+	spec := strings.TrimSpace(expr)
+
+	if strings.HasPrefix(spec, "CRON_TZ=") || strings.HasPrefix(spec, "TZ=") {
+		i := strings.IndexAny(spec, " \t")
+		if i < 0 {
+			return nil, fmt.Errorf("%w: %q has no fields", ErrCronExpressionInvalid, expr)
+		}
+		zone := spec[strings.Index(spec, "=")+1 : i]
+		location, err := time.LoadLocation(zone)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrCronExpressionInvalid, err)
+		}
+		expression.location = location
+		spec = strings.TrimSpace(spec[i:])
+	}
+	if descriptor, ok := cronDescriptors[strings.ToLower(spec)]; ok {
+		spec = descriptor
+	}
+
+	fields := strings.Fields(spec)
+	switch len(fields) {
+	case 5:
+		fields = append([]string{"0"}, fields...)
+	case 6:
+	default:
+		return nil, fmt.Errorf("%w: %q should have 5 or 6 fields", ErrCronExpressionInvalid, expr)
+	}
+
+	var err error
+	targets := []*uint64{&expression.seconds, &expression.minutes, &expression.hours, &expression.days, &expression.months, &expression.weekdays}
+	for i, field := range []cronField{cronFieldSecond, cronFieldMinute, cronFieldHour, cronFieldDay, cronFieldMonth, cronFieldWeekday} {
+		if *targets[i], err = field.parse(fields[i]); err != nil {
+			return nil, fmt.Errorf("%w: %q %v", ErrCronExpressionInvalid, expr, err)
+		}
+	}
+	// Sunday could be 7
+	if expression.weekdays&(1<<7) != 0 {
+		expression.weekdays |= 1
+	}
+	expression.isDayStar = fields[3] == "*" || fields[3] == "?"
+	expression.isWeekdayStar = fields[5] == "*" || fields[5] == "?"
+
+	return expression, nil
+}
+
+// parse Parse the field into bits
+func (field cronField) parse(spec string) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(spec, ",") {
+		rangeSpec, step := part, 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			var err error
+			rangeSpec = part[:i]
+			step, err = strconv.Atoi(part[i+1:])
+			if err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid step %q", part)
+			}
+		}
+
+		var from, to int
+		var err error
+		switch {
+		case rangeSpec == "*" || rangeSpec == "?":
+			from, to = field.min, field.max
+		case strings.Contains(rangeSpec, "-"):
+			bounds := strings.SplitN(rangeSpec, "-", 2)
+			if from, err = field.parseValue(bounds[0]); err != nil {
+				return 0, err
+			}
+			if to, err = field.parseValue(bounds[1]); err != nil {
+				return 0, err
+			}
+		default:
+			if from, err = field.parseValue(rangeSpec); err != nil {
+				return 0, err
+			}
+			to = from
+			// "5/10" means from 5 to the max every 10
+			if step > 1 {
+				to = field.max
+			}
+		}
+		if from > to {
+			return 0, fmt.Errorf("invalid range %q", part)
+		}
+
+		for i := from; i <= to; i += step {
+			bits |= 1 << uint(i)
+		}
+	}
+	return bits, nil
+}
+
+// parseValue Parse a number or a name within the range
+func (field cronField) parseValue(spec string) (int, error) {
+	if val, ok := field.names[strings.ToLower(spec)]; ok {
+		return val, nil
+	}
+	val, err := strconv.Atoi(spec)
+	if err != nil || val < field.min || val > field.max {
+		return 0, fmt.Errorf("invalid value %q", spec)
+	}
+	return val, nil
+}
+
+// String Get the original expression
+func (expressionSelf *CronExpression) String() string {
+	return expressionSelf.expr
+}
+
+// Location Get the time zone of the expression(nil if it's not specified)
+func (expressionSelf *CronExpression) Location() *time.Location {
+	return expressionSelf.location
+}
+
+// Next Get the first matched time after the given one(in the time zone of the expression or of the given time),
+// zero time if there's none within a few years
+func (expressionSelf *CronExpression) Next(after time.Time) time.Time {
+	location := after.Location()
+	if expressionSelf.location != nil {
+		location = expressionSelf.location
+	}
+	t := after.In(location).Truncate(time.Second).Add(time.Second)
+	yearLimit := t.Year() + cronSearchYears
+
+	for t.Year() <= yearLimit {
+		year, month, day := t.Date()
+		hour, minute, second := t.Clock()
+		// step Move to the next hour/minute/second in the absolute time,
+		// in case time.Date() goes backwards(e.g. the repeated hour when clocks go back)
+		var next time.Time
+		step := time.Hour - time.Duration(minute)*time.Minute - time.Duration(second)*time.Second
+		switch {
+		case expressionSelf.months&(1<<uint(month)) == 0:
+			next = time.Date(year, month+1, 1, 0, 0, 0, 0, location)
+		case !expressionSelf.isDayMatched(t):
+			next = time.Date(year, month, day+1, 0, 0, 0, 0, location)
+		case expressionSelf.hours&(1<<uint(hour)) == 0:
+			next = time.Date(year, month, day, hour+1, 0, 0, 0, location)
+		case expressionSelf.minutes&(1<<uint(minute)) == 0:
+			next = time.Date(year, month, day, hour, minute+1, 0, 0, location)
+			step = time.Minute - time.Duration(second)*time.Second
+		case expressionSelf.seconds&(1<<uint(second)) == 0:
+			next = time.Date(year, month, day, hour, minute, second+1, 0, location)
+			step = time.Second
+		default:
+			return t
+		}
+		if !next.After(t) {
+			next = t.Add(step)
+		}
+		t = next
+	}
+	return time.Time{}
+}
+
+func (expressionSelf *CronExpression) isDayMatched(t time.Time) bool {
+	isDayMatched := expressionSelf.days&(1<<uint(t.Day())) != 0
+	isWeekdayMatched := expressionSelf.weekdays&(1<<uint(t.Weekday())) != 0
+	if expressionSelf.isDayStar || expressionSelf.isWeekdayStar {
+		return isDayMatched && isWeekdayMatched
+	}
+	return isDayMatched || isWeekdayMatched
+}
+
+// CronScheduler
+
+// CronSchedulerOption Options of CronScheduler
+type CronSchedulerOption struct {
+	// Location The time zone of the expressions without CRON_TZ(time.Local if nil)
+	Location *time.Location
+	// TimeScheduler Schedule the timers(fpgo.DefaultTimeScheduler if nil)
+	TimeScheduler fpgo.TimeScheduler
+	// OnError Called with the error of scheduling a Job onto the WorkerPool(it could be nil)
+	OnError func(error)
+}
+
+// CronScheduler Dispatch Jobs onto the WorkerPool by cron expressions
+//
+// NOTE: closing the CronScheduler removes all the CronJobs but it doesn't close the WorkerPool
+type CronScheduler struct {
+	lock     sync.Mutex
+	isClosed bool
+
+	workerPool WorkerPool
+	option     CronSchedulerOption
+	jobs       map[*CronJob]bool
+}
+
+// CronJob A Job scheduled by a CronScheduler
+type CronJob struct {
+	scheduler  *CronScheduler
+	expression *CronExpression
+	fn         func()
+
+	// Guarded by the lock of the scheduler
+	timer     fpgo.TimerHandle
+	nextRun   time.Time
+	isPaused  bool
+	isRemoved bool
+}
+
+// NewCronScheduler New a CronScheduler dispatching Jobs onto the WorkerPool
+func NewCronScheduler(workerPool WorkerPool, opts ...CronSchedulerOption) *CronScheduler {
+	var option CronSchedulerOption
+	if len(opts) > 0 {
+		option = opts[0]
+	}
+	if option.Location == nil {
+		option.Location = time.Local
+	}
+	if option.TimeScheduler == nil {
+		option.TimeScheduler = fpgo.DefaultTimeScheduler
+	}
+
+	return &CronScheduler{
+		workerPool: workerPool,
+		option:     option,
+		jobs:       make(map[*CronJob]bool),
+	}
+}
+
+// Add Schedule fn by the cron expression(see ParseCronExpression())
+func (schedulerSelf *CronScheduler) Add(expr string, fn func()) (*CronJob, error) {
+	expression, err := ParseCronExpression(expr)
+	if err != nil {
+		return nil, err
+	}
+	return schedulerSelf.AddExpression(expression, fn)
+}
+
+// AddExpression Schedule fn by the parsed CronExpression
+func (schedulerSelf *CronScheduler) AddExpression(expression *CronExpression, fn func()) (*CronJob, error) {
+	schedulerSelf.lock.Lock()
+	defer schedulerSelf.lock.Unlock()
+	if schedulerSelf.isClosed {
+		return nil, ErrCronSchedulerIsClosed
+	}
+
+	job := &CronJob{
+		scheduler:  schedulerSelf,
+		expression: expression,
+		fn:         fn,
+	}
+	schedulerSelf.jobs[job] = true
+	job.arm(schedulerSelf.option.TimeScheduler.Now())
+	return job, nil
+}
+
+// Jobs Get the CronJobs(not removed)
+func (schedulerSelf *CronScheduler) Jobs() []*CronJob {
+	schedulerSelf.lock.Lock()
+	defer schedulerSelf.lock.Unlock()
+
+	return fpgo.Keys(schedulerSelf.jobs)
+}
+
+// IsClosed Is the CronScheduler closed
+func (schedulerSelf *CronScheduler) IsClosed() bool {
+	schedulerSelf.lock.Lock()
+	defer schedulerSelf.lock.Unlock()
+
+	return schedulerSelf.isClosed
+}
+
+// Close Remove all the CronJobs, Add() fails with ErrCronSchedulerIsClosed afterwards
+func (schedulerSelf *CronScheduler) Close() {
+	schedulerSelf.lock.Lock()
+	defer schedulerSelf.lock.Unlock()
+
+	schedulerSelf.isClosed = true
+	for job := range schedulerSelf.jobs {
+		job.remove()
+	}
+}
+
+// Expression Get the CronExpression
+func (jobSelf *CronJob) Expression() *CronExpression {
+	return jobSelf.expression
+}
+
+// NextRun Get the time of the next run(zero time if it's paused, removed or there's no next run)
+func (jobSelf *CronJob) NextRun() time.Time {
+	jobSelf.scheduler.lock.Lock()
+	defer jobSelf.scheduler.lock.Unlock()
+
+	return jobSelf.nextRun
+}
+
+// IsPaused Is the CronJob paused
+func (jobSelf *CronJob) IsPaused() bool {
+	jobSelf.scheduler.lock.Lock()
+	defer jobSelf.scheduler.lock.Unlock()
+
+	return jobSelf.isPaused
+}
+
+// Pause Stop dispatching the CronJob until Resume()
+func (jobSelf *CronJob) Pause() {
+	jobSelf.scheduler.lock.Lock()
+	defer jobSelf.scheduler.lock.Unlock()
+
+	if jobSelf.isRemoved || jobSelf.isPaused {
+		return
+	}
+	jobSelf.isPaused = true
+	jobSelf.disarm()
+}
+
+// Resume Dispatch the CronJob again from the next matched time(the missed runs are skipped)
+func (jobSelf *CronJob) Resume() {
+	jobSelf.scheduler.lock.Lock()
+	defer jobSelf.scheduler.lock.Unlock()
+
+	if jobSelf.isRemoved || !jobSelf.isPaused {
+		return
+	}
+	jobSelf.isPaused = false
+	jobSelf.arm(jobSelf.scheduler.option.TimeScheduler.Now())
+}
+
+// Remove Remove the CronJob from the CronScheduler
+func (jobSelf *CronJob) Remove() {
+	jobSelf.scheduler.lock.Lock()
+	defer jobSelf.scheduler.lock.Unlock()
+
+	jobSelf.remove()
+}
+
+// remove Remove the CronJob(the lock of the scheduler should be held)
+func (jobSelf *CronJob) remove() {
+	jobSelf.isRemoved = true
+	jobSelf.disarm()
+	delete(jobSelf.scheduler.jobs, jobSelf)
+}
+
+// disarm Stop the timer(the lock of the scheduler should be held)
+func (jobSelf *CronJob) disarm() {
+	if jobSelf.timer != nil {
+		jobSelf.timer.Stop()
+		jobSelf.timer = nil
+	}
+	jobSelf.nextRun = time.Time{}
+}
+
+// arm Start the timer for the next run after the given time(the lock of the scheduler should be held)
+func (jobSelf *CronJob) arm(after time.Time) {
+	schedulerSelf := jobSelf.scheduler
+	timeScheduler := schedulerSelf.option.TimeScheduler
+	nextRun := jobSelf.expression.Next(after.In(schedulerSelf.option.Location))
+	jobSelf.nextRun = nextRun
+	if nextRun.IsZero() {
+		jobSelf.timer = nil
+		return
+	}
+
+	jobSelf.timer = timeScheduler.AfterFunc(nextRun.Sub(timeScheduler.Now()), func() {
+		schedulerSelf.lock.Lock()
+		// Outdated timer(paused/removed in the meantime)
+		if jobSelf.isRemoved || jobSelf.isPaused || !jobSelf.nextRun.Equal(nextRun) {
+			schedulerSelf.lock.Unlock()
+			return
+		}
+		after := timeScheduler.Now()
+		if after.Before(nextRun) {
+			after = nextRun
+		}
+		jobSelf.arm(after)
+		schedulerSelf.lock.Unlock()
+
+		if err := schedulerSelf.workerPool.Schedule(jobSelf.fn); err != nil && schedulerSelf.option.OnError != nil {
+			schedulerSelf.option.OnError(err)
+		}
+	})
+}
@@ -0,0 +1,160 @@
+package worker
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	fpgo "github.com/TeaEntityLab/fpGo/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCronExpression(t *testing.T) {
+	location, _ := time.LoadLocation("Asia/Taipei")
+	start := time.Date(2024, 1, 1, 10, 30, 15, 0, time.UTC) // Monday
+
+	for _, testCase := range []struct {
+		expr     string
+		expected time.Time
+	}{
+		{"* * * * *", time.Date(2024, 1, 1, 10, 31, 0, 0, time.UTC)},
+		{"* * * * * *", time.Date(2024, 1, 1, 10, 30, 16, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2024, 1, 1, 10, 45, 0, 0, time.UTC)},
+		{"10-20/5 * * * *", time.Date(2024, 1, 1, 11, 10, 0, 0, time.UTC)},
+		{"5/20 * * * *", time.Date(2024, 1, 1, 10, 45, 0, 0, time.UTC)},
+		{"0 9,18 * * *", time.Date(2024, 1, 1, 18, 0, 0, 0, time.UTC)},
+		{"0 0 * * SAT,sun", time.Date(2024, 1, 6, 0, 0, 0, 0, time.UTC)},
+		{"0 9 * * MON-FRI", time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1 FEB ?", time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		// Either day or weekday
+		{"0 0 15 * 3", time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2024, 1, 1, 11, 0, 0, 0, time.UTC)},
+		{"@monthly", time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{"CRON_TZ=Asia/Taipei 0 0 * * *", time.Date(2024, 1, 2, 0, 0, 0, 0, location)},
+	} {
+		expression, err := ParseCronExpression(testCase.expr)
+		if !assert.NoError(t, err, testCase.expr) {
+			continue
+		}
+		assert.True(t, testCase.expected.Equal(expression.Next(start)), testCase.expr+" "+expression.Next(start).String())
+	}
+
+	// Never
+	expression, _ := ParseCronExpression("0 0 30 2 *")
+	assert.True(t, expression.Next(start).IsZero())
+
+	for _, expr := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "*/0 * * * *", "5-1 * * * *", "* * * FOO *", "TZ=Nowhere/City * * * * *", "CRON_TZ=UTC"} {
+		_, err := ParseCronExpression(expr)
+		assert.True(t, errors.Is(err, ErrCronExpressionInvalid), expr)
+	}
+}
+
+func TestCronExpressionDST(t *testing.T) {
+	location, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	// 01:30 EST, the repeated hour when clocks go back
+	after := time.Date(2024, 11, 3, 6, 30, 0, 0, time.UTC).In(location)
+	expression, _ := ParseCronExpression("31 1 * * *")
+	assert.True(t, time.Date(2024, 11, 3, 6, 31, 0, 0, time.UTC).Equal(expression.Next(after)), expression.Next(after).String())
+
+	// Always moving forward
+	for _, expr := range []string{"31 1 * * *", "*/20 * * * *", "30 2 * * *", "0 * * * * *"} {
+		expression, _ = ParseCronExpression(expr)
+		for _, start := range []time.Time{
+			time.Date(2024, 11, 3, 0, 0, 0, 0, location),
+			time.Date(2024, 3, 10, 0, 0, 0, 0, location),
+		} {
+			current := start
+			for i := 0; i < 200 && current.Before(start.Add(6*time.Hour)); i++ {
+				next := expression.Next(current)
+				if !assert.True(t, next.After(current), expr+" "+current.String()) {
+					break
+				}
+				current = next
+			}
+		}
+	}
+}
+
+func TestCronScheduler(t *testing.T) {
+	defaultWorkerPool := NewDefaultWorkerPool(fpgo.NewBufferedChannelQueue[func()](3, 10000, 100), nil).
+		SetSpawnWorkerDuration(1 * time.Millisecond / 10).
+		SetWorkerSizeMaximum(5).
+		SetWorkerSizeStandBy(5)
+	defer defaultWorkerPool.Close()
+	start := time.Date(2024, 1, 1, 10, 30, 15, 0, time.UTC)
+	timeScheduler := fpgo.NewVirtualTimeScheduler(start)
+	cronScheduler := NewCronScheduler(defaultWorkerPool, CronSchedulerOption{
+		Location:      time.UTC,
+		TimeScheduler: timeScheduler,
+	})
+
+	ran := make(chan string, 100)
+	everyMinute, err := cronScheduler.Add("* * * * *", func() {
+		ran <- "minute"
+	})
+	assert.NoError(t, err)
+	every15Minutes, _ := cronScheduler.Add("*/15 * * * *", func() {
+		ran <- "15 minutes"
+	})
+	assert.Equal(t, time.Date(2024, 1, 1, 10, 31, 0, 0, time.UTC), everyMinute.NextRun())
+	assert.Equal(t, time.Date(2024, 1, 1, 10, 45, 0, 0, time.UTC), every15Minutes.NextRun())
+	assert.Equal(t, 2, len(cronScheduler.Jobs()))
+
+	timeScheduler.Advance(45 * time.Second)
+	assert.Equal(t, "minute", <-ran)
+	assert.Equal(t, time.Date(2024, 1, 1, 10, 32, 0, 0, time.UTC), everyMinute.NextRun())
+
+	// Pause & Resume
+	everyMinute.Pause()
+	assert.True(t, everyMinute.IsPaused())
+	assert.True(t, everyMinute.NextRun().IsZero())
+	timeScheduler.Advance(14 * time.Minute)
+	assert.Equal(t, "15 minutes", <-ran)
+	everyMinute.Resume()
+	assert.False(t, everyMinute.IsPaused())
+	assert.Equal(t, time.Date(2024, 1, 1, 10, 46, 0, 0, time.UTC), everyMinute.NextRun())
+	timeScheduler.Advance(time.Minute)
+	assert.Equal(t, "minute", <-ran)
+
+	// Remove
+	everyMinute.Remove()
+	assert.True(t, everyMinute.NextRun().IsZero())
+	assert.Equal(t, []*CronJob{every15Minutes}, cronScheduler.Jobs())
+	timeScheduler.Advance(15 * time.Minute)
+	assert.Equal(t, "15 minutes", <-ran)
+
+	// Close
+	cronScheduler.Close()
+	assert.True(t, cronScheduler.IsClosed())
+	timeScheduler.Advance(time.Hour)
+	_, err = cronScheduler.Add("* * * * *", func() {})
+	assert.Equal(t, ErrCronSchedulerIsClosed, err)
+	_, err = NewCronScheduler(defaultWorkerPool).Add("* * *", func() {})
+	assert.True(t, errors.Is(err, ErrCronExpressionInvalid))
+	select {
+	case name := <-ran:
+		assert.Fail(t, "unexpected run "+name)
+	case <-time.After(5 * time.Millisecond):
+	}
+
+	// Scheduling errors
+	var scheduleErr error
+	closedPool := NewDefaultWorkerPool(fpgo.NewBufferedChannelQueue[func()](3, 10000, 100), nil)
+	closedPool.Close()
+	cronScheduler = NewCronScheduler(closedPool, CronSchedulerOption{
+		TimeScheduler: timeScheduler,
+		OnError: func(err error) {
+			scheduleErr = err
+		},
+	})
+	cronScheduler.Add("* * * * * *", func() {})
+	timeScheduler.Advance(time.Second)
+	assert.Equal(t, ErrWorkerPoolIsClosed, scheduleErr)
+	cronScheduler.Close()
+}
@@ -0,0 +1,147 @@
+package worker
+
+import (
+	"sync"
+	"time"
+)
+
+// LimitedWorkerPool
+
+// LimitedWorkerPool WorkerPool running at most limit Jobs concurrently on the underlying WorkerPool,
+// Jobs beyond the limit are queued in order(they don't occupy workers of the underlying WorkerPool while waiting)
+type LimitedWorkerPool struct {
+	lock     sync.Mutex
+	isClosed bool
+
+	workerPool WorkerPool
+	limit      int
+	running    int
+	pending    []func()
+}
+
+// LimitConcurrency Wrap the WorkerPool as a LimitedWorkerPool running at most n Jobs concurrently(1 if <= 0)
+//
+// NOTE: closing the LimitedWorkerPool drops the queued Jobs but it doesn't close the underlying WorkerPool
+func LimitConcurrency(workerPool WorkerPool, n int) *LimitedWorkerPool {
+	if n <= 0 {
+		n = 1
+	}
+	return &LimitedWorkerPool{
+		workerPool: workerPool,
+		limit:      n,
+	}
+}
+
+// IsClosed Check is Closed
+func (limitedSelf *LimitedWorkerPool) IsClosed() bool {
+	limitedSelf.lock.Lock()
+	defer limitedSelf.lock.Unlock()
+
+	return limitedSelf.isClosed || limitedSelf.workerPool.IsClosed()
+}
+
+// Close Close the LimitedWorkerPool and drop the queued Jobs
+func (limitedSelf *LimitedWorkerPool) Close() {
+	limitedSelf.lock.Lock()
+	defer limitedSelf.lock.Unlock()
+
+	limitedSelf.isClosed = true
+	limitedSelf.pending = nil
+}
+
+// Schedule Schedule the Job(queued if the limit is reached)
+func (limitedSelf *LimitedWorkerPool) Schedule(fn func()) error {
+	return limitedSelf.schedule(fn, func(job func()) error {
+		return limitedSelf.workerPool.Schedule(job)
+	})
+}
+
+// ScheduleWithTimeout Schedule the Job with timeout(the timeout applies to the underlying WorkerPool only if the limit isn't reached)
+func (limitedSelf *LimitedWorkerPool) ScheduleWithTimeout(fn func(), timeout time.Duration) error {
+	return limitedSelf.schedule(fn, func(job func()) error {
+		return limitedSelf.workerPool.ScheduleWithTimeout(job, timeout)
+	})
+}
+
+// Running Get the number of Jobs running(or being scheduled) on the underlying WorkerPool
+func (limitedSelf *LimitedWorkerPool) Running() int {
+	limitedSelf.lock.Lock()
+	defer limitedSelf.lock.Unlock()
+
+	return limitedSelf.running
+}
+
+// Pending Get the number of queued Jobs
+func (limitedSelf *LimitedWorkerPool) Pending() int {
+	limitedSelf.lock.Lock()
+	defer limitedSelf.lock.Unlock()
+
+	return len(limitedSelf.pending)
+}
+
+func (limitedSelf *LimitedWorkerPool) schedule(fn func(), scheduleFn func(func()) error) error {
+	limitedSelf.lock.Lock()
+	if limitedSelf.isClosed || limitedSelf.workerPool.IsClosed() {
+		limitedSelf.lock.Unlock()
+		return ErrWorkerPoolIsClosed
+	}
+	if limitedSelf.running >= limitedSelf.limit {
+		limitedSelf.pending = append(limitedSelf.pending, fn)
+		limitedSelf.lock.Unlock()
+		return nil
+	}
+	limitedSelf.running++
+	limitedSelf.lock.Unlock()
+
+	err := scheduleFn(limitedSelf.job(fn))
+	if err != nil {
+		limitedSelf.lock.Lock()
+		limitedSelf.running--
+		limitedSelf.lock.Unlock()
+	}
+	return err
+}
+
+// job Run fn and then the queued Jobs on the same worker until there's none
+func (limitedSelf *LimitedWorkerPool) job(fn func()) func() {
+	return func() {
+		for fn != nil {
+			limitedSelf.runJob(fn)
+			fn = limitedSelf.next()
+		}
+	}
+}
+
+func (limitedSelf *LimitedWorkerPool) runJob(fn func()) {
+	isDone := false
+	defer func() {
+		if !isDone {
+			// Panicked: hand over the slot to a new Job then let the underlying WorkerPool handle the panic
+			if next := limitedSelf.next(); next != nil {
+				if err := limitedSelf.workerPool.Schedule(limitedSelf.job(next)); err != nil {
+					limitedSelf.lock.Lock()
+					limitedSelf.running--
+					limitedSelf.lock.Unlock()
+				}
+			}
+		}
+	}()
+
+	fn()
+	isDone = true
+}
+
+// next Take the next queued Job, or release the slot if there's none
+func (limitedSelf *LimitedWorkerPool) next() func() {
+	limitedSelf.lock.Lock()
+	defer limitedSelf.lock.Unlock()
+
+	if len(limitedSelf.pending) == 0 || limitedSelf.isClosed {
+		limitedSelf.running--
+		return nil
+	}
+	fn := limitedSelf.pending[0]
+	limitedSelf.pending[0] = nil
+	limitedSelf.pending = limitedSelf.pending[1:]
+	return fn
+}
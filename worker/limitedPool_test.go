@@ -0,0 +1,68 @@
+package worker
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	fpgo "github.com/TeaEntityLab/fpGo/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLimitConcurrency(t *testing.T) {
+	defaultWorkerPool := NewDefaultWorkerPool(fpgo.NewBufferedChannelQueue[func()](3, 10000, 100), nil).
+		SetSpawnWorkerDuration(1 * time.Millisecond / 10).
+		SetWorkerSizeMaximum(5).
+		SetWorkerSizeStandBy(5)
+	defer defaultWorkerPool.Close()
+	var workerPool WorkerPool
+	limited := LimitConcurrency(defaultWorkerPool, 2)
+	workerPool = limited
+
+	var lock sync.Mutex
+	current, peak := 0, 0
+	var order []int
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		i := i
+		wg.Add(1)
+		assert.NoError(t, workerPool.Schedule(func() {
+			defer wg.Done()
+			lock.Lock()
+			current++
+			peak = fpgo.Max(peak, current)
+			order = append(order, i)
+			lock.Unlock()
+			time.Sleep(time.Millisecond)
+			lock.Lock()
+			current--
+			lock.Unlock()
+		}))
+	}
+	wg.Wait()
+	assert.Equal(t, 2, peak)
+	assert.Equal(t, 10, len(order))
+	assert.Eventually(t, func() bool {
+		return limited.Running() == 0
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, 0, limited.Pending())
+
+	// Panics release the slot
+	done := make(chan bool)
+	assert.NoError(t, limited.Schedule(func() {
+		panic("boom")
+	}))
+	assert.NoError(t, limited.Schedule(func() {
+		panic("boom")
+	}))
+	assert.NoError(t, limited.ScheduleWithTimeout(func() {
+		close(done)
+	}, time.Second))
+	<-done
+
+	// Closed
+	limited.Close()
+	assert.Equal(t, true, limited.IsClosed())
+	assert.Equal(t, ErrWorkerPoolIsClosed, limited.Schedule(func() {}))
+	assert.Equal(t, false, defaultWorkerPool.IsClosed())
+}
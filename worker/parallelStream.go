@@ -0,0 +1,177 @@
+package worker
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	fpgo "github.com/TeaEntityLab/fpGo/v2"
+)
+
+// ParallelStream
+
+// ParallelStream ParallelStream inspired by Java8 parallel Stream, items are processed on the WorkerPool
+type ParallelStream[T comparable] struct {
+	workerPool  WorkerPool
+	parallelism int
+	isOrdered   bool
+
+	stream *fpgo.StreamDef[T]
+	err    error
+}
+
+// NewParallelStream New a ParallelStream processing the Stream items on the WorkerPool
+func NewParallelStream[T comparable](workerPool WorkerPool, stream *fpgo.StreamDef[T]) *ParallelStream[T] {
+	return &ParallelStream[T]{
+		workerPool:  workerPool,
+		parallelism: runtime.NumCPU(),
+		isOrdered:   true,
+
+		stream: stream,
+	}
+}
+
+// SetParallelism Set the parallelism(maximum number of jobs running on the WorkerPool at the same time)
+func (parallelStreamSelf *ParallelStream[T]) SetParallelism(parallelism int) *ParallelStream[T] {
+	parallelStreamSelf.parallelism = parallelism
+	return parallelStreamSelf
+}
+
+// SetOrdered Set is the result collected in the original order(true) or in the completion order(false)
+func (parallelStreamSelf *ParallelStream[T]) SetOrdered(isOrdered bool) *ParallelStream[T] {
+	parallelStreamSelf.isOrdered = isOrdered
+	return parallelStreamSelf
+}
+
+// Map Map all items of Stream by function on the WorkerPool
+func (parallelStreamSelf *ParallelStream[T]) Map(fn func(T, int) T) *ParallelStream[T] {
+	return parallelStreamSelf.process(func(val T, index int) (T, bool) {
+		return fn(val, index), true
+	})
+}
+
+// Filter Filter items of Stream by function on the WorkerPool
+func (parallelStreamSelf *ParallelStream[T]) Filter(fn func(T, int) bool) *ParallelStream[T] {
+	return parallelStreamSelf.process(func(val T, index int) (T, bool) {
+		return val, fn(val, index)
+	})
+}
+
+// ForEach Do the function for all items on the WorkerPool(blocking until all done, in the completion order)
+func (parallelStreamSelf *ParallelStream[T]) ForEach(fn func(T, int)) error {
+	result := parallelStreamSelf.process(func(val T, index int) (T, bool) {
+		fn(val, index)
+		return val, false
+	})
+
+	return result.err
+}
+
+// ForEachConcurrent Do the function for all items of the Stream on the WorkerPool(blocking until all done)
+func ForEachConcurrent[T comparable](workerPool WorkerPool, stream *fpgo.StreamDef[T], fn func(T, int)) error {
+	return NewParallelStream(workerPool, stream).ForEach(fn)
+}
+
+// Collect Collect the processed items as a Stream (or the first error of processing)
+func (parallelStreamSelf *ParallelStream[T]) Collect() (*fpgo.StreamDef[T], error) {
+	if parallelStreamSelf.err != nil {
+		return nil, parallelStreamSelf.err
+	}
+
+	return parallelStreamSelf.stream, nil
+}
+
+// Err Get the first error of processing
+func (parallelStreamSelf *ParallelStream[T]) Err() error {
+	return parallelStreamSelf.err
+}
+
+func (parallelStreamSelf *ParallelStream[T]) process(fn func(T, int) (T, bool)) *ParallelStream[T] {
+	next := &ParallelStream[T]{
+		workerPool:  parallelStreamSelf.workerPool,
+		parallelism: parallelStreamSelf.parallelism,
+		isOrdered:   parallelStreamSelf.isOrdered,
+
+		err: parallelStreamSelf.err,
+	}
+	if next.err != nil {
+		return next
+	}
+
+	list := parallelStreamSelf.stream.ToArray()
+	listLen := len(list)
+
+	isOrdered := parallelStreamSelf.isOrdered
+	results := make([]T, listLen)
+	isKept := make([]bool, listLen)
+	unorderedResults := make([]T, 0, listLen)
+	var resultLock sync.Mutex
+
+	var panicErr error
+	var nextIndex int64 = -1
+	var wg sync.WaitGroup
+	job := func() {
+		defer wg.Done()
+		defer func() {
+			if panic := recover(); panic != nil {
+				resultLock.Lock()
+				panicErr = fmt.Errorf("panic from ParallelStream: %v", panic)
+				resultLock.Unlock()
+			}
+		}()
+
+		// Every job takes items by the shared index until all are taken
+		for {
+			i := int(atomic.AddInt64(&nextIndex, 1))
+			if i >= listLen {
+				return
+			}
+
+			val, ok := fn(list[i], i)
+			if isOrdered {
+				results[i], isKept[i] = val, ok
+			} else if ok {
+				resultLock.Lock()
+				unorderedResults = append(unorderedResults, val)
+				resultLock.Unlock()
+			}
+		}
+	}
+
+	jobCount := parallelStreamSelf.parallelism
+	if jobCount <= 0 || jobCount > listLen {
+		jobCount = listLen
+	}
+	scheduledCount := 0
+	var scheduleErr error
+	for i := 0; i < jobCount; i++ {
+		wg.Add(1)
+		scheduleErr = parallelStreamSelf.workerPool.Schedule(job)
+		if scheduleErr != nil {
+			wg.Done()
+			break
+		}
+		scheduledCount++
+	}
+	wg.Wait()
+
+	// Scheduled jobs take all the items, it fails only if there's no job scheduled
+	if listLen > 0 && scheduledCount == 0 {
+		next.err = scheduleErr
+		return next
+	}
+	if panicErr != nil {
+		next.err = panicErr
+		return next
+	}
+
+	if isOrdered {
+		next.stream = fpgo.StreamFromArray(fpgo.Filter(func(_ T, i int) bool {
+			return isKept[i]
+		}, results...))
+	} else {
+		next.stream = fpgo.StreamFromArray(unorderedResults)
+	}
+	return next
+}
@@ -0,0 +1,81 @@
+package worker
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	fpgo "github.com/TeaEntityLab/fpGo/v2"
+)
+
+func TestParallelStream(t *testing.T) {
+	defaultWorkerPool := NewDefaultWorkerPool(fpgo.NewBufferedChannelQueue[func()](3, 10000, 100), nil).
+		SetSpawnWorkerDuration(1 * time.Millisecond / 10).
+		SetWorkerSizeMaximum(5).
+		SetWorkerSizeStandBy(5)
+	defer defaultWorkerPool.Close()
+
+	var result *fpgo.StreamDef[int]
+	var err error
+
+	result, err = NewParallelStream(defaultWorkerPool, fpgo.StreamFrom(1, 2, 3, 4, 5, 6)).
+		SetParallelism(3).
+		Map(func(v int, _ int) int {
+			return v * v
+		}).
+		Filter(func(v int, _ int) bool {
+			return v%2 == 0
+		}).
+		Collect()
+	assert.NoError(t, err)
+	assert.Equal(t, []int{4, 16, 36}, result.ToArray())
+
+	result, err = NewParallelStream(defaultWorkerPool, fpgo.StreamFrom(1, 2, 3, 4)).
+		SetOrdered(false).
+		Map(func(v int, _ int) int {
+			return v * 10
+		}).
+		Collect()
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []int{10, 20, 30, 40}, result.ToArray())
+
+	var sum int64
+	err = NewParallelStream(defaultWorkerPool, fpgo.StreamFrom(1, 2, 3, 4)).ForEach(func(v int, _ int) {
+		atomic.AddInt64(&sum, int64(v))
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), sum)
+
+	// Panic inside jobs
+	_, err = NewParallelStream(defaultWorkerPool, fpgo.StreamFrom(1, 2, 3)).Map(func(v int, _ int) int {
+		if v == 2 {
+			panic("boom")
+		}
+		return v
+	}).Map(func(v int, _ int) int {
+		return v
+	}).Collect()
+	assert.Error(t, err)
+
+	// Closed WorkerPool
+	defaultWorkerPool.Close()
+	_, err = NewParallelStream(defaultWorkerPool, fpgo.StreamFrom(1, 2, 3)).Map(func(v int, _ int) int {
+		return v
+	}).Collect()
+	assert.Equal(t, ErrWorkerPoolIsClosed, err)
+}
+
+func TestForEachConcurrent(t *testing.T) {
+	defaultWorkerPool := NewDefaultWorkerPool(fpgo.NewBufferedChannelQueue[func()](3, 10000, 100), nil).
+		SetSpawnWorkerDuration(1 * time.Millisecond / 10)
+	defer defaultWorkerPool.Close()
+
+	var sum int64
+	err := ForEachConcurrent(defaultWorkerPool, fpgo.StreamFrom(1, 2, 3, 4, 5), func(v int, _ int) {
+		atomic.AddInt64(&sum, int64(v))
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(15), sum)
+}
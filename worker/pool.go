@@ -1,6 +1,7 @@
 package worker
 
 import (
+	"context"
 	"errors"
 	"log"
 	"runtime"
@@ -45,6 +46,8 @@ type DefaultWorkerPoolSettings struct {
 	workerExpiryDuration  time.Duration
 	workerJamDuration     time.Duration
 	scheduleRetryInterval time.Duration
+	// The worker abandons a job of ScheduleWithExecutionTimeout ignoring the cancellation over the duration(never if <= 0)
+	executionAbandonDuration time.Duration
 
 	// Panic Handler
 
@@ -81,6 +84,8 @@ type DefaultWorkerPool struct {
 	spawnWorkerCh fpgo.ChannelQueue[int]
 	lastAliveTime time.Time
 
+	abandonedJobCount int
+
 	// Settings
 	DefaultWorkerPoolSettings
 }
@@ -184,9 +189,7 @@ func (workerPoolSelf *DefaultWorkerPool) generateWorkerWithMaximum(maximum int)
 		// Recover & Recycle
 		defer func() {
 			if panic := recover(); panic != nil {
-				if handler := workerPoolSelf.panicHandler; handler != nil {
-					handler(panic)
-				}
+				workerPoolSelf.handlePanic(panic)
 			}
 
 			workerPoolSelf.lock.Lock()
@@ -301,6 +304,15 @@ func (workerPoolSelf *DefaultWorkerPool) SetScheduleRetryInterval(scheduleRetryI
 	return workerPoolSelf
 }
 
+// SetExecutionAbandonDuration The worker would abandon a job of ScheduleWithExecutionTimeout(leaving it running on its own goroutine)
+// and take the next jobs if the job keeps running over the duration after its timeout(never abandoned if <= 0)
+func (workerPoolSelf *DefaultWorkerPool) SetExecutionAbandonDuration(executionAbandonDuration time.Duration) *DefaultWorkerPool {
+	workerPoolSelf.lock.Lock()
+	workerPoolSelf.executionAbandonDuration = executionAbandonDuration
+	workerPoolSelf.lock.Unlock()
+	return workerPoolSelf
+}
+
 // SetDefaultWorkerPoolSettings Set the defaultWorkerPoolSettings
 func (workerPoolSelf *DefaultWorkerPool) SetDefaultWorkerPoolSettings(defaultWorkerPoolSettings DefaultWorkerPoolSettings) *DefaultWorkerPool {
 	workerPoolSelf.DefaultWorkerPoolSettings = defaultWorkerPoolSettings
@@ -368,9 +380,168 @@ func (workerPoolSelf *DefaultWorkerPool) ScheduleWithTimeout(fn func(), timeout
 			return ErrWorkerPoolScheduleTimeout
 		}
 		time.Sleep(retryInterval)
+	}
+}
+
+// ScheduleWithExecutionTimeout Schedule the Job with a ctx canceled after the timeout since it starts running
+// (see SetExecutionAbandonDuration() for jobs ignoring the cancellation)
+func (workerPoolSelf *DefaultWorkerPool) ScheduleWithExecutionTimeout(fn func(ctx context.Context), timeout time.Duration) error {
+	return workerPoolSelf.Schedule(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+
+		workerPoolSelf.lock.RLock()
+		abandonDuration := workerPoolSelf.executionAbandonDuration
+		workerPoolSelf.lock.RUnlock()
+		if abandonDuration <= 0 {
+			fn(ctx)
+			return
+		}
+
+		// Run on its own goroutine, so that the worker could leave it.
+		// A panic is rethrown on the worker like Schedule(), or handled there if the worker has left it
+		var jobLock sync.Mutex
+		isAbandoned := false
+		done := make(chan interface{}, 1)
+		go func() {
+			defer func() {
+				panic := recover()
+
+				jobLock.Lock()
+				if !isAbandoned {
+					done <- panic
+					jobLock.Unlock()
+					return
+				}
+				jobLock.Unlock()
+
+				if panic != nil {
+					workerPoolSelf.handlePanic(panic)
+				}
+			}()
+			fn(ctx)
+		}()
+		rethrow := func(panicked interface{}) {
+			if panicked != nil {
+				panic(panicked)
+			}
+		}
+
+		select {
+		case panicked := <-done:
+			rethrow(panicked)
+			return
+		case <-ctx.Done():
+		}
+		select {
+		case panicked := <-done:
+			rethrow(panicked)
+		case <-time.After(abandonDuration):
+			jobLock.Lock()
+			// Done right before it's abandoned
+			select {
+			case panicked := <-done:
+				jobLock.Unlock()
+				rethrow(panicked)
+				return
+			default:
+			}
+			isAbandoned = true
+			jobLock.Unlock()
+
+			workerPoolSelf.lock.Lock()
+			workerPoolSelf.abandonedJobCount++
+			workerPoolSelf.lock.Unlock()
+		}
+	})
+}
 
+// handlePanic Handle the panic of a Job by the panicHandler(if it's set)
+func (workerPoolSelf *DefaultWorkerPool) handlePanic(panic interface{}) {
+	if handler := workerPoolSelf.panicHandler; handler != nil {
+		handler(panic)
 	}
-	return err
+}
+
+// AbandonedJobCount Get the number of jobs abandoned by workers(see SetExecutionAbandonDuration())
+func (workerPoolSelf *DefaultWorkerPool) AbandonedJobCount() int {
+	workerPoolSelf.lock.RLock()
+	defer workerPoolSelf.lock.RUnlock()
+
+	return workerPoolSelf.abandonedJobCount
+}
+
+// ScheduleWithRetry Schedule the Job & re-schedule it by the RetryPolicy while it returns an error
+// (onError is called with the last error if it's still failed, it could be nil)
+func (workerPoolSelf *DefaultWorkerPool) ScheduleWithRetry(fn func() error, policy fpgo.RetryPolicy, onError func(error)) error {
+	return workerPoolSelf.scheduleWithRetry(fn, policy, onError, 1)
+}
+
+func (workerPoolSelf *DefaultWorkerPool) scheduleWithRetry(fn func() error, policy fpgo.RetryPolicy, onError func(error), retry int) error {
+	return workerPoolSelf.Schedule(func() {
+		err := fn()
+		if err == nil {
+			return
+		}
+		if !policy.CanRetry(retry, err) {
+			if onError != nil {
+				onError(err)
+			}
+			return
+		}
+
+		delay := policy.Delay(retry)
+		if policy.OnRetry != nil {
+			policy.OnRetry(retry, err, delay)
+		}
+		// Wait outside of the worker
+		time.AfterFunc(delay, func() {
+			if scheduleErr := workerPoolSelf.scheduleWithRetry(fn, policy, onError, retry+1); scheduleErr != nil && onError != nil {
+				onError(scheduleErr)
+			}
+		})
+	})
+}
+
+// ScheduleWithBreaker Schedule the Job through the CircuitBreaker, fpgo.ErrCircuitBreakerOpen is returned without scheduling if it's open
+// (onError is called with the error of the Job or fpgo.ErrCircuitBreakerOpen if it's rejected when running, it could be nil)
+func (workerPoolSelf *DefaultWorkerPool) ScheduleWithBreaker(fn func() error, breaker *fpgo.CircuitBreaker, onError func(error)) error {
+	if breaker.State() == fpgo.CircuitBreakerOpen {
+		return fpgo.ErrCircuitBreakerOpen
+	}
+
+	return workerPoolSelf.Schedule(func() {
+		if err := breaker.Execute(fn); err != nil && onError != nil {
+			onError(err)
+		}
+	})
+}
+
+// ScheduleWithLimiter Schedule the Job once the RateLimiter permits it(waiting outside of the workers),
+// fpgo.ErrRateLimitExceeded is returned if the permit can't be reserved
+// (onError is called with the error of scheduling after the delay, it could be nil)
+func (workerPoolSelf *DefaultWorkerPool) ScheduleWithLimiter(fn func(), limiter fpgo.RateLimiter, onError func(error)) error {
+	if workerPoolSelf.IsClosed() {
+		return ErrWorkerPoolIsClosed
+	}
+	reservation := limiter.Reserve()
+	if !reservation.OK() {
+		return fpgo.ErrRateLimitExceeded
+	}
+	if reservation.Delay() <= 0 {
+		err := workerPoolSelf.Schedule(fn)
+		if err != nil {
+			reservation.Cancel()
+		}
+		return err
+	}
+
+	time.AfterFunc(reservation.Delay(), func() {
+		if err := workerPoolSelf.Schedule(fn); err != nil && onError != nil {
+			onError(err)
+		}
+	})
+	return nil
 }
 
 // Invokable
@@ -1,6 +1,8 @@
 package worker
 
 import (
+	"context"
+	"errors"
 	"testing"
 	"time"
 	// "sync"
@@ -158,3 +160,221 @@ func TestWorkerJamDuration(t *testing.T) {
 	// A new expected goroutine is generated
 	assert.Equal(t, 5, defaultWorkerPool.workerCount)
 }
+
+func TestWorkerPoolAsScheduler(t *testing.T) {
+	defaultWorkerPool := NewDefaultWorkerPool(fpgo.NewBufferedChannelQueue[func()](3, 10000, 100), nil).
+		SetSpawnWorkerDuration(1 * time.Millisecond / 10).
+		SetWorkerSizeMaximum(5).
+		SetWorkerSizeStandBy(5)
+	var scheduler fpgo.Scheduler = defaultWorkerPool
+
+	p := fpgo.PublisherNewGenerics[int]()
+	received := make(chan int, 3)
+	p.ObserveOn(scheduler).Subscribe(fpgo.Subscription[int]{
+		OnNext: func(in int) {
+			received <- in
+		},
+	})
+	p.Publish(1)
+	p.Publish(2)
+	p.Publish(3)
+	actual := []int{<-received, <-received, <-received}
+	assert.ElementsMatch(t, []int{1, 2, 3}, actual)
+
+	results, err := fpgo.ParSequence([]*fpgo.MonadIODef[int]{
+		fpgo.MonadIOJustGenerics(1),
+		fpgo.MonadIOJustGenerics(2),
+	}, scheduler).EvalWithError()
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, results)
+
+	// Errors of Schedule() terminate the observing Publisher
+	defaultWorkerPool.Close()
+	var actualErr error
+	p = fpgo.PublisherNewGenerics[int]()
+	p.ObserveOn(scheduler).Subscribe(fpgo.Subscription[int]{
+		OnError: func(err error) {
+			actualErr = err
+		},
+	})
+	p.Publish(1)
+	assert.Equal(t, ErrWorkerPoolIsClosed, actualErr)
+}
+
+func TestScheduleWithRetry(t *testing.T) {
+	defaultWorkerPool := NewDefaultWorkerPool(fpgo.NewBufferedChannelQueue[func()](3, 10000, 100), nil).
+		SetSpawnWorkerDuration(1 * time.Millisecond / 10).
+		SetWorkerSizeMaximum(5).
+		SetWorkerSizeStandBy(5)
+	defer defaultWorkerPool.Close()
+
+	errFailed := errors.New("failed")
+	attempts := make(chan int, 10)
+	count := 0
+	done := make(chan bool)
+	err := defaultWorkerPool.ScheduleWithRetry(func() error {
+		count++
+		attempts <- count
+		if count < 3 {
+			return errFailed
+		}
+		close(done)
+		return nil
+	}, fpgo.FixedBackoff(5, time.Millisecond), nil)
+	assert.NoError(t, err)
+	<-done
+	assert.Equal(t, []int{1, 2, 3}, []int{<-attempts, <-attempts, <-attempts})
+
+	// Give up after 2 retries
+	lastErr := make(chan error, 1)
+	retried := make(chan int, 10)
+	policy := fpgo.FixedBackoff(2, time.Millisecond)
+	policy.OnRetry = func(retry int, err error, delay time.Duration) {
+		retried <- retry
+	}
+	err = defaultWorkerPool.ScheduleWithRetry(func() error {
+		return errFailed
+	}, policy, func(err error) {
+		lastErr <- err
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, errFailed, <-lastErr)
+	assert.Equal(t, []int{1, 2}, []int{<-retried, <-retried})
+	assert.Equal(t, 0, len(retried))
+}
+
+func TestScheduleWithBreaker(t *testing.T) {
+	defaultWorkerPool := NewDefaultWorkerPool(fpgo.NewBufferedChannelQueue[func()](3, 10000, 100), nil).
+		SetSpawnWorkerDuration(1 * time.Millisecond / 10).
+		SetWorkerSizeMaximum(5).
+		SetWorkerSizeStandBy(5)
+	defer defaultWorkerPool.Close()
+
+	errFailed := errors.New("failed")
+	timeScheduler := fpgo.NewVirtualTimeScheduler(time.Now())
+	breaker := fpgo.NewCircuitBreaker(fpgo.CircuitBreakerOption{FailureThreshold: 2, ResetTimeout: time.Second, TimeScheduler: timeScheduler})
+	errs := make(chan error, 10)
+	for i := 0; i < 2; i++ {
+		err := defaultWorkerPool.ScheduleWithBreaker(func() error {
+			return errFailed
+		}, breaker, func(err error) {
+			errs <- err
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, errFailed, <-errs)
+	}
+	assert.Equal(t, fpgo.CircuitBreakerOpen, breaker.State())
+	assert.Equal(t, fpgo.ErrCircuitBreakerOpen, defaultWorkerPool.ScheduleWithBreaker(func() error {
+		return nil
+	}, breaker, nil))
+
+	timeScheduler.Advance(time.Second)
+	done := make(chan bool)
+	assert.NoError(t, defaultWorkerPool.ScheduleWithBreaker(func() error {
+		close(done)
+		return nil
+	}, breaker, nil))
+	<-done
+	assert.Eventually(t, func() bool {
+		return breaker.State() == fpgo.CircuitBreakerClosed
+	}, time.Second, time.Millisecond)
+}
+
+func TestScheduleWithLimiter(t *testing.T) {
+	defaultWorkerPool := NewDefaultWorkerPool(fpgo.NewBufferedChannelQueue[func()](3, 10000, 100), nil).
+		SetSpawnWorkerDuration(1 * time.Millisecond / 10).
+		SetWorkerSizeMaximum(5).
+		SetWorkerSizeStandBy(5)
+	defer defaultWorkerPool.Close()
+
+	limiter := fpgo.NewLeakyBucketLimiter(100, 1)
+	ran := make(chan time.Time, 3)
+	start := time.Now()
+	for i := 0; i < 2; i++ {
+		assert.NoError(t, defaultWorkerPool.ScheduleWithLimiter(func() {
+			ran <- time.Now()
+		}, limiter, nil))
+	}
+	assert.Equal(t, fpgo.ErrRateLimitExceeded, defaultWorkerPool.ScheduleWithLimiter(func() {
+		ran <- time.Now()
+	}, limiter, nil))
+	<-ran
+	assert.True(t, (<-ran).Sub(start) >= 10*time.Millisecond)
+	assert.Equal(t, 0, len(ran))
+}
+
+func TestScheduleWithExecutionTimeout(t *testing.T) {
+	defaultWorkerPool := NewDefaultWorkerPool(fpgo.NewBufferedChannelQueue[func()](3, 10000, 100), nil).
+		SetSpawnWorkerDuration(1 * time.Millisecond / 10).
+		SetWorkerSizeMaximum(1).
+		SetWorkerSizeStandBy(1)
+	defer defaultWorkerPool.Close()
+
+	// The job is canceled by the ctx
+	ctxErr := make(chan error, 1)
+	assert.NoError(t, defaultWorkerPool.ScheduleWithExecutionTimeout(func(ctx context.Context) {
+		<-ctx.Done()
+		ctxErr <- ctx.Err()
+	}, time.Millisecond))
+	assert.Equal(t, context.DeadlineExceeded, <-ctxErr)
+
+	// The runaway job holds the worker
+	block := make(chan bool)
+	ran := make(chan bool, 2)
+	assert.NoError(t, defaultWorkerPool.ScheduleWithExecutionTimeout(func(ctx context.Context) {
+		<-block
+	}, time.Millisecond))
+	assert.NoError(t, defaultWorkerPool.Schedule(func() {
+		ran <- true
+	}))
+	select {
+	case <-ran:
+		assert.Fail(t, "the worker should be held")
+	case <-time.After(10 * time.Millisecond):
+	}
+	close(block)
+	assert.True(t, <-ran)
+	assert.Equal(t, 0, defaultWorkerPool.AbandonedJobCount())
+
+	// The runaway job is abandoned
+	block = make(chan bool)
+	defer close(block)
+	defaultWorkerPool.SetExecutionAbandonDuration(time.Millisecond)
+	assert.NoError(t, defaultWorkerPool.ScheduleWithExecutionTimeout(func(ctx context.Context) {
+		<-block
+	}, time.Millisecond))
+	assert.NoError(t, defaultWorkerPool.Schedule(func() {
+		ran <- true
+	}))
+	assert.True(t, <-ran)
+	assert.Equal(t, 1, defaultWorkerPool.AbandonedJobCount())
+
+	// Panics are handled by the panicHandler
+	panicked := make(chan interface{}, 1)
+	defaultWorkerPool.SetPanicHandler(func(panic interface{}) {
+		panicked <- panic
+	})
+	assert.NoError(t, defaultWorkerPool.ScheduleWithExecutionTimeout(func(ctx context.Context) {
+		panic("runaway")
+	}, time.Millisecond))
+	assert.Equal(t, "runaway", <-panicked)
+
+	// Panics after it's abandoned
+	release := make(chan bool)
+	assert.NoError(t, defaultWorkerPool.ScheduleWithExecutionTimeout(func(ctx context.Context) {
+		<-release
+		panic("abandoned")
+	}, time.Millisecond))
+	for defaultWorkerPool.AbandonedJobCount() < 2 {
+		time.Sleep(time.Millisecond)
+	}
+	close(release)
+	assert.Equal(t, "abandoned", <-panicked)
+
+	// Panics without the abandonment
+	defaultWorkerPool.SetExecutionAbandonDuration(0)
+	assert.NoError(t, defaultWorkerPool.ScheduleWithExecutionTimeout(func(ctx context.Context) {
+		panic("inline")
+	}, time.Millisecond))
+	assert.Equal(t, "inline", <-panicked)
+}
@@ -0,0 +1,51 @@
+package fpgo
+
+// Writer
+
+// Writer Writer monad inspired by Haskell, a result A with the log W accumulated by the Monoid
+type Writer[W any, A any] struct {
+	monoid Monoid[W]
+	val    A
+	log    W
+}
+
+// WriterOf New Writer of the value with an empty log
+func WriterOf[W any, A any](monoid Monoid[W], val A) *Writer[W, A] {
+	return &Writer[W, A]{monoid: monoid, val: val, log: monoid.Empty()}
+}
+
+// WriterTell New Writer with only the log
+func WriterTell[W any](monoid Monoid[W], log W) *Writer[W, struct{}] {
+	return &Writer[W, struct{}]{monoid: monoid, log: monoid.Combine(monoid.Empty(), log)}
+}
+
+// Run Get the result & the accumulated log
+func (writerSelf *Writer[W, A]) Run() (A, W) {
+	return writerSelf.val, writerSelf.log
+}
+
+// Tell New Writer with the log appended
+func (writerSelf *Writer[W, A]) Tell(log W) *Writer[W, A] {
+	return &Writer[W, A]{monoid: writerSelf.monoid, val: writerSelf.val, log: writerSelf.monoid.Combine(writerSelf.log, log)}
+}
+
+// Map Map the result of the Writer by function(the log is kept)
+func (writerSelf *Writer[W, A]) Map(fn func(A) A) *Writer[W, A] {
+	return WriterMap(writerSelf, fn)
+}
+
+// FlatMap FlatMap the result of the Writer by function(the logs are combined in order)
+func (writerSelf *Writer[W, A]) FlatMap(fn func(A) *Writer[W, A]) *Writer[W, A] {
+	return WriterFlatMap(writerSelf, fn)
+}
+
+// WriterMap Map the result of the Writer into another type by function(the log is kept)
+func WriterMap[W any, A any, B any](writer *Writer[W, A], fn func(A) B) *Writer[W, B] {
+	return &Writer[W, B]{monoid: writer.monoid, val: fn(writer.val), log: writer.log}
+}
+
+// WriterFlatMap FlatMap the result of the Writer into a Writer of another type(the logs are combined in order)
+func WriterFlatMap[W any, A any, B any](writer *Writer[W, A], fn func(A) *Writer[W, B]) *Writer[W, B] {
+	next := fn(writer.val)
+	return &Writer[W, B]{monoid: writer.monoid, val: next.val, log: writer.monoid.Combine(writer.log, next.log)}
+}
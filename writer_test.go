@@ -0,0 +1,58 @@
+package fpgo
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriter(t *testing.T) {
+	logs := NewMonoid(func() []string {
+		return []string{}
+	}, func(a []string, b []string) []string {
+		return Concat(a, b)
+	})
+	double := func(v int) *Writer[[]string, int] {
+		return WriterOf(logs, v*2).Tell([]string{"double " + strconv.Itoa(v)})
+	}
+
+	result, log := WriterFlatMap(WriterTell(logs, []string{"start"}), func(struct{}) *Writer[[]string, int] {
+		return WriterOf(logs, 1)
+	}).FlatMap(double).FlatMap(double).Map(func(v int) int {
+		return v + 1
+	}).Run()
+	assert.Equal(t, 5, result)
+	assert.Equal(t, []string{"start", "double 1", "double 2"}, log)
+
+	// Metrics
+	sum := NewMonoid(func() int {
+		return 0
+	}, func(a int, b int) int {
+		return a + b
+	})
+	text, count := WriterMap(WriterOf(sum, 3).Tell(1).Tell(2), strconv.Itoa).Run()
+	assert.Equal(t, "3", text)
+	assert.Equal(t, 3, count)
+}
+
+func TestWriterMonoidInstances(t *testing.T) {
+	logs := MonoidSlice[string]()
+	double := func(v int) *Writer[[]string, int] {
+		return WriterOf(logs, v*2).Tell([]string{"double " + strconv.Itoa(v)})
+	}
+
+	result, log := WriterOf(logs, 1).FlatMap(double).FlatMap(double).Run()
+	assert.Equal(t, 4, result)
+	assert.Equal(t, []string{"double 1", "double 2"}, log)
+	_, log = WriterOf(logs, 1).Run()
+	assert.Equal(t, []string{}, log)
+
+	// Metrics
+	sum := MonoidSum[int]()
+	text, count := WriterMap(WriterOf(sum, 3).Tell(1).Tell(2), strconv.Itoa).Run()
+	assert.Equal(t, "3", text)
+	assert.Equal(t, 3, count)
+	_, count = WriterOf(sum, 3).Run()
+	assert.Equal(t, 0, count)
+}